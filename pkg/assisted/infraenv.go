@@ -430,7 +430,7 @@ func (builder *InfraEnvBuilder) GetAgentsByLabel(key, value string) ([]*agentBui
 
 	var agents agentInstallV1Beta1.AgentList
 
-	err := builder.apiClient.List(logging.DiscardContext(), &agents, goclient.MatchingLabels(matchLabel))
+	err := clients.ListInto(logging.DiscardContext(), builder.apiClient, &agents, goclient.MatchingLabels(matchLabel))
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +155,7 @@ func ListNmStateConfigsInAllNamespaces(apiClient *clients.Settings) ([]*NmStateC
 		return nil, fmt.Errorf("the apiClient is nil")
 	}
 
-	err := apiClient.List(logging.DiscardContext(), nmStateConfigList, &goclient.ListOptions{})
+	err := clients.ListInto(logging.DiscardContext(), apiClient, nmStateConfigList, &goclient.ListOptions{})
 	if err != nil {
 		klog.V(100).Infof("Failed to list nmStateConfigs across all namespaces due to %s", err.Error())
 
@@ -192,7 +192,8 @@ func ListNmStateConfigs(apiClient *clients.Settings, namespace string) ([]*NmSta
 		return nil, fmt.Errorf("namespace to list nmstateconfigs cannot be empty")
 	}
 
-	err := apiClient.List(logging.DiscardContext(), nmStateConfigList, &goclient.ListOptions{Namespace: namespace})
+	err := clients.ListInto(
+		logging.DiscardContext(), apiClient, nmStateConfigList, &goclient.ListOptions{Namespace: namespace})
 	if err != nil {
 		klog.V(100).Infof("Failed to list nmStateConfigs in namespace: %s due to %s",
 			namespace, err.Error())
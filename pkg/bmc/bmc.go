@@ -3,12 +3,16 @@ package bmc
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/redfish"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/crypto/ssh"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
@@ -66,6 +70,8 @@ type BMC struct {
 
 	sshClientForSerialConsole *ssh.Client
 
+	tracerProvider trace.TracerProvider
+
 	errorMsg string
 }
 
@@ -258,6 +264,26 @@ func (bmc *BMC) WithSSHTimeout(timeout time.Duration) *BMC {
 	return bmc
 }
 
+// WithTracerProvider provides the TracerProvider used to create a span around each request to the Redfish API. If it is
+// not set, Redfish requests are not traced.
+func (bmc *BMC) WithTracerProvider(provider trace.TracerProvider) *BMC {
+	if valid, _ := bmc.validate(); !valid {
+		return bmc
+	}
+
+	if provider == nil {
+		klog.V(100).Info("The TracerProvider is nil")
+
+		bmc.errorMsg = "'tracerProvider' cannot be nil"
+
+		return bmc
+	}
+
+	bmc.tracerProvider = provider
+
+	return bmc
+}
+
 // SystemManufacturer gets system's manufacturer from the BMC's RedFish API endpoint.
 func (bmc *BMC) SystemManufacturer() (string, error) {
 	if valid, err := bmc.validateRedfish(); !valid {
@@ -270,7 +296,8 @@ func (bmc *BMC) SystemManufacturer() (string, error) {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -304,7 +331,8 @@ func (bmc *BMC) IsSecureBootEnabled() (bool, error) {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -338,7 +366,8 @@ func (bmc *BMC) SecureBootEnable() error {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -387,7 +416,8 @@ func (bmc *BMC) SecureBootDisable() error {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -436,7 +466,8 @@ func (bmc *BMC) SystemResetAction(action redfish.ResetType) error {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -563,7 +594,8 @@ func (bmc *BMC) SystemPowerState() (string, error) {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -619,7 +651,8 @@ func (bmc *BMC) PowerUsage() (float32, error) {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -652,7 +685,8 @@ func (bmc *BMC) SystemBootOptions() (map[string]string, error) {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -700,7 +734,8 @@ func (bmc *BMC) SystemBootOrderReferences() ([]string, error) {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -739,7 +774,8 @@ func (bmc *BMC) SetSystemBootOrderReferences(bootOrderReferences []string) error
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -788,7 +824,8 @@ func (bmc *BMC) BootFromCD(isoURL, virtualMediaID string) error {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
@@ -1045,7 +1082,9 @@ func (bmc *BMC) CloseSerialConsole() error {
 // redfishConnect uses the provided host, credentials, and timeout to produce a gofish APIClient for accessing the
 // Redfish API.
 func redfishConnect(
-	host, user, password string, sessionTimeout time.Duration) (*gofish.APIClient, context.CancelFunc, error) {
+	host, user, password string,
+	sessionTimeout time.Duration,
+	tracerProvider trace.TracerProvider) (*gofish.APIClient, context.CancelFunc, error) {
 	gofishConfig := gofish.ClientConfig{
 		Endpoint: "https://" + host,
 		Username: user,
@@ -1053,6 +1092,10 @@ func redfishConnect(
 		Insecure: true,
 	}
 
+	if tracerProvider != nil {
+		gofishConfig.HTTPClient = newTracedRedfishHTTPClient(tracerProvider)
+	}
+
 	ctx, cancel := context.WithTimeout(context.TODO(), sessionTimeout)
 
 	client, err := gofish.ConnectContext(ctx, gofishConfig)
@@ -1065,6 +1108,20 @@ func redfishConnect(
 	return client, cancel, nil
 }
 
+// newTracedRedfishHTTPClient returns an HTTP client matching the one gofish uses by default, except that each request is
+// traced using the provided TracerProvider.
+func newTracedRedfishHTTPClient(tracerProvider trace.TracerProvider) *http.Client {
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		TLSHandshakeTimeout: 10 * time.Second,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+		},
+	}
+
+	return &http.Client{Transport: tracing.NewTransport(transport, tracerProvider, "redfish")}
+}
+
 // redfishGetSystem uses the provided gofish APIClient and the system index to get a system from the Redfish API.
 func redfishGetSystem(redfishClient *gofish.APIClient, index int) (*redfish.ComputerSystem, error) {
 	systems, err := redfishClient.GetService().Systems()
@@ -1185,7 +1242,8 @@ func (bmc *BMC) getSupportedResetTypes() ([]redfish.ResetType, error) {
 		bmc.host,
 		bmc.redfishUser.Name,
 		bmc.redfishUser.Password,
-		bmc.timeOuts.Redfish)
+		bmc.timeOuts.Redfish,
+		bmc.tracerProvider)
 	if err != nil {
 		klog.V(100).Infof("Redfish connection error: %v", err)
 
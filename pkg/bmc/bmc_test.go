@@ -14,8 +14,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
 	"github.com/stmcginnis/gofish/redfish"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:embed testdata/redfish_v1.json
@@ -380,6 +385,60 @@ func TestBMCWithSSHTimeout(t *testing.T) {
 	}
 }
 
+func TestBMCWithTracerProvider(t *testing.T) {
+	testCases := []struct {
+		name           string
+		provider       trace.TracerProvider
+		expectedErrMsg string
+	}{
+		{
+			name:           "everything alright",
+			provider:       sdktrace.NewTracerProvider(),
+			expectedErrMsg: "",
+		},
+		{
+			name:           "nil provider",
+			provider:       nil,
+			expectedErrMsg: "'tracerProvider' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bmc := New(defaultHost).WithTracerProvider(testCase.provider)
+
+			assert.Equal(t, testCase.expectedErrMsg, bmc.errorMsg)
+
+			if testCase.expectedErrMsg == "" {
+				assert.Equal(t, testCase.provider, bmc.tracerProvider)
+			}
+		})
+	}
+}
+
+func TestBMCRedfishTracing(t *testing.T) {
+	redfishServer := createFakeRedfishLocalServer(false, redfishAPIResponseCallbacks{})
+	defer redfishServer.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	host := strings.Split(redfishServer.URL, "//")[1]
+	bmc := New(host).WithRedfishUser(defaultUsername, defaultPassword).WithTracerProvider(provider)
+
+	_, err := bmc.SystemManufacturer()
+	assert.NoError(t, err, "Failed to get system manufacturer")
+
+	spans := exporter.GetSpans()
+	assert.NotEmpty(t, spans)
+
+	for _, span := range spans {
+		attributes := attribute.NewSet(span.Attributes...)
+		service, found := attributes.Value(tracing.ServiceKey)
+		assert.True(t, found)
+		assert.Equal(t, "redfish", service.AsString())
+	}
+}
+
 func TestBMCSystemManufacturer(t *testing.T) {
 	respCallbacks := redfishAPIResponseCallbacks{}
 
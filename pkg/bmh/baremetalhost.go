@@ -13,6 +13,7 @@ import (
 
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	"golang.org/x/exp/slices"
@@ -578,21 +579,18 @@ func (builder *BmhBuilder) WaitUntilInStatus(status bmhv1alpha1.ProvisioningStat
 		return err
 	}
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			var err error
-
-			builder.Object, err = builder.Get()
-			if err != nil {
-				return false, nil
-			}
+	klog.V(100).Infof("Waiting for the defined period until baremetalhost %s in namespace %s has state %s",
+		builder.Definition.Name, builder.Definition.Namespace, status)
 
-			if builder.Object.Status.Provisioning.State == status {
-				return true, nil
-			}
+	var err error
 
-			return false, err
+	builder.Object, err = common.WaitUntilObject(context.TODO(), builder.apiClient,
+		bmhv1alpha1.GroupVersion.WithKind("BareMetalHost"), builder.Definition, timeout,
+		func(bmh *bmhv1alpha1.BareMetalHost) (bool, error) {
+			return bmh != nil && bmh.Status.Provisioning.State == status, nil
 		})
+
+	return err
 }
 
 // DeleteAndWaitUntilDeleted delete bmh object and waits until deleted.
@@ -2,12 +2,14 @@ package bmh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -748,7 +750,7 @@ func TestBareMetalHostCreateAndWaitUntilProvisioned(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -763,7 +765,7 @@ func TestBareMetalHostCreateAndWaitUntilProvisioned(t *testing.T) {
 			assert.Equal(t, ipAddressPoolBuilder.Definition.Name, ipAddressPoolBuilder.Object.Name)
 			assert.Equal(t, ipAddressPoolBuilder.Definition.Namespace, ipAddressPoolBuilder.Object.Namespace)
 		} else {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+			assertBmhError(t, testCase.expectedError, err)
 		}
 	}
 }
@@ -779,11 +781,11 @@ func TestBareMetalHostWaitUntilProvisioned(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject(bmhv1alpha1.StateDeprovisioning)),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -794,7 +796,7 @@ func TestBareMetalHostWaitUntilProvisioned(t *testing.T) {
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilProvisioned(1 * time.Millisecond)
 		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+			assertBmhError(t, testCase.expectedError, err)
 		} else {
 			assert.Nil(t, err)
 		}
@@ -812,11 +814,11 @@ func TestBareMetalHostWaitUntilProvisioning(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -827,7 +829,7 @@ func TestBareMetalHostWaitUntilProvisioning(t *testing.T) {
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilProvisioning(1 * time.Millisecond)
 		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+			assertBmhError(t, testCase.expectedError, err)
 		} else {
 			assert.Nil(t, err)
 		}
@@ -845,11 +847,11 @@ func TestBareMetalHostWaitUntilReady(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -860,7 +862,7 @@ func TestBareMetalHostWaitUntilReady(t *testing.T) {
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilReady(1 * time.Millisecond)
 		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+			assertBmhError(t, testCase.expectedError, err)
 		} else {
 			assert.Nil(t, err)
 		}
@@ -878,11 +880,11 @@ func TestBareMetalHostWaitUntilAvailable(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -893,7 +895,7 @@ func TestBareMetalHostWaitUntilAvailable(t *testing.T) {
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilAvailable(1 * time.Millisecond)
 		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+			assertBmhError(t, testCase.expectedError, err)
 		} else {
 			assert.Nil(t, err)
 		}
@@ -911,15 +913,15 @@ func TestBareMetalHostWaitUntilInStatus(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject(bmhv1alpha1.StateProvisioning)),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -930,13 +932,38 @@ func TestBareMetalHostWaitUntilInStatus(t *testing.T) {
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilInStatus(bmhv1alpha1.StateAvailable, 1*time.Millisecond)
 		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+			assertBmhError(t, testCase.expectedError, err)
 		} else {
 			assert.Nil(t, err)
 		}
 	}
 }
 
+func TestBareMetalHostWaitUntilInStatusTransitions(t *testing.T) {
+	testSettings, err := clients.NewTestClientBuilder().
+		WithObjects(buildDummyBmHost(bmhv1alpha1.StateRegistering, bmhv1alpha1.OperationalStatusOK)).
+		WithSchemeAttachers(testSchemes...).
+		WithStatusTransitions(&bmhv1alpha1.BareMetalHost{},
+			clients.AfterReads(2, func(bmh *bmhv1alpha1.BareMetalHost) {
+				bmh.Status.Provisioning.State = bmhv1alpha1.StateProvisioning
+			}),
+			clients.AfterReads(5, func(bmh *bmhv1alpha1.BareMetalHost) {
+				bmh.Status.Provisioning.State = bmhv1alpha1.StateProvisioned
+			})).
+		Build()
+	assert.Nil(t, err)
+
+	testBmHost := buildValidBmHostBuilder(testSettings)
+
+	err = testBmHost.WaitUntilProvisioning(5 * time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, bmhv1alpha1.StateProvisioning, testBmHost.Object.Status.Provisioning.State)
+
+	err = testBmHost.WaitUntilProvisioned(5 * time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, bmhv1alpha1.StateProvisioned, testBmHost.Object.Status.Provisioning.State)
+}
+
 func TestBareMetalHostDeleteAndWaitUntilDeleted(t *testing.T) {
 	testCases := []struct {
 		testBmHost    *BmhBuilder
@@ -1149,3 +1176,17 @@ func buildDummyBmHostObject(
 
 	return append([]runtime.Object{}, buildDummyBmHost(state, operState))
 }
+
+// assertBmhError asserts that err matches expectedError. Context errors are expected to be wrapped in a wait timeout.
+func assertBmhError(t *testing.T, expectedError, err error) {
+	t.Helper()
+
+	if errors.Is(expectedError, context.DeadlineExceeded) {
+		assert.True(t, goinfraerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+		assert.ErrorIs(t, err, expectedError)
+
+		return
+	}
+
+	assert.Equal(t, expectedError.Error(), err.Error())
+}
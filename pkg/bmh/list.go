@@ -140,7 +140,7 @@ func list(apiClient *clients.Settings, options goclient.ListOptions) ([]*BmhBuil
 
 	var bmhList bmhv1alpha1.BareMetalHostList
 
-	err = apiClient.List(logging.DiscardContext(), &bmhList, &options)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, &bmhList, &options)
 	if err != nil {
 		klog.V(100).Infof("Failed to list bareMetalHosts due to %s", err.Error())
 
@@ -0,0 +1,133 @@
+// Package bulk provides functions to create, delete, and wait on many builders at once, with a bound on the number of
+// builders being operated on concurrently. They accept the builders from any package that provides the usual Create,
+// Delete, and Exists methods, such as pod.Builder, nad.Builder, sriov.NetworkBuilder, and siteconfig.CIBuilder.
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultConcurrency is the number of builders operated on at once when the concurrency provided is not positive.
+const DefaultConcurrency = common.DefaultBulkConcurrency
+
+// deletePollInterval is how often DeleteAllAndWait checks whether a deleted resource still exists.
+const deletePollInterval = time.Second
+
+// Result is the result of a bulk operation for a single builder. The bulk functions return a result for each builder,
+// in the same order as the builders, along with an error that joins the errors from all of the results, so it is nil
+// only if the operation succeeded for every builder.
+//
+// The operation is performed on every builder even if it fails for some of them. Builders whose operation has not
+// started when the context is done are skipped and have the context's error as their result.
+type Result[SB any] = common.BulkResult[SB]
+
+// Creator is a builder which can create its resource. SB is the type of the builder itself, such as *pod.Builder,
+// since Create returns the builder it was called on.
+type Creator[SB any] interface {
+	Create() (SB, error)
+}
+
+// Deleter is a builder which can delete its resource and check whether it still exists. Since builders differ in the
+// return values of Delete, it is not part of the interface, but must be either Delete() error or Delete() (SB, error).
+type Deleter interface {
+	Exists() bool
+}
+
+// CreateAll creates the resources for all of the builders, with at most concurrency creates in progress at once. See
+// Result for how the results and errors are returned. If a builder has a CreateWithContext method, it is used instead
+// of Create so that the create stops once ctx is done.
+func CreateAll[SB Creator[SB]](ctx context.Context, builders []SB, concurrency int) ([]Result[SB], error) {
+	return common.RunBulk(ctx, builders, concurrency, func(ctx context.Context, builder SB) error {
+		if contextCreator, ok := any(builder).(interface {
+			CreateWithContext(ctx context.Context) (SB, error)
+		}); ok {
+			_, err := contextCreator.CreateWithContext(ctx)
+
+			return err
+		}
+
+		_, err := builder.Create()
+
+		return err
+	})
+}
+
+// DeleteAllAndWait deletes the resources for all of the builders and waits up to timeout for each of them to no longer
+// exist, with at most concurrency builders being deleted or waited on at once. See Result for how the results and
+// errors are returned. As with the Delete methods of the builders, resources which do not exist are not treated as
+// errors.
+func DeleteAllAndWait[SB Deleter](
+	ctx context.Context, builders []SB, concurrency int, timeout time.Duration) ([]Result[SB], error) {
+	return common.RunBulk(ctx, builders, concurrency, func(ctx context.Context, builder SB) error {
+		err := deleteBuilder(ctx, builder)
+		if err != nil {
+			return err
+		}
+
+		err = wait.PollUntilContextTimeout(
+			ctx, deletePollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+				return !exists(ctx, builder), nil
+			})
+		if err != nil {
+			return fmt.Errorf("failed to wait for %T to be deleted: %w", builder, err)
+		}
+
+		return nil
+	})
+}
+
+// WaitAllReady waits up to timeout for the resource of each of the builders to be ready, with at most concurrency
+// builders being waited on at once. Since what it means to be ready differs between resources, the wait function is
+// provided by the caller, usually as a method expression such as (*pod.Builder).WaitUntilReady. See Result for how the
+// results and errors are returned.
+func WaitAllReady[SB any](
+	ctx context.Context,
+	builders []SB,
+	concurrency int,
+	timeout time.Duration,
+	waitReady func(builder SB, timeout time.Duration) error) ([]Result[SB], error) {
+	return common.RunBulk(ctx, builders, concurrency, func(_ context.Context, builder SB) error {
+		return waitReady(builder, timeout)
+	})
+}
+
+// deleteBuilder deletes the resource for the builder using whichever form of Delete it provides, preferring
+// DeleteWithContext if it exists.
+func deleteBuilder[SB Deleter](ctx context.Context, builder SB) error {
+	switch deleter := any(builder).(type) {
+	case interface {
+		DeleteWithContext(ctx context.Context) error
+	}:
+		return deleter.DeleteWithContext(ctx)
+	case interface {
+		DeleteWithContext(ctx context.Context) (SB, error)
+	}:
+		_, err := deleter.DeleteWithContext(ctx)
+
+		return err
+	case interface{ Delete() error }:
+		return deleter.Delete()
+	case interface{ Delete() (SB, error) }:
+		_, err := deleter.Delete()
+
+		return err
+	default:
+		return fmt.Errorf("cannot delete %T: it has no supported Delete method", builder)
+	}
+}
+
+// exists returns whether the resource for the builder exists, using ExistsWithContext if the builder provides it.
+func exists[SB Deleter](ctx context.Context, builder SB) bool {
+	if contextExister, ok := any(builder).(interface {
+		ExistsWithContext(ctx context.Context) bool
+	}); ok {
+		return contextExister.ExistsWithContext(ctx)
+	}
+
+	return builder.Exists()
+}
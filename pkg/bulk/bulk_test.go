@@ -0,0 +1,190 @@
+package bulk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/deployment"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultBulkNamespace = "bulk-test-namespace"
+	defaultBulkImage     = "test-image"
+)
+
+func TestCreateAll(t *testing.T) {
+	testCases := []struct {
+		names         []string
+		expectedError string
+	}{
+		{
+			names:         []string{"bulk-test-0", "bulk-test-1", "bulk-test-2"},
+			expectedError: "",
+		},
+		{
+			// A pod builder with an empty name is invalid, so only its create should fail.
+			names:         []string{"bulk-test-0", "", "bulk-test-2"},
+			expectedError: "pod 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		podBuilders := buildTestPodBuilders(testSettings, testCase.names)
+
+		results, err := CreateAll(t.Context(), podBuilders, 2)
+		require.Len(t, results, len(podBuilders))
+
+		for index, result := range results {
+			assert.Same(t, podBuilders[index], result.Builder)
+
+			if testCase.names[index] == "" {
+				assert.ErrorContains(t, result.Err, testCase.expectedError)
+
+				continue
+			}
+
+			assert.NoError(t, result.Err)
+			assert.True(t, result.Builder.Exists())
+		}
+
+		if testCase.expectedError == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorContains(t, err, testCase.expectedError)
+		}
+	}
+}
+
+func TestDeleteAllAndWait(t *testing.T) {
+	names := []string{"bulk-test-0", "bulk-test-1", "bulk-test-2"}
+
+	var objects []runtime.Object
+
+	// The last pod and deployment do not exist, which should not be treated as an error.
+	for _, name := range names[:2] {
+		objects = append(objects, buildDummyPod(name), buildDummyDeployment(name))
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects})
+
+	podResults, err := DeleteAllAndWait(t.Context(), buildTestPodBuilders(testSettings, names), 2, time.Second)
+	assert.NoError(t, err)
+	require.Len(t, podResults, len(names))
+
+	for _, result := range podResults {
+		assert.NoError(t, result.Err)
+		assert.False(t, result.Builder.Exists())
+	}
+
+	var deploymentBuilders []*deployment.Builder
+
+	for _, name := range names {
+		deploymentBuilders = append(deploymentBuilders, buildTestDeploymentBuilder(testSettings, name))
+	}
+
+	deploymentResults, err := DeleteAllAndWait(t.Context(), deploymentBuilders, 0, time.Second)
+	assert.NoError(t, err)
+	require.Len(t, deploymentResults, len(names))
+
+	for _, result := range deploymentResults {
+		assert.NoError(t, result.Err)
+		assert.False(t, result.Builder.Exists())
+	}
+}
+
+func TestDeleteAllAndWaitUnsupported(t *testing.T) {
+	results, err := DeleteAllAndWait(t.Context(), []existsOnly{{}}, 1, time.Second)
+	require.Len(t, results, 1)
+	assert.ErrorContains(t, results[0].Err, "has no supported Delete method")
+	assert.ErrorContains(t, err, "has no supported Delete method")
+}
+
+func TestWaitAllReady(t *testing.T) {
+	runningPod := buildDummyPod("bulk-test-running")
+	runningPod.Status.Phase = corev1.PodRunning
+
+	pendingPod := buildDummyPod("bulk-test-pending")
+	pendingPod.Status.Phase = corev1.PodPending
+
+	// The wait watches the pods using the runtime client, so the clients must share a tracker.
+	testSettings, err := clients.NewTestClientBuilder().WithObjects(runningPod, pendingPod).Build()
+	require.NoError(t, err)
+
+	podBuilders := buildTestPodBuilders(testSettings, []string{"bulk-test-running", "bulk-test-pending"})
+
+	results, err := WaitAllReady(t.Context(), podBuilders, 0, time.Second, (*pod.Builder).WaitUntilRunning)
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.Error(t, results[1].Err)
+	assert.Error(t, err)
+}
+
+func TestBulkCancelledContext(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	podBuilders := buildTestPodBuilders(testSettings, []string{"bulk-test-0", "bulk-test-1"})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	results, err := CreateAll(ctx, podBuilders, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, results, 2)
+
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+		assert.False(t, result.Builder.Exists())
+	}
+}
+
+// existsOnly is a builder with an Exists method but no Delete method.
+type existsOnly struct{}
+
+func (existsOnly) Exists() bool {
+	return false
+}
+
+func buildDummyPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultBulkNamespace,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "test", Image: defaultBulkImage}},
+		},
+	}
+}
+
+func buildDummyDeployment(name string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultBulkNamespace,
+		},
+	}
+}
+
+func buildTestPodBuilders(apiClient *clients.Settings, names []string) []*pod.Builder {
+	podBuilders := make([]*pod.Builder, 0, len(names))
+
+	for _, name := range names {
+		podBuilders = append(podBuilders, pod.NewBuilder(apiClient, name, defaultBulkNamespace, defaultBulkImage))
+	}
+
+	return podBuilders
+}
+
+func buildTestDeploymentBuilder(apiClient *clients.Settings, name string) *deployment.Builder {
+	return deployment.NewBuilder(apiClient, name, defaultBulkNamespace, map[string]string{"app": name},
+		corev1.Container{Name: "test", Image: defaultBulkImage})
+}
@@ -49,7 +49,7 @@ func ListSigningRequests(
 
 	csrList := new(certificatesv1.CertificateSigningRequestList)
 
-	err = apiClient.List(logging.DiscardContext(), csrList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, csrList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list CertificateSigningRequests: %v", err)
 
@@ -6,17 +6,26 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// resourceCRD is the kind of the cgu resource, used in the errors returned by the CguBuilder.
+	resourceCRD = "cgu"
+	// preCachingConfigCRD is the kind of the PreCachingConfig resource, used in the errors returned by the
+	// PreCachingConfigBuilder.
+	preCachingConfigCRD = "preCachingConfig"
+)
+
 var conditionComplete = metav1.Condition{Type: "Succeeded", Status: metav1.ConditionTrue}
 
 // CguBuilder provides struct for the cgu object containing connection to
@@ -28,31 +37,34 @@ type CguBuilder struct {
 	Object *v1alpha1.ClusterGroupUpgrade
 	// api client to interact with the cluster.
 	apiClient goclient.Client
+	// clientLogger is the logger of the clients.Settings the builder was created with. See getLogger.
+	clientLogger logr.Logger
 	// used to store latest error message upon defining or mutating application definition.
 	errorMsg string
 }
 
 // NewCguBuilder creates a new instance of CguBuilder.
 func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurrency int) *CguBuilder {
-	klog.V(100).Infof(
-		"Initializing new CGU structure with the following params: name: %s, nsname: %s, maxConcurrency: %d",
-		name, nsname, maxConcurrency)
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "name", name, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info("Initializing new CGU structure", "maxConcurrency", maxConcurrency)
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient for the CGU is nil")
+		logger.V(logging.LevelDebug).Info("The apiClient for the CGU is nil")
 
 		return nil
 	}
 
 	err := apiClient.AttachScheme(v1alpha1.AddToScheme)
 	if err != nil {
-		klog.V(100).Info("Failed to add cgu v1alpha1 scheme to client schemes")
+		logger.V(logging.LevelDebug).Info("Failed to add cgu v1alpha1 scheme to client schemes", "error", err)
 
 		return nil
 	}
 
 	builder := &CguBuilder{
-		apiClient: apiClient.Client,
+		apiClient:    apiClient.Client,
+		clientLogger: logging.FromClient(apiClient),
 		Definition: &v1alpha1.ClusterGroupUpgrade{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -67,7 +79,7 @@ func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurre
 	}
 
 	if name == "" {
-		klog.V(100).Info("The name of the CGU is empty")
+		logger.V(logging.LevelDebug).Info("The name of the CGU is empty")
 
 		builder.errorMsg = "CGU 'name' cannot be empty"
 
@@ -75,7 +87,7 @@ func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurre
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the CGU is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the CGU is empty")
 
 		builder.errorMsg = "CGU 'nsname' cannot be empty"
 
@@ -83,7 +95,7 @@ func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurre
 	}
 
 	if maxConcurrency < 1 {
-		klog.V(100).Info("The maxConcurrency of the CGU has a minimum of 1")
+		logger.V(logging.LevelDebug).Info("The maxConcurrency of the CGU has a minimum of 1")
 
 		builder.errorMsg = "CGU 'maxConcurrency' cannot be less than 1"
 
@@ -100,7 +112,7 @@ func (builder *CguBuilder) WithCluster(cluster string) *CguBuilder {
 	}
 
 	if cluster == "" {
-		klog.V(100).Info("The cluster to be added to the CGU is empty")
+		builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("The cluster to be added to the CGU is empty")
 
 		builder.errorMsg = "cluster in CGU cluster spec cannot be empty"
 
@@ -119,7 +131,8 @@ func (builder *CguBuilder) WithManagedPolicy(policy string) *CguBuilder {
 	}
 
 	if policy == "" {
-		klog.V(100).Info("The policy to be added to the CGU's ManagedPolicies is empty")
+		builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+			"The policy to be added to the CGU's ManagedPolicies is empty")
 
 		builder.errorMsg = "policy in CGU managedpolicies spec cannot be empty"
 
@@ -138,7 +151,8 @@ func (builder *CguBuilder) WithCanary(canary string) *CguBuilder {
 	}
 
 	if canary == "" {
-		klog.V(100).Info("The canary to be added to the CGU's RemediationStrategy is empty")
+		builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+			"The canary to be added to the CGU's RemediationStrategy is empty")
 
 		builder.errorMsg = "canary in CGU remediationstrategy spec cannot be empty"
 
@@ -153,23 +167,31 @@ func (builder *CguBuilder) WithCanary(canary string) *CguBuilder {
 
 // Pull pulls existing cgu into CguBuilder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error) {
-	klog.V(100).Infof("Pulling existing cgu name %s under namespace %s from cluster", name, nsname)
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the same as Pull but uses the provided context for the API call.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*CguBuilder, error) {
+	logger := logging.FromContext(ctx, apiClient).WithValues("kind", resourceCRD, "name", name, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info("Pulling existing cgu from cluster")
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty")
 
-		return nil, fmt.Errorf("cgu 'apiClient' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "cgu 'apiClient' cannot be empty")
 	}
 
 	err := apiClient.AttachScheme(v1alpha1.AddToScheme)
 	if err != nil {
-		klog.V(100).Info("Failed to add cgu v1alpha1 scheme to client schemes")
+		logger.V(logging.LevelDebug).Info("Failed to add cgu v1alpha1 scheme to client schemes", "error", err)
 
 		return nil, err
 	}
 
 	builder := CguBuilder{
-		apiClient: apiClient.Client,
+		apiClient:    apiClient.Client,
+		clientLogger: logging.FromClient(apiClient),
 		Definition: &v1alpha1.ClusterGroupUpgrade{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -179,19 +201,19 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error)
 	}
 
 	if name == "" {
-		klog.V(100).Info("The name of the cgu is empty")
+		logger.V(logging.LevelDebug).Info("The name of the cgu is empty")
 
-		return nil, fmt.Errorf("cgu 'name' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "cgu 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the cgu is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the cgu is empty")
 
-		return nil, fmt.Errorf("cgu 'namespace' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "cgu 'namespace' cannot be empty")
 	}
 
-	if !builder.Exists() {
-		return nil, fmt.Errorf("cgu object %s does not exist in namespace %s", name, nsname)
+	if !builder.ExistsWithContext(ctx) {
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -201,23 +223,26 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error)
 
 // Get returns ClusterGroupUpgrade object if found.
 func (builder *CguBuilder) Get() (*v1alpha1.ClusterGroupUpgrade, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the same as Get but uses the provided context for the API call.
+func (builder *CguBuilder) GetWithContext(ctx context.Context) (*v1alpha1.ClusterGroupUpgrade, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	klog.V(100).Infof(
-		"Collecting clusterGroupUpgrade object %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelDebug).Info("Collecting clusterGroupUpgrade object")
 
 	clusterGroupUpgrade := &v1alpha1.ClusterGroupUpgrade{}
 
-	err := builder.apiClient.Get(logging.DiscardContext(),
+	err := builder.apiClient.Get(logging.DiscardContextFrom(ctx),
 		goclient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		clusterGroupUpgrade)
 	if err != nil {
-		klog.V(100).Infof(
-			"clusterGroupUpgrade object %s does not exist in namespace %s",
-			builder.Definition.Name, builder.Definition.Namespace)
+		logger.V(logging.LevelDebug).Info("Failed to get clusterGroupUpgrade object", "error", err)
 
 		return nil, err
 	}
@@ -227,34 +252,44 @@ func (builder *CguBuilder) Get() (*v1alpha1.ClusterGroupUpgrade, error) {
 
 // Exists checks whether the given cgu exists.
 func (builder *CguBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the same as Exists but uses the provided context for the API call.
+func (builder *CguBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	klog.V(100).Infof("Checking if cgu %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info("Checking if cgu exists")
 
 	var err error
 
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Create makes a cgu in the cluster and stores the created object in struct.
 func (builder *CguBuilder) Create() (*CguBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the same as Create but uses the provided context for all API calls.
+func (builder *CguBuilder) CreateWithContext(ctx context.Context) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Creating the cgu %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info("Creating the cgu")
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(logging.DiscardContext(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(logging.DiscardContextFrom(ctx), builder.Definition)
 		if err != nil {
-			klog.V(100).Info("Failed to create clusterGroupUpgrade")
+			logger.V(logging.LevelDebug).Info("Failed to create clusterGroupUpgrade", "error", err)
 
 			return nil, err
 		}
@@ -267,23 +302,28 @@ func (builder *CguBuilder) Create() (*CguBuilder, error) {
 
 // Delete removes a cgu from a cluster.
 func (builder *CguBuilder) Delete() (*CguBuilder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the same as Delete but uses the provided context for all API calls.
+func (builder *CguBuilder) DeleteWithContext(ctx context.Context) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Deleting the cgu %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
 
-	if !builder.Exists() {
-		klog.V(100).Infof("cgu %s in namespace %s does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelOperation).Info("Deleting the cgu")
+
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("The cgu does not exist")
 
 		builder.Object = nil
 
 		return builder, nil
 	}
 
-	err := builder.apiClient.Delete(logging.DiscardContext(), builder.Definition)
+	err := builder.apiClient.Delete(logging.DiscardContextFrom(ctx), builder.Definition)
 	if err != nil {
 		return builder, fmt.Errorf("can not delete cgu: %w", err)
 	}
@@ -295,30 +335,38 @@ func (builder *CguBuilder) Delete() (*CguBuilder, error) {
 
 // Update renovates the existing cgu object with the cgu definition in builder.
 func (builder *CguBuilder) Update(force bool) (*CguBuilder, error) {
+	return builder.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the same as Update but uses the provided context for all API calls, including the wait for
+// deletion when force is true.
+func (builder *CguBuilder) UpdateWithContext(ctx context.Context, force bool) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Updating the cgu object %s", builder.Definition.Name)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info("Updating the cgu object", "force", force)
 
-	err := builder.apiClient.Update(logging.DiscardContext(), builder.Definition)
+	err := builder.apiClient.Update(logging.DiscardContextFrom(ctx), builder.Definition)
 	if err == nil {
 		builder.Object = builder.Definition
 	} else if force {
-		klog.V(100).Infof("%v", msg.FailToUpdateNotification("cgu", builder.Definition.Name))
+		logger.V(logging.LevelDebug).Info(msg.FailToUpdateNotification("cgu", builder.Definition.Name), "error", err)
 
 		// Deleting the cgu may take time, so wait for it to be deleted before recreating. Otherwise,
 		// the create happens before the delete finishes and this update results in just deletion.
-		builder, err := builder.DeleteAndWait(time.Minute)
+		builder, err := builder.DeleteAndWaitWithContext(ctx, time.Minute)
 		builder.Definition.ResourceVersion = ""
 
 		if err != nil {
-			klog.V(100).Infof("%v", msg.FailToUpdateError("cgu", builder.Definition.Name))
+			logger.V(logging.LevelDebug).Info(msg.FailToUpdateError("cgu", builder.Definition.Name), "error", err)
 
 			return nil, err
 		}
 
-		return builder.Create()
+		return builder.CreateWithContext(ctx)
 	}
 
 	return builder, err
@@ -326,76 +374,99 @@ func (builder *CguBuilder) Update(force bool) (*CguBuilder, error) {
 
 // DeleteAndWait deletes the cgu object and waits until the cgu is deleted.
 func (builder *CguBuilder) DeleteAndWait(timeout time.Duration) (*CguBuilder, error) {
+	return builder.DeleteAndWaitWithContext(context.TODO(), timeout)
+}
+
+// DeleteAndWaitWithContext is the same as DeleteAndWait but uses the provided context for all API calls and for the
+// wait.
+func (builder *CguBuilder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Deleting cgu %s in namespace %s and waiting for the defined period until it is removed",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info(
+		"Deleting cgu and waiting for the defined period until it is removed", "timeout", timeout)
 
-	builder, err := builder.Delete()
+	builder, err := builder.DeleteWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	err = builder.WaitUntilDeletedWithContext(ctx, timeout)
 
 	return builder, err
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the cgu is deleted.
 func (builder *CguBuilder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilDeletedWithContext is the same as WaitUntilDeleted but stops waiting once the provided context is done.
+func (builder *CguBuilder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof(
-		"Waiting for the defined period until cgu %s in namespace %s is deleted",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info("Waiting for the defined period until cgu is deleted", "timeout", timeout)
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			_, err := builder.Get()
+	err := wait.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.GetWithContext(ctx)
 			if err == nil {
-				klog.V(100).Infof("cgu %s/%s still present", builder.Definition.Name, builder.Definition.Namespace)
+				logger.V(logging.LevelDebug).Info("The cgu is still present")
 
 				return false, nil
 			}
 
 			if k8serrors.IsNotFound(err) {
-				klog.V(100).Infof("cgu %s/%s is gone", builder.Definition.Name, builder.Definition.Namespace)
+				logger.V(logging.LevelDebug).Info("The cgu is gone")
 
 				return true, nil
 			}
 
-			klog.V(100).Infof("failed to get cgu %s/%s: %v", builder.Definition.Name, builder.Definition.Namespace, err)
+			logger.V(logging.LevelDebug).Info("Failed to get cgu", "error", err)
 
 			return false, err
 		})
+
+	return goinfraerrors.WrapWaitError(resourceCRD, builder.Definition.Name, builder.Definition.Namespace, nil, err)
 }
 
 // WaitForCondition waits until the CGU has a condition that matches the expected, checking only the Type, Status,
 // Reason, and Message fields. For the message field, it matches if the message contains the expected. Zero fields in
 // the expected condition are ignored.
 func (builder *CguBuilder) WaitForCondition(expected metav1.Condition, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitForConditionWithContext(context.TODO(), expected, timeout)
+}
+
+// WaitForConditionWithContext is the same as WaitForCondition but stops waiting once the provided context is done.
+func (builder *CguBuilder) WaitForConditionWithContext(
+	ctx context.Context, expected metav1.Condition, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	if !builder.Exists() {
-		klog.V(100).Info("The CGU does not exist on the cluster")
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info(
+		"Waiting for the cgu to have the expected condition", "condition", expected, "timeout", timeout)
 
-		return builder, fmt.Errorf(
-			"cgu object %s does not exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("The CGU does not exist on the cluster")
+
+		return builder, goinfraerrors.NewNotFound(resourceCRD, builder.Definition.Name, builder.Definition.Namespace)
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
 
-			builder.Object, err = builder.Get()
+			builder.Object, err = builder.GetWithContext(ctx)
 			if err != nil {
-				klog.V(100).Infof("failed to get cgu %s/%s: %v", builder.Definition.Name, builder.Definition.Namespace, err)
+				logger.V(logging.LevelDebug).Info("Failed to get cgu", "error", err)
 
 				return false, nil
 			}
@@ -424,56 +495,69 @@ func (builder *CguBuilder) WaitForCondition(expected metav1.Condition, timeout t
 
 			return false, nil
 		})
+	if err != nil {
+		return builder, goinfraerrors.WrapWaitError(
+			resourceCRD, builder.Definition.Name, builder.Definition.Namespace, builder.Object, err)
+	}
 
-	return builder, err
+	return builder, nil
 }
 
 // WaitUntilComplete waits the specified timeout for the CGU to complete.
 func (builder *CguBuilder) WaitUntilComplete(timeout time.Duration) (*CguBuilder, error) {
-	return builder.WaitForCondition(conditionComplete, timeout)
+	return builder.WaitUntilCompleteWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilCompleteWithContext is the same as WaitUntilComplete but stops waiting once the provided context is done.
+func (builder *CguBuilder) WaitUntilCompleteWithContext(ctx context.Context, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitForConditionWithContext(ctx, conditionComplete, timeout)
 }
 
 // WaitUntilClusterInState waits the specified timeout for a cluster in the CGU to be in the specified state.
 func (builder *CguBuilder) WaitUntilClusterInState(cluster, state string, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilClusterInStateWithContext(context.TODO(), cluster, state, timeout)
+}
+
+// WaitUntilClusterInStateWithContext is the same as WaitUntilClusterInState but stops waiting once the provided context
+// is done.
+func (builder *CguBuilder) WaitUntilClusterInStateWithContext(
+	ctx context.Context, cluster, state string, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
+	logger := builder.getLogger(ctx).WithValues("cluster", cluster, "state", state)
+
 	if cluster == "" {
-		klog.V(100).Info("Cluster name cannot be empty")
+		logger.V(logging.LevelDebug).Info("Cluster name cannot be empty")
 
-		return nil, fmt.Errorf("cluster name cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "cluster name cannot be empty")
 	}
 
 	if state == "" {
-		klog.V(100).Info("State cannot be empty")
+		logger.V(logging.LevelDebug).Info("State cannot be empty")
 
-		return nil, fmt.Errorf("state cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "state cannot be empty")
 	}
 
-	klog.V(100).Infof(
-		"Waiting until cluster %s on CGU %s in namespace %s is in state %s",
-		cluster, builder.Definition.Name, builder.Definition.Namespace, state)
+	logger.V(logging.LevelOperation).Info("Waiting until cluster on CGU is in state", "timeout", timeout)
 
-	if !builder.Exists() {
-		return nil, fmt.Errorf(
-			"cgu object %s does not exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	if !builder.ExistsWithContext(ctx) {
+		return nil, goinfraerrors.NewNotFound(resourceCRD, builder.Definition.Name, builder.Definition.Namespace)
 	}
 
 	var err error
 
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			builder.Object, err = builder.Get()
+		ctx, 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			builder.Object, err = builder.GetWithContext(ctx)
 			if err != nil {
 				return false, nil
 			}
 
 			status, ok := builder.Object.Status.Status.CurrentBatchRemediationProgress[cluster]
 			if !ok {
-				klog.V(100).Infof(
-					"cluster %s not found in batch remediation progress for cgu %s in namespace %s",
-					cluster, builder.Definition.Name, builder.Definition.Namespace)
+				logger.V(logging.LevelDebug).Info("Cluster not found in batch remediation progress for cgu")
 
 				return false, nil
 			}
@@ -481,7 +565,8 @@ func (builder *CguBuilder) WaitUntilClusterInState(cluster, state string, timeou
 			return status.State == state, nil
 		})
 	if err != nil {
-		return nil, err
+		return nil, goinfraerrors.WrapWaitError(
+			resourceCRD, builder.Definition.Name, builder.Definition.Namespace, builder.Object, err)
 	}
 
 	return builder, nil
@@ -489,36 +574,56 @@ func (builder *CguBuilder) WaitUntilClusterInState(cluster, state string, timeou
 
 // WaitUntilClusterComplete waits the specified timeout for a cluster in the CGU to complete remidation.
 func (builder *CguBuilder) WaitUntilClusterComplete(cluster string, timeout time.Duration) (*CguBuilder, error) {
-	return builder.WaitUntilClusterInState(cluster, v1alpha1.Completed, timeout)
+	return builder.WaitUntilClusterCompleteWithContext(context.TODO(), cluster, timeout)
+}
+
+// WaitUntilClusterCompleteWithContext is the same as WaitUntilClusterComplete but stops waiting once the provided
+// context is done.
+func (builder *CguBuilder) WaitUntilClusterCompleteWithContext(
+	ctx context.Context, cluster string, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilClusterInStateWithContext(ctx, cluster, v1alpha1.Completed, timeout)
 }
 
 // WaitUntilClusterInProgress waits the specified timeout for a cluster in the CGU to start remidation.
 func (builder *CguBuilder) WaitUntilClusterInProgress(cluster string, timeout time.Duration) (*CguBuilder, error) {
-	return builder.WaitUntilClusterInState(cluster, v1alpha1.InProgress, timeout)
+	return builder.WaitUntilClusterInProgressWithContext(context.TODO(), cluster, timeout)
+}
+
+// WaitUntilClusterInProgressWithContext is the same as WaitUntilClusterInProgress but stops waiting once the provided
+// context is done.
+func (builder *CguBuilder) WaitUntilClusterInProgressWithContext(
+	ctx context.Context, cluster string, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilClusterInStateWithContext(ctx, cluster, v1alpha1.InProgress, timeout)
 }
 
 // WaitUntilBackupStarts waits the specified timeout for the backup to start.
 func (builder *CguBuilder) WaitUntilBackupStarts(timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilBackupStartsWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilBackupStartsWithContext is the same as WaitUntilBackupStarts but stops waiting once the provided context is
+// done.
+func (builder *CguBuilder) WaitUntilBackupStartsWithContext(ctx context.Context, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof(
-		"Waiting for CGU %s in namespace %s to start backup", builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info("Waiting for CGU to start backup", "timeout", timeout)
 
-	if !builder.Exists() {
-		klog.V(100).Info("The CGU does not exist on the cluster")
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("The CGU does not exist on the cluster")
 
-		return builder, fmt.Errorf("%s", builder.errorMsg)
+		return builder, goinfraerrors.NewNotFound(resourceCRD, builder.Definition.Name, builder.Definition.Namespace)
 	}
 
 	var err error
 
-	err = wait.PollUntilContextTimeout(context.TODO(), 3*time.Second, timeout, true, func(context.Context) (bool, error) {
-		builder.Object, err = builder.Get()
+	err = wait.PollUntilContextTimeout(ctx, 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		builder.Object, err = builder.GetWithContext(ctx)
 		if err != nil {
-			klog.V(100).Infof(
-				"Failed to get CGU %s in namespace %s due to: %v", builder.Definition.Name, builder.Definition.Namespace, err)
+			logger.V(logging.LevelDebug).Info("Failed to get CGU", "error", err)
 
 			return false, nil
 		}
@@ -529,41 +634,56 @@ func (builder *CguBuilder) WaitUntilBackupStarts(timeout time.Duration) (*CguBui
 		return builder, nil
 	}
 
-	klog.V(100).Infof(
-		"Failed to wait for CGU %s in namespace %s to start backup due to: %v",
-		builder.Definition.Name, builder.Definition.Namespace, err)
+	logger.V(logging.LevelDebug).Info("Failed to wait for CGU to start backup", "error", err)
 
-	return nil, err
+	return nil, goinfraerrors.WrapWaitError(
+		resourceCRD, builder.Definition.Name, builder.Definition.Namespace, builder.Object, err)
 }
 
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *CguBuilder) validate() (bool, error) {
-	resourceCRD := "cgu"
+	logger := builder.getLogger(context.TODO())
 
 	if builder == nil {
-		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder is uninitialized")
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		klog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder definition is undefined")
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder apiClient is nil")
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelDebug).Info("The builder has an error message", "errorMsg", builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
 }
+
+// getLogger returns the logger for an operation on the cgu. The logger from the context takes precedence over that of
+// the client the builder was created with. It is safe to call on a nil builder.
+func (builder *CguBuilder) getLogger(ctx context.Context) logr.Logger {
+	if builder == nil {
+		return logging.FromContextOr(ctx, logr.Logger{}).WithValues("kind", resourceCRD)
+	}
+
+	logger := logging.FromContextOr(ctx, builder.clientLogger).WithValues("kind", resourceCRD)
+
+	if builder.Definition != nil {
+		logger = logger.WithValues("name", builder.Definition.Name, "namespace", builder.Definition.Namespace)
+	}
+
+	return logger
+}
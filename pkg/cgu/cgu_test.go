@@ -2,12 +2,16 @@ package cgu
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -283,7 +287,7 @@ func TestCguCreate(t *testing.T) {
 		},
 		{
 			testCgu:       buildInvalidCguTestBuilder(buildTestClientWithDummyCguObject()),
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "CGU 'nsname' cannot be empty"),
 		},
 	}
 
@@ -312,7 +316,7 @@ func TestCguDelete(t *testing.T) {
 		},
 		{
 			testCgu:       buildInvalidCguTestBuilder(buildTestClientWithDummyCguObject()),
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "CGU 'nsname' cannot be empty"),
 		},
 	}
 
@@ -398,7 +402,7 @@ func TestCguDeleteAndWait(t *testing.T) {
 		},
 		{
 			testCgu:       buildInvalidCguTestBuilder(buildTestClientWithDummyCguObject()),
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "CGU 'nsname' cannot be empty"),
 		},
 	}
 
@@ -428,13 +432,13 @@ func TestCguWaitUntilDeleted(t *testing.T) {
 		},
 		{
 			testCgu:       buildInvalidCguTestBuilder(buildTestClientWithDummyCguObject()),
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "CGU 'nsname' cannot be empty"),
 		},
 	}
 
 	for _, testCase := range testCases {
 		err := testCase.testCgu.WaitUntilDeleted(time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+		assertCguWaitError(t, testCase.expectedError, err)
 
 		if testCase.expectedError == nil {
 			assert.Nil(t, testCase.testCgu.Object)
@@ -462,7 +466,7 @@ func TestCguWaitForCondition(t *testing.T) {
 			exists:        false,
 			conditionMet:  true,
 			valid:         true,
-			expectedError: fmt.Errorf("cgu object %s does not exist in namespace %s", defaultCguName, defaultCguNsName),
+			expectedError: goinfraerrors.NewNotFound(resourceCRD, defaultCguName, defaultCguNsName),
 		},
 		{
 			condition:     defaultCguCondition,
@@ -476,7 +480,7 @@ func TestCguWaitForCondition(t *testing.T) {
 			exists:        true,
 			conditionMet:  true,
 			valid:         false,
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "CGU 'nsname' cannot be empty"),
 		},
 	}
 
@@ -508,7 +512,45 @@ func TestCguWaitForCondition(t *testing.T) {
 		}
 
 		_, err := cguBuilder.WaitForCondition(testCase.condition, time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+		assertCguWaitError(t, testCase.expectedError, err)
+	}
+}
+
+func TestCguWaitForConditionWithContext(t *testing.T) {
+	testCases := []struct {
+		cancelled     bool
+		cancelAfter   time.Duration
+		expectedError error
+	}{
+		{
+			cancelled:     true,
+			expectedError: context.Canceled,
+		},
+		{
+			cancelAfter:   100 * time.Millisecond,
+			expectedError: context.Canceled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		cguBuilder := buildValidCguTestBuilder(buildTestClientWithDummyCguObject())
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		if testCase.cancelled {
+			cancel()
+		}
+
+		// The condition is never met, so only cancelling the context can stop the wait before the timeout.
+		if testCase.cancelAfter > 0 {
+			time.AfterFunc(testCase.cancelAfter, cancel)
+		}
+
+		start := time.Now()
+		_, err := cguBuilder.WaitForConditionWithContext(ctx, defaultCguCondition, time.Minute)
+		assertCguWaitError(t, testCase.expectedError, err)
+		assert.Less(t, time.Since(start), 10*time.Second)
+
+		cancel()
 	}
 }
 
@@ -542,10 +584,45 @@ func TestCguWaitUntilComplete(t *testing.T) {
 		cguBuilder := buildValidCguTestBuilder(testSettings)
 		_, err := cguBuilder.WaitUntilComplete(time.Second)
 
-		assert.Equal(t, testCase.expectedError, err)
+		assertCguWaitError(t, testCase.expectedError, err)
 	}
 }
 
+func TestCguWaitStatusTransitions(t *testing.T) {
+	setClusterState := func(state string) func(*v1alpha1.ClusterGroupUpgrade) {
+		return func(cgu *v1alpha1.ClusterGroupUpgrade) {
+			cgu.Status.Status.CurrentBatchRemediationProgress = map[string]*v1alpha1.ClusterRemediationProgress{
+				defaultCguClusterName: {State: state},
+			}
+		}
+	}
+
+	// Each wait checks that the CGU exists and then reads it once right away, so every transition happens on the
+	// first poll of the wait that expects it.
+	testSettings, err := clients.NewTestClientBuilder().
+		WithObjects(buildDummyCgu(defaultCguName, defaultCguNsName, defaultCguMaxConcurrency)).
+		WithSchemeAttachers(testSchemes...).
+		WithStatusTransitions(&v1alpha1.ClusterGroupUpgrade{},
+			clients.AfterReads(1, setClusterState(v1alpha1.InProgress)),
+			clients.AfterReads(3, setClusterState(v1alpha1.Completed)),
+			clients.AfterReads(5, func(cgu *v1alpha1.ClusterGroupUpgrade) {
+				cgu.Status.Conditions = append(cgu.Status.Conditions, conditionComplete)
+			})).
+		Build()
+	assert.Nil(t, err)
+
+	cguBuilder := buildValidCguTestBuilder(testSettings)
+
+	_, err = cguBuilder.WaitUntilClusterInProgress(defaultCguClusterName, 10*time.Second)
+	assert.Nil(t, err)
+
+	_, err = cguBuilder.WaitUntilClusterComplete(defaultCguClusterName, 10*time.Second)
+	assert.Nil(t, err)
+
+	_, err = cguBuilder.WaitUntilComplete(10 * time.Second)
+	assert.Nil(t, err)
+}
+
 func TestCguWaitUntilClusterInState(t *testing.T) {
 	testCases := []struct {
 		cluster       string
@@ -569,7 +646,7 @@ func TestCguWaitUntilClusterInState(t *testing.T) {
 			exists:        true,
 			inState:       true,
 			valid:         true,
-			expectedError: fmt.Errorf("cluster name cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "cluster name cannot be empty"),
 		},
 		{
 			cluster:       defaultCguClusterName,
@@ -577,7 +654,7 @@ func TestCguWaitUntilClusterInState(t *testing.T) {
 			exists:        true,
 			inState:       true,
 			valid:         true,
-			expectedError: fmt.Errorf("state cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "state cannot be empty"),
 		},
 		{
 			cluster:       defaultCguClusterName,
@@ -585,7 +662,7 @@ func TestCguWaitUntilClusterInState(t *testing.T) {
 			exists:        false,
 			inState:       true,
 			valid:         true,
-			expectedError: fmt.Errorf("cgu object %s does not exist in namespace %s", defaultCguName, defaultCguNsName),
+			expectedError: goinfraerrors.NewNotFound(resourceCRD, defaultCguName, defaultCguNsName),
 		},
 		{
 			cluster:       defaultCguClusterName,
@@ -601,7 +678,7 @@ func TestCguWaitUntilClusterInState(t *testing.T) {
 			exists:        true,
 			inState:       true,
 			valid:         false,
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "CGU 'nsname' cannot be empty"),
 		},
 	}
 
@@ -635,7 +712,7 @@ func TestCguWaitUntilClusterInState(t *testing.T) {
 		}
 
 		_, err := cguBuilder.WaitUntilClusterInState(testCase.cluster, testCase.state, time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+		assertCguWaitError(t, testCase.expectedError, err)
 	}
 }
 
@@ -671,7 +748,7 @@ func TestCguWaitUntilClusterComplete(t *testing.T) {
 		cguBuilder := buildValidCguTestBuilder(testSettings)
 		_, err := cguBuilder.WaitUntilClusterComplete(defaultCguClusterName, time.Second)
 
-		assert.Equal(t, testCase.expectedError, err)
+		assertCguWaitError(t, testCase.expectedError, err)
 	}
 }
 
@@ -707,7 +784,7 @@ func TestCguWaitUntilClusterInProgress(t *testing.T) {
 		cguBuilder := buildValidCguTestBuilder(testSettings)
 		_, err := cguBuilder.WaitUntilClusterInProgress(defaultCguClusterName, time.Second)
 
-		assert.Equal(t, testCase.expectedError, err)
+		assertCguWaitError(t, testCase.expectedError, err)
 	}
 }
 
@@ -726,6 +803,32 @@ func TestWaitUntilBackupStarts(t *testing.T) {
 	assert.Equal(t, cguBuilder.Object.Namespace, defaultCguNsName)
 }
 
+func TestCguLogging(t *testing.T) {
+	var contextEntries, clientEntries []string
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+	testSettings.Logger = funcr.New(func(_, args string) {
+		clientEntries = append(clientEntries, args)
+	}, funcr.Options{Verbosity: logging.LevelOperation})
+
+	ctx := logr.NewContext(context.TODO(), funcr.New(func(_, args string) {
+		contextEntries = append(contextEntries, args)
+	}, funcr.Options{Verbosity: logging.LevelOperation}))
+
+	cguBuilder, err := buildValidCguTestBuilder(testSettings).CreateWithContext(ctx)
+	assert.Nil(t, err)
+
+	_, err = cguBuilder.Delete()
+	assert.Nil(t, err)
+
+	// The logger of the context takes precedence, while the logger of the client is used without one.
+	assert.Len(t, contextEntries, 1)
+	assert.Contains(t, contextEntries[0], `"msg"="Creating the cgu"`)
+	assert.Contains(t, contextEntries[0], `"kind"="cgu" "name"="cgu-test" "namespace"="test-ns"`)
+	assert.Len(t, clientEntries, 1)
+	assert.Contains(t, clientEntries[0], `"msg"="Deleting the cgu"`)
+}
+
 func TestCguBuilderValidate(t *testing.T) {
 	testCases := []struct {
 		builderNil    bool
@@ -745,21 +848,21 @@ func TestCguBuilderValidate(t *testing.T) {
 			builderNil:    true,
 			definitionNil: false,
 			apiClientNil:  false,
-			expectedError: fmt.Errorf("error: received nil cgu builder"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil cgu builder"),
 			builderErrMsg: "",
 		},
 		{
 			builderNil:    false,
 			definitionNil: true,
 			apiClientNil:  false,
-			expectedError: fmt.Errorf("can not redefine the undefined cgu"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "can not redefine the undefined cgu"),
 			builderErrMsg: "",
 		},
 		{
 			builderNil:    false,
 			definitionNil: false,
 			apiClientNil:  true,
-			expectedError: fmt.Errorf("cgu builder cannot have nil apiClient"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "cgu builder cannot have nil apiClient"),
 			builderErrMsg: "",
 		},
 		{
@@ -767,7 +870,7 @@ func TestCguBuilderValidate(t *testing.T) {
 			definitionNil: false,
 			apiClientNil:  false,
 			builderErrMsg: "test error",
-			expectedError: fmt.Errorf("test error"),
+			expectedError: goinfraerrors.NewInvalidBuilder(resourceCRD, "test error"),
 		},
 	}
 
@@ -843,3 +946,18 @@ func buildInvalidCguTestBuilder(apiClient *clients.Settings) *CguBuilder {
 		"",
 		defaultCguMaxConcurrency)
 }
+
+// assertCguWaitError asserts that err matches expectedError. Context errors are expected to be wrapped in a wait
+// timeout, while all other errors must be equal.
+func assertCguWaitError(t *testing.T, expectedError, err error) {
+	t.Helper()
+
+	if errors.Is(expectedError, context.DeadlineExceeded) || errors.Is(expectedError, context.Canceled) {
+		assert.True(t, goinfraerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+		assert.ErrorIs(t, err, expectedError)
+
+		return
+	}
+
+	assert.Equal(t, expectedError, err)
+}
@@ -6,46 +6,44 @@ import (
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
-	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ListInAllNamespaces returns a cluster-wide cgu inventory.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...client.ListOptions) ([]*CguBuilder, error) {
-	logMessage := "Listing CGUS in all namespaces"
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
 	passedOptions := client.ListOptions{}
 
 	if apiClient == nil {
-		klog.V(100).Info("CGUs 'apiClient' parameter can not be empty")
+		logger.V(logging.LevelDebug).Info("CGUs 'apiClient' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list cgu objects, 'apiClient' parameter is empty")
 	}
 
 	err := apiClient.AttachScheme(v1alpha1.AddToScheme)
 	if err != nil {
-		klog.V(100).Info("Failed to add cgu v1alpha1 scheme to client schemes")
+		logger.V(logging.LevelDebug).Info("Failed to add cgu v1alpha1 scheme to client schemes", "error", err)
 
 		return nil, err
 	}
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Infof("%v", logMessage)
+	logger.V(logging.LevelDebug).Info("Listing CGUs in all namespaces", "options", passedOptions)
 
 	cguList := &v1alpha1.ClusterGroupUpgradeList{}
 
-	err = apiClient.List(logging.DiscardContext(), cguList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, cguList, &passedOptions)
 	if err != nil {
-		klog.V(100).Infof("Failed to list all CGUs in all namespaces due to %s", err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list all CGUs in all namespaces", "error", err)
 
 		return nil, err
 	}
@@ -55,9 +53,10 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...client.ListOpti
 	for _, policy := range cguList.Items {
 		copiedCgu := policy
 		cguBuilder := &CguBuilder{
-			apiClient:  apiClient.Client,
-			Object:     &copiedCgu,
-			Definition: &copiedCgu,
+			apiClient:    apiClient.Client,
+			clientLogger: logging.FromClient(apiClient),
+			Object:       &copiedCgu,
+			Definition:   &copiedCgu,
 		}
 
 		cguObjects = append(cguObjects, cguBuilder)
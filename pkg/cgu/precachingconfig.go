@@ -1,15 +1,16 @@
 package cgu
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/go-logr/logr"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -22,30 +23,34 @@ type PreCachingConfigBuilder struct {
 	Object *v1alpha1.PreCachingConfig
 	// api client to interact with the cluster.
 	apiClient runtimeclient.Client
+	// clientLogger is the logger of the clients.Settings the builder was created with. See getLogger.
+	clientLogger logr.Logger
 	// used to store latest error message upon defining or mutating application definition.
 	errorMsg string
 }
 
 // NewPreCachingConfigBuilder creates a new instance of PreCachingConfig.
 func NewPreCachingConfigBuilder(apiClient *clients.Settings, name, nsname string) *PreCachingConfigBuilder {
-	klog.V(100).Infof(
-		"Initializing new PreCachingConfig structure with the following params: name: %s, nsname: %s", name, nsname)
+	logger := logging.FromClient(apiClient).WithValues("kind", preCachingConfigCRD, "name", name, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info("Initializing new PreCachingConfig structure")
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient for the PreCachingConfig is nil")
+		logger.V(logging.LevelDebug).Info("The apiClient for the PreCachingConfig is nil")
 
 		return nil
 	}
 
 	err := apiClient.AttachScheme(v1alpha1.AddToScheme)
 	if err != nil {
-		klog.V(100).Info("Failed to add cgu v1alpha1 scheme to client schemes")
+		logger.V(logging.LevelDebug).Info("Failed to add cgu v1alpha1 scheme to client schemes", "error", err)
 
 		return nil
 	}
 
 	builder := &PreCachingConfigBuilder{
-		apiClient: apiClient.Client,
+		apiClient:    apiClient.Client,
+		clientLogger: logging.FromClient(apiClient),
 		Definition: &v1alpha1.PreCachingConfig{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -54,7 +59,7 @@ func NewPreCachingConfigBuilder(apiClient *clients.Settings, name, nsname string
 		}}
 
 	if name == "" {
-		klog.V(100).Info("The name of the PreCachingConfig is empty")
+		logger.V(logging.LevelDebug).Info("The name of the PreCachingConfig is empty")
 
 		builder.errorMsg = "preCachingConfig 'name' cannot be empty"
 
@@ -62,7 +67,7 @@ func NewPreCachingConfigBuilder(apiClient *clients.Settings, name, nsname string
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the PreCachingConfig is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the PreCachingConfig is empty")
 
 		builder.errorMsg = "preCachingConfig 'nsname' cannot be empty"
 
@@ -74,23 +79,26 @@ func NewPreCachingConfigBuilder(apiClient *clients.Settings, name, nsname string
 
 // PullPreCachingConfig pulls an existing PreCachingConfig into a PreCachingConfigBuilder struct.
 func PullPreCachingConfig(apiClient *clients.Settings, name, nsname string) (*PreCachingConfigBuilder, error) {
-	klog.V(100).Infof("Pulling existing PreCachingConfig %s under namespace %s from cluster", name, nsname)
+	logger := logging.FromClient(apiClient).WithValues("kind", preCachingConfigCRD, "name", name, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info("Pulling existing PreCachingConfig from cluster")
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty")
 
-		return nil, fmt.Errorf("preCachingConfig 'apiClient' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "preCachingConfig 'apiClient' cannot be empty")
 	}
 
 	err := apiClient.AttachScheme(v1alpha1.AddToScheme)
 	if err != nil {
-		klog.V(100).Info("Failed to add cgu v1alpha1 scheme to client schemes")
+		logger.V(logging.LevelDebug).Info("Failed to add cgu v1alpha1 scheme to client schemes", "error", err)
 
 		return nil, err
 	}
 
 	builder := PreCachingConfigBuilder{
-		apiClient: apiClient.Client,
+		apiClient:    apiClient.Client,
+		clientLogger: logging.FromClient(apiClient),
 		Definition: &v1alpha1.PreCachingConfig{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -100,19 +108,19 @@ func PullPreCachingConfig(apiClient *clients.Settings, name, nsname string) (*Pr
 	}
 
 	if name == "" {
-		klog.V(100).Info("The name of the PreCachingConfig is empty")
+		logger.V(logging.LevelDebug).Info("The name of the PreCachingConfig is empty")
 
-		return nil, fmt.Errorf("preCachingConfig 'name' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "preCachingConfig 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the PreCachingConfig is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the PreCachingConfig is empty")
 
-		return nil, fmt.Errorf("preCachingConfig 'nsname' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "preCachingConfig 'nsname' cannot be empty")
 	}
 
 	if !builder.Exists() {
-		return nil, fmt.Errorf("preCachingConfig object %s does not exist in namespace %s", name, nsname)
+		return nil, goinfraerrors.NewNotFound(preCachingConfigCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -126,8 +134,7 @@ func (builder *PreCachingConfigBuilder) Exists() bool {
 		return false
 	}
 
-	klog.V(100).Infof(
-		"Checking if preCachingConfig %s exists in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Checking if preCachingConfig exists")
 
 	var err error
 
@@ -142,7 +149,7 @@ func (builder *PreCachingConfigBuilder) Get() (*v1alpha1.PreCachingConfig, error
 		return nil, err
 	}
 
-	klog.V(100).Infof("Getting PreCachingConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Getting PreCachingConfig")
 
 	preCachingConfig := &v1alpha1.PreCachingConfig{}
 
@@ -163,8 +170,7 @@ func (builder *PreCachingConfigBuilder) Create() (*PreCachingConfigBuilder, erro
 		return nil, err
 	}
 
-	klog.V(100).Infof(
-		"Creating the PreCachingConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelOperation).Info("Creating the PreCachingConfig")
 
 	if builder.Exists() {
 		return builder, nil
@@ -186,8 +192,7 @@ func (builder *PreCachingConfigBuilder) Delete() error {
 		return err
 	}
 
-	klog.V(100).Infof(
-		"Deleting the PreCachingConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelOperation).Info("Deleting the PreCachingConfig")
 
 	if !builder.Exists() {
 		builder.Object = nil
@@ -212,17 +217,19 @@ func (builder *PreCachingConfigBuilder) Update(force bool) (*PreCachingConfigBui
 		return nil, err
 	}
 
-	klog.V(100).Infof(
-		"Updating the PreCachingConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelOperation).Info("Updating the PreCachingConfig", "force", force)
 
 	err := builder.apiClient.Update(logging.DiscardContext(), builder.Definition)
 	if err != nil {
 		if force {
-			klog.V(100).Infof("%v", msg.FailToUpdateNotification("preCachingConfig", builder.Definition.Name))
+			logger.V(logging.LevelDebug).Info(
+				msg.FailToUpdateNotification("preCachingConfig", builder.Definition.Name), "error", err)
 
 			err := builder.Delete()
 			if err != nil {
-				klog.V(100).Infof("%v", msg.FailToUpdateError("preCachingConfig", builder.Definition.Name))
+				logger.V(logging.LevelDebug).Info(msg.FailToUpdateError("preCachingConfig", builder.Definition.Name), "error", err)
 
 				return nil, err
 			}
@@ -240,31 +247,50 @@ func (builder *PreCachingConfigBuilder) Update(force bool) (*PreCachingConfigBui
 
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *PreCachingConfigBuilder) validate() (bool, error) {
-	resourceCRD := "preCachingConfig"
+	logger := builder.getLogger(context.TODO())
 
 	if builder == nil {
-		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder is uninitialized")
 
-		return false, fmt.Errorf("error received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(
+			preCachingConfigCRD, "error received nil %s builder", preCachingConfigCRD)
 	}
 
 	if builder.Definition == nil {
-		klog.V(100).Infof("The %s is uninitialized", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder definition is undefined")
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(
+			preCachingConfigCRD, "%s", msg.UndefinedCrdObjectErrString(preCachingConfigCRD))
 	}
 
 	if builder.apiClient == nil {
-		klog.V(100).Infof("The %s builder apiClient is nil", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder apiClient is nil")
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(
+			preCachingConfigCRD, "%s builder cannot have nil apiClient", preCachingConfigCRD)
 	}
 
 	if builder.errorMsg != "" {
-		klog.V(100).Infof("The %s builder has error message %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelDebug).Info("The builder has an error message", "errorMsg", builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
 }
+
+// getLogger returns the logger for an operation on the PreCachingConfig, in the same way as CguBuilder.getLogger. It is
+// safe to call on a nil builder.
+func (builder *PreCachingConfigBuilder) getLogger(ctx context.Context) logr.Logger {
+	if builder == nil {
+		return logging.FromContextOr(ctx, logr.Logger{}).WithValues("kind", preCachingConfigCRD)
+	}
+
+	logger := logging.FromContextOr(ctx, builder.clientLogger).WithValues("kind", preCachingConfigCRD)
+
+	if builder.Definition != nil {
+		logger = logger.WithValues("name", builder.Definition.Name, "namespace", builder.Definition.Namespace)
+	}
+
+	return logger
+}
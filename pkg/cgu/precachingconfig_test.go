@@ -6,6 +6,7 @@ import (
 
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -70,7 +71,7 @@ func TestPullPreCachingConfig(t *testing.T) {
 			addToRuntimeObjects:       false,
 			client:                    true,
 			expectedErrorText: fmt.Sprintf(
-				"preCachingConfig object %s does not exist in namespace %s",
+				"precachingconfig object %s does not exist in namespace %s",
 				defaultPreCachingConfigName, defaultPreCachingConfigNsName),
 		},
 		{
@@ -190,7 +191,7 @@ func TestPreCachingConfigCreate(t *testing.T) {
 		},
 		{
 			testBuilder:   buildInvalidPreCachingConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("preCachingConfig 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "preCachingConfig 'nsname' cannot be empty"),
 		},
 	}
 
@@ -215,7 +216,7 @@ func TestPreCachingConfigDelete(t *testing.T) {
 		},
 		{
 			testBuilder:   buildInvalidPreCachingConfigTestBuilder(buildTestClientWithDummyPreCachingConfig()),
-			expectedError: fmt.Errorf("preCachingConfig 'nsname' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "preCachingConfig 'nsname' cannot be empty"),
 		},
 	}
 
@@ -302,21 +303,23 @@ func TestPreCachingConfigValidate(t *testing.T) {
 			builderNil:    true,
 			definitionNil: false,
 			apiClientNil:  false,
-			expectedError: fmt.Errorf("error received nil preCachingConfig builder"),
+			expectedError: goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "error received nil preCachingConfig builder"),
 			builderErrMsg: "",
 		},
 		{
 			builderNil:    false,
 			definitionNil: true,
 			apiClientNil:  false,
-			expectedError: fmt.Errorf("can not redefine the undefined preCachingConfig"),
+			expectedError: goinfraerrors.NewInvalidBuilder(
+				preCachingConfigCRD, "can not redefine the undefined preCachingConfig"),
 			builderErrMsg: "",
 		},
 		{
 			builderNil:    false,
 			definitionNil: false,
 			apiClientNil:  true,
-			expectedError: fmt.Errorf("preCachingConfig builder cannot have nil apiClient"),
+			expectedError: goinfraerrors.NewInvalidBuilder(
+				preCachingConfigCRD, "preCachingConfig builder cannot have nil apiClient"),
 			builderErrMsg: "",
 		},
 		{
@@ -324,7 +327,7 @@ func TestPreCachingConfigValidate(t *testing.T) {
 			definitionNil: false,
 			apiClientNil:  false,
 			builderErrMsg: "test error",
-			expectedError: fmt.Errorf("test error"),
+			expectedError: goinfraerrors.NewInvalidBuilder(preCachingConfigCRD, "test error"),
 		},
 	}
 
@@ -0,0 +1,169 @@
+package clients
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	cassetteOperationGet         = "get"
+	cassetteOperationList        = "list"
+	cassetteOperationCreate      = "create"
+	cassetteOperationUpdate      = "update"
+	cassetteOperationPatch       = "patch"
+	cassetteOperationDelete      = "delete"
+	cassetteOperationDeleteAllOf = "deleteallof"
+)
+
+// InteractionKey identifies the request an Interaction was recorded for. During replay, interactions are matched
+// using their keys.
+type InteractionKey struct {
+	// Operation is the client method that was called, such as get, list, create, update, patch, delete, or
+	// deleteallof.
+	Operation string `json:"operation"`
+	// Subresource is the subresource the request was for, such as status, or empty if there is none.
+	Subresource string `json:"subresource,omitempty"`
+	APIVersion  string `json:"apiVersion"`
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+	// LabelSelector is the label selector of a list or deleteallof, or empty if there is none.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// FieldSelector is the field selector of a list or deleteallof, or empty if there is none.
+	FieldSelector string `json:"fieldSelector,omitempty"`
+}
+
+// String returns a short, human-readable description of the key.
+func (key InteractionKey) String() string {
+	operation := key.Operation
+	if key.Subresource != "" {
+		operation += "/" + key.Subresource
+	}
+
+	description := fmt.Sprintf("%s %s %s %s/%s", operation, key.APIVersion, key.Kind, key.Namespace, key.Name)
+
+	if key.LabelSelector != "" {
+		description += " labels=" + key.LabelSelector
+	}
+
+	if key.FieldSelector != "" {
+		description += " fields=" + key.FieldSelector
+	}
+
+	return description
+}
+
+// Interaction is a single request and response pair recorded by a RecordingClient.
+type Interaction struct {
+	InteractionKey `json:",inline"`
+	// Request is the object or patch sent with the request, if there was one. It is recorded for reference only and
+	// is not used to match interactions during replay.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Response is the object returned by the request, if there was one.
+	Response json.RawMessage `json:"response,omitempty"`
+	// Error is the status of the error returned by the request, if there was one. Errors that are not API errors are
+	// recorded as a status with only the message set.
+	Error *metav1.Status `json:"error,omitempty"`
+}
+
+// Cassette is an ordered collection of interactions which may be saved to a file and later replayed.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// LoadCassette reads a cassette from the JSON file at the provided path.
+func LoadCassette(path string) (*Cassette, error) {
+	klog.V(100).Infof("Loading cassette from %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette %s: %w", path, err)
+	}
+
+	cassette := &Cassette{}
+
+	err = json.Unmarshal(data, cassette)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cassette %s: %w", path, err)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the cassette as JSON to the file at the provided path, overwriting it if it exists.
+func (cassette *Cassette) Save(path string) error {
+	if cassette == nil {
+		return fmt.Errorf("cannot save nil cassette")
+	}
+
+	klog.V(100).Infof("Saving cassette with %d interactions to %s", len(cassette.Interactions), path)
+
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+
+	err = os.WriteFile(path, data, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write cassette %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// newInteractionKey creates the key for an interaction, using the client to look up the GroupVersionKind of the
+// object. The listOptions are only provided for lists and deleteallofs, so that requests with different selectors
+// have different keys.
+func newInteractionKey(
+	client runtimeClient.Client,
+	operation, subResource, namespace, name string,
+	obj runtime.Object,
+	listOptions *runtimeClient.ListOptions) InteractionKey {
+	key := InteractionKey{
+		Operation:   operation,
+		Subresource: subResource,
+		Namespace:   namespace,
+		Name:        name,
+	}
+
+	if listOptions != nil {
+		if listOptions.LabelSelector != nil {
+			key.LabelSelector = listOptions.LabelSelector.String()
+		}
+
+		if listOptions.FieldSelector != nil {
+			key.FieldSelector = listOptions.FieldSelector.String()
+		}
+	}
+
+	gvk, err := client.GroupVersionKindFor(obj)
+	if err != nil {
+		klog.V(100).Infof("Failed to get GroupVersionKind for object of type %T: %v", obj, err)
+
+		return key
+	}
+
+	key.APIVersion, key.Kind = gvk.ToAPIVersionAndKind()
+
+	return key
+}
+
+// newCassetteStatus converts an error to a status so it may be saved in a cassette.
+func newCassetteStatus(err error) *metav1.Status {
+	var status k8serrors.APIStatus
+	if errors.As(err, &status) {
+		errStatus := status.Status()
+
+		return &errStatus
+	}
+
+	return &metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+}
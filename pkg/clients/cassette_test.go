@@ -0,0 +1,153 @@
+package clients
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	cassetteTestName      = "cassette-test-name"
+	cassetteTestNamespace = "cassette-test-namespace"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	testSettings, recorder, err := GetTestClients(TestClientParams{}).NewRecording()
+	require.NoError(t, err)
+
+	recordedResults := runCassetteTestFlow(testSettings)
+
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+	err = recorder.Cassette().Save(cassettePath)
+	require.NoError(t, err)
+
+	cassette, err := LoadCassette(cassettePath)
+	require.NoError(t, err)
+	assert.Len(t, cassette.Interactions, 6)
+
+	replaySettings := GetReplayTestClients(TestClientParams{}, cassette)
+	replayClient, ok := replaySettings.Client.(*ReplayClient)
+	require.True(t, ok)
+
+	replayedResults := runCassetteTestFlow(replaySettings)
+	assert.Equal(t, recordedResults, replayedResults)
+	assert.Equal(t, 0, replayClient.Remaining())
+
+	// Gets beyond those recorded repeat the last response while mutations fail.
+	err = replaySettings.Get(context.TODO(), runtimeClient.ObjectKey{
+		Name: cassetteTestName, Namespace: cassetteTestNamespace}, &corev1.ConfigMap{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	err = replaySettings.Create(context.TODO(), buildCassetteTestConfigMap())
+	assert.ErrorContains(t, err, "no recorded interaction remaining")
+}
+
+func TestRecordAndReplaySelectorsAndGenerateName(t *testing.T) {
+	testSettings, recorder, err := GetTestClients(TestClientParams{}).NewRecording()
+	require.NoError(t, err)
+
+	recordedResults := runCassetteTestSelectorFlow(t, testSettings)
+	assert.Equal(t, []string{"first", "second"}, recordedResults[1:])
+
+	replaySettings := GetReplayTestClients(TestClientParams{}, recorder.Cassette())
+	replayClient, ok := replaySettings.Client.(*ReplayClient)
+	require.True(t, ok)
+
+	// The generated name is only known after the create, so the replayed create must match without it, and lists with
+	// different selectors must each get their own response.
+	replayedResults := runCassetteTestSelectorFlow(t, replaySettings)
+	assert.Equal(t, recordedResults, replayedResults)
+	assert.Equal(t, 0, replayClient.Remaining())
+}
+
+func TestRecordingClientNewRecordingErrors(t *testing.T) {
+	var nilSettings *Settings
+
+	_, _, err := nilSettings.NewRecording()
+	assert.EqualError(t, err, "cannot record using nil client")
+
+	_, _, err = (&Settings{}).NewRecording()
+	assert.EqualError(t, err, "cannot record without runtime client")
+}
+
+func TestLoadCassetteMissingFile(t *testing.T) {
+	_, err := LoadCassette(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorContains(t, err, "failed to read cassette")
+}
+
+// runCassetteTestFlow creates, updates, and deletes a ConfigMap, returning the data and errors observed along the way.
+func runCassetteTestFlow(apiClient *Settings) []string {
+	var results []string
+
+	configMap := buildCassetteTestConfigMap()
+	key := runtimeClient.ObjectKeyFromObject(configMap)
+
+	addResult := func(err error) {
+		if err != nil {
+			results = append(results, err.Error())
+
+			return
+		}
+
+		results = append(results, configMap.Data["stage"])
+	}
+
+	addResult(apiClient.Create(context.TODO(), configMap))
+	addResult(apiClient.Get(context.TODO(), key, configMap))
+
+	configMap.Data["stage"] = "updated"
+	addResult(apiClient.Update(context.TODO(), configMap))
+
+	configMap = &corev1.ConfigMap{}
+	addResult(apiClient.Get(context.TODO(), key, configMap))
+	addResult(apiClient.Delete(context.TODO(), configMap))
+	addResult(apiClient.Get(context.TODO(), key, &corev1.ConfigMap{}))
+
+	return results
+}
+
+// runCassetteTestSelectorFlow creates a ConfigMap using generateName and two labeled ConfigMaps, then lists each label
+// separately. It returns the generated name followed by the names found by each list.
+func runCassetteTestSelectorFlow(t *testing.T, apiClient *Settings) []string {
+	t.Helper()
+
+	generated := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		GenerateName: "generated-", Namespace: cassetteTestNamespace}}
+	require.NoError(t, apiClient.Create(context.TODO(), generated))
+	require.NotEmpty(t, generated.Name)
+
+	results := []string{generated.Name}
+
+	for _, name := range []string{"first", "second"} {
+		require.NoError(t, apiClient.Create(context.TODO(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: cassetteTestNamespace, Labels: map[string]string{"name": name}}}))
+	}
+
+	for _, name := range []string{"first", "second"} {
+		configMapList := &corev1.ConfigMapList{}
+		require.NoError(t, apiClient.List(context.TODO(), configMapList,
+			runtimeClient.InNamespace(cassetteTestNamespace), runtimeClient.MatchingLabels{"name": name}))
+		require.Len(t, configMapList.Items, 1)
+
+		results = append(results, configMapList.Items[0].Name)
+	}
+
+	return results
+}
+
+func buildCassetteTestConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cassetteTestName,
+			Namespace: cassetteTestNamespace,
+		},
+		Data: map[string]string{"stage": "created"},
+	}
+}
@@ -1,9 +1,13 @@
 package clients
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"os"
+	"net/http"
 
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
@@ -14,6 +18,7 @@ import (
 	apiExt "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	appsV1Client "k8s.io/client-go/kubernetes/typed/apps/v1"
 	networkV1Client "k8s.io/client-go/kubernetes/typed/networking/v1"
 	rbacV1Client "k8s.io/client-go/kubernetes/typed/rbac/v1"
@@ -64,53 +69,86 @@ type Settings struct {
 	machinev1beta1client.MachineV1beta1Interface
 	storageV1Client.StorageV1Interface
 	policyv1clientTyped.PolicyV1Interface
-	scheme *runtime.Scheme
+	// RetryPolicy controls how the common builder functions retry transient API errors. If nil, API calls are not
+	// retried. It may be overridden for a single call using WithRetryPolicy.
+	RetryPolicy *RetryPolicy
+	// Logger receives the logs of the common builder functions and the pod, deployment, configmap, and cgu builders
+	// using this client. If it is the zero logger, logs are sent to klog at V(100) and above. It may be overridden for a
+	// single call by adding a logger to the context using logr.NewContext. Builders in all other packages always log to
+	// klog at V(100) and ignore this logger.
+	Logger logr.Logger
+	// TracerProvider is used to create spans around the operations of the common builder functions using this client.
+	// If nil, operations are only traced when the context passed to them contains a span, using that span's provider.
+	// Any TracerProvider may be used, such as one from the OpenTelemetry SDK; tests can record the spans using the
+	// in-memory exporter of the SDK's tracetest package.
+	TracerProvider trace.TracerProvider
+	// Metrics collects the counts, latencies, and retries of the operations of the common builder functions using
+	// this client, as well as the creates, deletes, and waits of the pod, deployment, and configmap builders. If nil, no
+	// metrics are collected.
+	Metrics *Metrics
+	scheme  *runtime.Scheme
 }
 
 // SchemeAttacher represents a function that can modify the clients current schemes.
 type SchemeAttacher func(*runtime.Scheme) error
 
-// New returns a *Settings with the given kubeconfig.
+// New returns a *Settings with the given kubeconfig. If kubeconfig is empty, the KUBECONFIG environment variable is
+// used and, if that is also empty, the in-cluster config. It returns nil on any error; use NewWithOptions to get the
+// error instead.
 func New(kubeconfig string) *Settings {
-	var (
-		config *rest.Config
-		err    error
-	)
+	clientSet, err := NewWithOptions(kubeconfig)
+	if err != nil {
+		klog.V(100).Infof("Failed to create apiClient: %v", err)
 
-	if kubeconfig == "" {
-		kubeconfig = os.Getenv("KUBECONFIG")
+		return nil
 	}
 
-	if kubeconfig != "" {
-		klog.V(100).Infof("Loading kube client config from path %s", kubeconfig)
+	return clientSet
+}
+
+// NewFromKubeconfigData returns a *Settings from the contents of a kubeconfig file, such as one stored in a secret.
+// The KubeconfigPath of the returned Settings is left empty since there is no file backing it.
+func NewFromKubeconfigData(kubeconfig []byte) (*Settings, error) {
+	if len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("kubeconfig data cannot be empty")
+	}
 
-		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
-	} else {
-		klog.V(100).Info("Using in-cluster kube client config")
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		klog.V(100).Infof("Failed to load kubeconfig data: %v", err)
 
-		config, err = rest.InClusterConfig()
+		return nil, fmt.Errorf("failed to load kubeconfig data: %w", err)
 	}
 
+	return newSettingsFromConfig(config)
+}
+
+// newSettingsFromConfig creates all of the clients for a new Settings from the provided rest config. The
+// KubeconfigPath is left empty for the caller to set.
+func newSettingsFromConfig(config *rest.Config) (*Settings, error) {
+	httpClient, err := rest.HTTPClientFor(config)
 	if err != nil {
-		klog.V(100).Infof("Failed to load kubeconfig: %v", err)
+		klog.V(100).Info("Error to create apiClient HTTP client")
 
-		return nil
+		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
 	}
 
-	clientSet := &Settings{}
-	clientSet.CoreV1Interface = coreV1Client.NewForConfigOrDie(config)
-	clientSet.ConfigV1Interface = clientConfigV1.NewForConfigOrDie(config)
-	clientSet.AppsV1Interface = appsV1Client.NewForConfigOrDie(config)
-	clientSet.NetworkingV1Interface = networkV1Client.NewForConfigOrDie(config)
-	clientSet.RbacV1Interface = rbacV1Client.NewForConfigOrDie(config)
-	clientSet.Interface = dynamic.NewForConfigOrDie(config)
-	clientSet.SecurityV1Interface = v1security.NewForConfigOrDie(config)
-	clientSet.OperatorV1alpha1Interface = operatorv1alpha1.NewForConfigOrDie(config)
-	clientSet.MachineV1beta1Interface = machinev1beta1client.NewForConfigOrDie(config)
-	clientSet.StorageV1Interface = storageV1Client.NewForConfigOrDie(config)
-	clientSet.PolicyV1Interface = policyv1clientTyped.NewForConfigOrDie(config)
-	clientSet.K8sClient = kubernetes.NewForConfigOrDie(config)
-	clientSet.Config = config
+	return newSettingsFromConfigAndClient(config, httpClient, false)
+}
+
+// newSettingsFromConfigAndClient creates all of the clients for a new Settings from the provided rest config, sharing
+// httpClient between them. If lazyRuntimeClient is true, the runtime client and its REST mapper are only created on
+// first use. The KubeconfigPath is left empty for the caller to set.
+func newSettingsFromConfigAndClient(
+	config *rest.Config, httpClient *http.Client, lazyRuntimeClient bool) (*Settings, error) {
+	clientSet := &Settings{Config: config}
+
+	err := clientSet.setTypedClients(config, httpClient)
+	if err != nil {
+		klog.V(100).Info("Error to create apiClient clientsets")
+
+		return nil, fmt.Errorf("failed to create clientsets: %w", err)
+	}
 
 	clientSet.scheme = runtime.NewScheme()
 
@@ -118,21 +156,64 @@ func New(kubeconfig string) *Settings {
 	if err != nil {
 		klog.V(100).Info("Error to load apiClient scheme")
 
-		return nil
+		return nil, fmt.Errorf("failed to load apiClient scheme: %w", err)
 	}
 
-	clientSet.Client, err = runtimeClient.New(config, runtimeClient.Options{
-		Scheme: clientSet.scheme,
-	})
+	runtimeOptions := runtimeClient.Options{
+		HTTPClient: httpClient,
+		Scheme:     clientSet.scheme,
+	}
+
+	if lazyRuntimeClient {
+		clientSet.Client = newLazyClient(config, runtimeOptions)
+
+		return clientSet, nil
+	}
+
+	clientSet.Client, err = runtimeClient.NewWithWatch(config, runtimeOptions)
 	if err != nil {
 		klog.V(100).Info("Error to create apiClient")
 
-		return nil
+		return nil, fmt.Errorf("failed to create runtime client: %w", err)
 	}
 
-	clientSet.KubeconfigPath = kubeconfig
+	return clientSet, nil
+}
 
-	return clientSet
+// setTypedClients sets all of the typed clientsets and the dynamic client of the Settings, sharing httpClient between
+// them.
+func (settings *Settings) setTypedClients(config *rest.Config, httpClient *http.Client) error {
+	var (
+		err  error
+		errs []error
+	)
+
+	settings.CoreV1Interface, err = coreV1Client.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.ConfigV1Interface, err = clientConfigV1.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.AppsV1Interface, err = appsV1Client.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.NetworkingV1Interface, err = networkV1Client.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.RbacV1Interface, err = rbacV1Client.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.Interface, err = dynamic.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.SecurityV1Interface, err = v1security.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.OperatorV1alpha1Interface, err = operatorv1alpha1.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.MachineV1beta1Interface, err = machinev1beta1client.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.StorageV1Interface, err = storageV1Client.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.PolicyV1Interface, err = policyv1clientTyped.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+	settings.K8sClient, err = kubernetes.NewForConfigAndClient(config, httpClient)
+	errs = append(errs, err)
+
+	return errors.Join(errs...)
 }
 
 // SetScheme returns mutated apiClient's scheme.
@@ -183,6 +264,15 @@ func (settings *Settings) GetAPIClient() (*Settings, error) {
 	return settings, nil
 }
 
+// GetLogger returns the Logger of the Settings, which is the zero logger if none was set.
+func (settings *Settings) GetLogger() logr.Logger {
+	if settings == nil {
+		return logr.Logger{}
+	}
+
+	return settings.Logger
+}
+
 // AttachScheme attaches a scheme to the client's current scheme.
 func (settings *Settings) AttachScheme(attacher SchemeAttacher) error {
 	if settings == nil {
@@ -199,6 +289,26 @@ func (settings *Settings) AttachScheme(attacher SchemeAttacher) error {
 	return nil
 }
 
+// Watch implements the runtimeClient.WithWatch interface so that callers holding the Settings as a runtimeClient.Client
+// can watch resources. It returns an error if the underlying client does not support watches.
+func (settings *Settings) Watch(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) (watch.Interface, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, fmt.Errorf("cannot watch using nil client")
+	}
+
+	watchClient, ok := settings.Client.(runtimeClient.WithWatch)
+	if !ok {
+		klog.V(100).Infof("The client of type %T does not support watches", settings.Client)
+
+		return nil, fmt.Errorf("client of type %T does not support watches", settings.Client)
+	}
+
+	return watchClient.Watch(ctx, list, opts...)
+}
+
 // TestClientParams provides the struct to store the parameters for the test client.
 type TestClientParams struct {
 	K8sMockObjects   []runtime.Object
@@ -207,7 +317,8 @@ type TestClientParams struct {
 	InterceptorFuncs interceptor.Funcs
 }
 
-// GetTestClients returns a fake clientset for testing.
+// GetTestClients returns a fake clientset for testing. Only the mock objects of the types listed in
+// GetModifiableTestClients are added to the typed and dynamic clients; use NewTestClientBuilder for other types.
 func GetTestClients(tcp TestClientParams) *Settings {
 	clientSet, testBuilder := GetModifiableTestClients(tcp)
 	clientSet.Client = testBuilder.Build()
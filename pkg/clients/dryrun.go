@@ -0,0 +1,507 @@
+package clients
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// DryRunMode controls what happens to mutating requests sent through a dry-run Settings.
+type DryRunMode int
+
+const (
+	// DryRunServer sends mutating requests to the API server with dryRun=All. Admission, defaulting, and schema
+	// validation all run, but nothing is persisted.
+	DryRunServer DryRunMode = iota
+	// DryRunLocal records mutating requests without sending them. Creates and updates respond with the request body,
+	// patches respond with the current form of the resource, and deletes respond with a success status. Patches of
+	// resources which do not exist on the cluster are answered locally when possible: a server-side apply responds
+	// with the request body as though it created the resource, and other patches of a resource created earlier in the
+	// same plan respond with the form it was created with and are marked as Unverified.
+	DryRunLocal
+)
+
+// readOnlyPostResources are the resources that are sent as a POST but neither persist nor change anything on the
+// cluster, such as access reviews. Requests for them are sent unchanged and are not recorded in either mode.
+var readOnlyPostResources = map[schema.GroupResource]bool{
+	{Group: "authentication.k8s.io", Resource: "tokenreviews"}:             true,
+	{Group: "authentication.k8s.io", Resource: "selfsubjectreviews"}:       true,
+	{Group: "authorization.k8s.io", Resource: "subjectaccessreviews"}:      true,
+	{Group: "authorization.k8s.io", Resource: "localsubjectaccessreviews"}: true,
+	{Group: "authorization.k8s.io", Resource: "selfsubjectaccessreviews"}:  true,
+	{Group: "authorization.k8s.io", Resource: "selfsubjectrulesreviews"}:   true,
+}
+
+// readOnlyPostSubresources are the core subresources, keyed by resource/subresource, that are sent as a POST without
+// modifying the resource. These include connecting to a pod and requesting a service account token.
+var readOnlyPostSubresources = map[string]bool{
+	"pods/exec":             true,
+	"pods/attach":           true,
+	"pods/portforward":      true,
+	"serviceaccounts/token": true,
+}
+
+// namespaceSubresources are the subresources of a namespace. Since their paths have the form
+// namespaces/{name}/{subresource}, they would otherwise be parsed as a resource in the namespace.
+var namespaceSubresources = map[string]bool{
+	"status":   true,
+	"finalize": true,
+}
+
+// PlannedOperation is a single mutating request recorded while in dry-run mode.
+type PlannedOperation struct {
+	// Verb is one of create, update, patch, delete, or deletecollection.
+	Verb string
+	// Resource is the resource the request targets. The Group is empty for core resources.
+	Resource schema.GroupVersionResource
+	// Namespace is the namespace of the request, or empty for cluster-scoped resources.
+	Namespace string
+	// Name is the name of the resource, or empty for creates and collection deletes.
+	Name string
+	// Subresource is the subresource of the request, such as status, or empty if there is none.
+	Subresource string
+	// Body is the request body as it was sent by the client.
+	Body []byte
+	// StatusCode is the HTTP status code of the response. For DryRunServer it is the code returned by the API
+	// server, so codes of 400 or greater indicate the request would have been rejected.
+	StatusCode int
+	// Unverified is true if the outcome of the request could not be determined, such as a DryRunLocal patch of a
+	// resource that was created earlier in the same plan and so does not exist on the cluster.
+	Unverified bool
+}
+
+// String returns a short, human-readable description of the operation.
+func (operation PlannedOperation) String() string {
+	target := operation.Resource.Resource
+	if operation.Subresource != "" {
+		target += "/" + operation.Subresource
+	}
+
+	if operation.Namespace != "" {
+		target += " " + operation.Namespace + "/" + operation.Name
+	} else if operation.Name != "" {
+		target += " " + operation.Name
+	}
+
+	if operation.Unverified {
+		return fmt.Sprintf("%s %s (%d, unverified)", operation.Verb, target, operation.StatusCode)
+	}
+
+	return fmt.Sprintf("%s %s (%d)", operation.Verb, target, operation.StatusCode)
+}
+
+// localObject is the form of a resource last sent in a DryRunLocal create, update, or apply, so later patches of a
+// resource that does not exist on the cluster may still be answered.
+type localObject struct {
+	body        []byte
+	contentType string
+}
+
+// localObjectKey identifies a resource created or updated locally.
+type localObjectKey struct {
+	resource  schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// Plan collects the mutating requests sent through a dry-run Settings. It is safe for concurrent use.
+type Plan struct {
+	mutex        sync.Mutex
+	operations   []PlannedOperation
+	localObjects map[localObjectKey]localObject
+}
+
+// Operations returns a copy of all of the operations recorded so far, in the order they were sent.
+func (plan *Plan) Operations() []PlannedOperation {
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+
+	return slices.Clone(plan.operations)
+}
+
+// OperationsWithVerb returns the recorded operations with the provided verb, such as create or delete.
+func (plan *Plan) OperationsWithVerb(verb string) []PlannedOperation {
+	var filtered []PlannedOperation
+
+	for _, operation := range plan.Operations() {
+		if operation.Verb == verb {
+			filtered = append(filtered, operation)
+		}
+	}
+
+	return filtered
+}
+
+// Failed returns the recorded operations that the API server rejected.
+func (plan *Plan) Failed() []PlannedOperation {
+	var failed []PlannedOperation
+
+	for _, operation := range plan.Operations() {
+		if operation.StatusCode >= http.StatusBadRequest {
+			failed = append(failed, operation)
+		}
+	}
+
+	return failed
+}
+
+// Reset removes all of the recorded operations.
+func (plan *Plan) Reset() {
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+
+	plan.operations = nil
+	plan.localObjects = nil
+}
+
+// String returns the recorded operations, one per line.
+func (plan *Plan) String() string {
+	var builder strings.Builder
+
+	for _, operation := range plan.Operations() {
+		builder.WriteString(operation.String())
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+func (plan *Plan) record(operation PlannedOperation) {
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+
+	plan.operations = append(plan.operations, operation)
+}
+
+// storeLocalObject records the form of the resource sent by a DryRunLocal request. Protobuf bodies can only be decoded
+// for built-in types, so resources whose name cannot be decoded are not stored.
+func (plan *Plan) storeLocalObject(operation PlannedOperation, object localObject) {
+	name := operation.Name
+	if name == "" {
+		objectMeta, err := decodeObjectMeta(object.body, object.contentType)
+		if err != nil {
+			klog.V(100).Infof("Failed to decode %s, later patches of it cannot be answered: %v",
+				operation.Resource.Resource, err)
+
+			return
+		}
+
+		name = objectMeta.GetName()
+	}
+
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+
+	if plan.localObjects == nil {
+		plan.localObjects = make(map[localObjectKey]localObject)
+	}
+
+	plan.localObjects[localObjectKey{resource: operation.Resource, namespace: operation.Namespace, name: name}] = object
+}
+
+// localObject returns the form of the resource last sent by a DryRunLocal create, update, or apply, if there is one.
+func (plan *Plan) localObject(operation PlannedOperation) (localObject, bool) {
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+
+	object, ok := plan.localObjects[localObjectKey{
+		resource: operation.Resource, namespace: operation.Namespace, name: operation.Name}]
+
+	return object, ok
+}
+
+// NewDryRun returns a copy of the Settings where every mutating request, from any of the clients it contains, is
+// handled according to mode and recorded in the returned Plan. Read requests, including POSTs that do not modify the
+// cluster such as pod exec, token requests, and access reviews, are sent to the API server unchanged. The
+// Settings must have been created from a rest config, such as through New, since the requests are intercepted at the
+// transport level.
+func (settings *Settings) NewDryRun(mode DryRunMode) (*Settings, *Plan, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, nil, fmt.Errorf("cannot create dry-run client from nil client")
+	}
+
+	if settings.Config == nil {
+		klog.V(100).Info("The APIClient has no rest config")
+
+		return nil, nil, fmt.Errorf("cannot create dry-run client without rest config")
+	}
+
+	klog.V(100).Infof("Creating dry-run client with mode %d", mode)
+
+	plan := &Plan{}
+	config := rest.CopyConfig(settings.Config)
+	config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &dryRunRoundTripper{next: roundTripper, mode: mode, plan: plan}
+	})
+
+	dryRunSettings, err := newSettingsFromConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dryRunSettings.KubeconfigPath = settings.KubeconfigPath
+	dryRunSettings.RetryPolicy = settings.RetryPolicy
+	dryRunSettings.Logger = settings.Logger
+	dryRunSettings.TracerProvider = settings.TracerProvider
+	dryRunSettings.Metrics = settings.Metrics
+
+	return dryRunSettings, plan, nil
+}
+
+// dryRunRoundTripper intercepts mutating requests, either adding the dryRun=All query parameter or answering them
+// locally, and records them in the plan.
+type dryRunRoundTripper struct {
+	next http.RoundTripper
+	mode DryRunMode
+	plan *Plan
+}
+
+var _ http.RoundTripper = (*dryRunRoundTripper)(nil)
+
+// RoundTrip implements the http.RoundTripper interface.
+func (roundTripper *dryRunRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	operation, mutating := newPlannedOperation(request)
+	if !mutating {
+		return roundTripper.next.RoundTrip(request)
+	}
+
+	body, err := readRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	operation.Body = body
+
+	var response *http.Response
+
+	switch roundTripper.mode {
+	case DryRunLocal:
+		response, err = roundTripper.respondLocally(request, &operation)
+	default:
+		response, err = roundTripper.sendWithDryRun(request)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	operation.StatusCode = response.StatusCode
+	roundTripper.plan.record(operation)
+
+	klog.V(100).Infof("Recorded dry-run operation: %s", operation.String())
+
+	return response, nil
+}
+
+// sendWithDryRun sends a copy of the request with the dryRun=All query parameter set.
+func (roundTripper *dryRunRoundTripper) sendWithDryRun(request *http.Request) (*http.Response, error) {
+	dryRunRequest := request.Clone(request.Context())
+
+	query := dryRunRequest.URL.Query()
+	query.Set("dryRun", metav1.DryRunAll)
+	dryRunRequest.URL.RawQuery = query.Encode()
+
+	return roundTripper.next.RoundTrip(dryRunRequest)
+}
+
+// respondLocally builds a response for the request without sending it. Patches are answered by getting the current
+// form of the resource since the patched form cannot be computed locally, unless the resource does not exist.
+func (roundTripper *dryRunRoundTripper) respondLocally(
+	request *http.Request, operation *PlannedOperation) (*http.Response, error) {
+	contentType := request.Header.Get("Content-Type")
+
+	switch request.Method {
+	case http.MethodPost:
+		if operation.Subresource == "" {
+			roundTripper.plan.storeLocalObject(*operation, localObject{body: operation.Body, contentType: contentType})
+		}
+
+		return newLocalResponse(request, http.StatusCreated, contentType, operation.Body), nil
+	case http.MethodPut:
+		if operation.Subresource == "" {
+			roundTripper.plan.storeLocalObject(*operation, localObject{body: operation.Body, contentType: contentType})
+		}
+
+		return newLocalResponse(request, http.StatusOK, contentType, operation.Body), nil
+	case http.MethodPatch:
+		return roundTripper.patchLocally(request, operation)
+	default:
+		status := fmt.Appendf(nil, `{"kind":"Status","apiVersion":"v1","status":"%s"}`, metav1.StatusSuccess)
+
+		return newLocalResponse(request, http.StatusOK, runtime.ContentTypeJSON, status), nil
+	}
+}
+
+// patchLocally answers a patch with the current form of the resource. If the resource does not exist, a server-side
+// apply is answered with its body as though it created the resource, while other patches of a resource created
+// earlier in the plan are answered with the form it was created with and marked as unverified since the patch cannot
+// be applied locally.
+func (roundTripper *dryRunRoundTripper) patchLocally(
+	request *http.Request, operation *PlannedOperation) (*http.Response, error) {
+	getRequest := request.Clone(request.Context())
+	getRequest.Method = http.MethodGet
+	getRequest.Body = nil
+	getRequest.ContentLength = 0
+
+	response, err := roundTripper.next.RoundTrip(getRequest)
+	if err != nil || response.StatusCode != http.StatusNotFound || operation.Subresource != "" {
+		return response, err
+	}
+
+	if request.Header.Get("Content-Type") == string(types.ApplyPatchType) {
+		// Apply patches may be sent as either YAML or JSON, but the response must be JSON for the client to decode it.
+		body, err := yaml.YAMLToJSON(operation.Body)
+		if err != nil {
+			klog.V(100).Infof("Failed to convert apply patch of %s to JSON: %v", operation.Resource.Resource, err)
+
+			return response, nil
+		}
+
+		_ = response.Body.Close()
+
+		roundTripper.plan.storeLocalObject(*operation, localObject{body: body, contentType: runtime.ContentTypeJSON})
+
+		return newLocalResponse(request, http.StatusCreated, runtime.ContentTypeJSON, body), nil
+	}
+
+	object, ok := roundTripper.plan.localObject(*operation)
+	if !ok {
+		return response, nil
+	}
+
+	_ = response.Body.Close()
+
+	operation.Unverified = true
+
+	return newLocalResponse(request, http.StatusOK, object.contentType, object.body), nil
+}
+
+// newLocalResponse creates a response to the request with the provided status code, content type, and body.
+func newLocalResponse(request *http.Request, statusCode int, contentType string, body []byte) *http.Response {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+
+	return &http.Response{
+		StatusCode:    statusCode,
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+	}
+}
+
+// readRequestBody reads the body of the request and replaces it so it may be read again.
+func readRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	_ = request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
+
+// newPlannedOperation parses the verb and resource from a request. It returns false if the request is not mutating.
+// Paths are expected to have the form /api/{version}/... or /apis/{group}/{version}/... followed by an optional
+// namespaces/{namespace} and then {resource}/{name}/{subresource}, except for the subresources of a namespace itself,
+// such as namespaces/{name}/finalize.
+func newPlannedOperation(request *http.Request) (PlannedOperation, bool) {
+	var operation PlannedOperation
+
+	switch request.Method {
+	case http.MethodPost:
+		operation.Verb = "create"
+	case http.MethodPut:
+		operation.Verb = "update"
+	case http.MethodPatch:
+		operation.Verb = "patch"
+	case http.MethodDelete:
+		operation.Verb = "delete"
+	default:
+		return operation, false
+	}
+
+	segments := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+
+	switch {
+	case len(segments) >= 2 && segments[0] == "api":
+		operation.Resource.Version = segments[1]
+		segments = segments[2:]
+	case len(segments) >= 3 && segments[0] == "apis":
+		operation.Resource.Group = segments[1]
+		operation.Resource.Version = segments[2]
+		segments = segments[3:]
+	default:
+		return operation, true
+	}
+
+	// A path of namespaces/{namespace} with nothing after it refers to the namespace itself rather than a resource
+	// in the namespace, as does namespaces/{namespace}/{subresource} for the subresources of a namespace.
+	isNamespaceSubresource := len(segments) == 3 && operation.Resource.Group == "" && segments[0] == "namespaces" &&
+		namespaceSubresources[segments[2]]
+
+	if len(segments) >= 3 && segments[0] == "namespaces" && !isNamespaceSubresource {
+		operation.Namespace = segments[1]
+		segments = segments[2:]
+	}
+
+	if len(segments) > 0 {
+		operation.Resource.Resource = segments[0]
+	}
+
+	if len(segments) > 1 {
+		operation.Name = segments[1]
+	}
+
+	if len(segments) > 2 {
+		operation.Subresource = segments[2]
+	}
+
+	if operation.Verb == "delete" && operation.Name == "" {
+		operation.Verb = "deletecollection"
+	}
+
+	if operation.Verb == "create" && isReadOnlyPost(operation) {
+		return operation, false
+	}
+
+	return operation, true
+}
+
+// isReadOnlyPost returns true if the create operation does not modify the cluster, such as an access review or a pod
+// exec.
+func isReadOnlyPost(operation PlannedOperation) bool {
+	if readOnlyPostResources[operation.Resource.GroupResource()] {
+		return true
+	}
+
+	return operation.Resource.Group == "" && operation.Subresource != "" &&
+		readOnlyPostSubresources[operation.Resource.Resource+"/"+operation.Subresource]
+}
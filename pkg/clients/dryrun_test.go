@@ -0,0 +1,375 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	corev1apply "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	dryRunTestName      = "dry-run-test-name"
+	dryRunTestNamespace = "dry-run-test-namespace"
+)
+
+func TestSettingsNewDryRun(t *testing.T) {
+	testCases := []struct {
+		name              string
+		mode              DryRunMode
+		expectedRequests  []string
+		expectedDryRunAll bool
+	}{
+		{
+			name:              "server mode sends requests with dryRun",
+			mode:              DryRunServer,
+			expectedRequests:  []string{http.MethodPost, http.MethodDelete},
+			expectedDryRunAll: true,
+		},
+		{
+			name:             "local mode sends no mutating requests",
+			mode:             DryRunLocal,
+			expectedRequests: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		server, requests := newDryRunTestServer(t)
+
+		settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL})
+		require.NoError(t, err)
+
+		dryRunSettings, plan, err := settings.NewDryRun(testCase.mode)
+		require.NoError(t, err)
+
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: dryRunTestName}}
+
+		created, err := dryRunSettings.ConfigMaps(dryRunTestNamespace).Create(
+			context.TODO(), configMap, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, dryRunTestName, created.Name)
+
+		err = dryRunSettings.ConfigMaps(dryRunTestNamespace).Delete(context.TODO(), dryRunTestName, metav1.DeleteOptions{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, testCase.expectedRequests, requests.methods())
+
+		for _, dryRun := range requests.dryRunValues() {
+			assert.Equal(t, testCase.expectedDryRunAll, dryRun == metav1.DryRunAll)
+		}
+
+		operations := plan.Operations()
+		require.Len(t, operations, 2)
+
+		configMapResource := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+		assert.Equal(t, "create", operations[0].Verb)
+		assert.Equal(t, configMapResource, operations[0].Resource)
+		assert.Equal(t, dryRunTestNamespace, operations[0].Namespace)
+		assert.Contains(t, string(operations[0].Body), dryRunTestName)
+		assert.Equal(t, http.StatusCreated, operations[0].StatusCode)
+
+		assert.Equal(t, "delete", operations[1].Verb)
+		assert.Equal(t, dryRunTestName, operations[1].Name)
+		assert.Empty(t, plan.Failed())
+
+		plan.Reset()
+		assert.Empty(t, plan.Operations())
+	}
+}
+
+func TestSettingsNewDryRunReadOnlyPost(t *testing.T) {
+	server, requests := newDryRunTestServer(t)
+
+	settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	dryRunSettings, plan, err := settings.NewDryRun(DryRunLocal)
+	require.NoError(t, err)
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: "get", Resource: "pods"},
+		},
+	}
+
+	_, err = dryRunSettings.K8sClient.AuthorizationV1().SelfSubjectAccessReviews().Create(
+		context.TODO(), review, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// Access reviews do not modify the cluster so they reach the server even in local mode and are not recorded.
+	assert.Equal(t, []string{http.MethodPost}, requests.methods())
+	assert.Empty(t, plan.Operations())
+}
+
+func TestSettingsNewDryRunLocalPatch(t *testing.T) {
+	server, requests := newDryRunTestServer(t)
+
+	settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	dryRunSettings, plan, err := settings.NewDryRun(DryRunLocal)
+	require.NoError(t, err)
+
+	// The test server responds to every get with not found, so none of these resources exist on the cluster.
+	applied, err := dryRunSettings.ConfigMaps(dryRunTestNamespace).Apply(context.TODO(),
+		corev1apply.ConfigMap("applied", dryRunTestNamespace).WithData(map[string]string{"key": "value"}),
+		metav1.ApplyOptions{FieldManager: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, "applied", applied.Name)
+	assert.Equal(t, map[string]string{"key": "value"}, applied.Data)
+
+	_, err = dryRunSettings.ConfigMaps(dryRunTestNamespace).Create(context.TODO(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: dryRunTestName}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	patched, err := dryRunSettings.ConfigMaps(dryRunTestNamespace).Patch(context.TODO(), dryRunTestName,
+		types.MergePatchType, []byte(`{"data":{"key":"value"}}`), metav1.PatchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, dryRunTestName, patched.Name)
+
+	_, err = dryRunSettings.ConfigMaps(dryRunTestNamespace).Patch(context.TODO(), "missing",
+		types.MergePatchType, []byte(`{"data":{"key":"value"}}`), metav1.PatchOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	assert.Empty(t, requests.methods())
+
+	operations := plan.Operations()
+	require.Len(t, operations, 4)
+
+	assert.Equal(t, http.StatusCreated, operations[0].StatusCode)
+	assert.False(t, operations[0].Unverified)
+	assert.Equal(t, http.StatusOK, operations[2].StatusCode)
+	assert.True(t, operations[2].Unverified)
+	assert.Equal(t, http.StatusNotFound, operations[3].StatusCode)
+	assert.Equal(t, []PlannedOperation{operations[3]}, plan.Failed())
+}
+
+func TestSettingsNewDryRunErrors(t *testing.T) {
+	var nilSettings *Settings
+
+	_, _, err := nilSettings.NewDryRun(DryRunServer)
+	assert.EqualError(t, err, "cannot create dry-run client from nil client")
+
+	_, _, err = (&Settings{}).NewDryRun(DryRunServer)
+	assert.EqualError(t, err, "cannot create dry-run client without rest config")
+}
+
+func TestNewPlannedOperation(t *testing.T) {
+	testCases := []struct {
+		method     string
+		path       string
+		mutating   bool
+		expectedOp PlannedOperation
+	}{
+		{
+			method:   http.MethodGet,
+			path:     "/api/v1/namespaces/test/configmaps/test",
+			mutating: false,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/api/v1/namespaces",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:     "create",
+				Resource: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+			},
+		},
+		{
+			method:   http.MethodDelete,
+			path:     "/api/v1/namespaces/test",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:     "delete",
+				Resource: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+				Name:     "test",
+			},
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/apis/apps/v1/namespaces/test/deployments/test/status",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:        "update",
+				Resource:    schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+				Namespace:   "test",
+				Name:        "test",
+				Subresource: "status",
+			},
+		},
+		{
+			method:   http.MethodDelete,
+			path:     "/apis/apps/v1/namespaces/test/deployments",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:      "deletecollection",
+				Resource:  schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+				Namespace: "test",
+			},
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/api/v1/namespaces/test/pods/test/exec",
+			mutating: false,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/api/v1/namespaces/test/serviceaccounts/test/token",
+			mutating: false,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/apis/authorization.k8s.io/v1/subjectaccessreviews",
+			mutating: false,
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/apis/authentication.k8s.io/v1/tokenreviews",
+			mutating: false,
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/api/v1/namespaces/test/finalize",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:        "update",
+				Resource:    schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+				Name:        "test",
+				Subresource: "finalize",
+			},
+		},
+		{
+			method:   http.MethodPatch,
+			path:     "/api/v1/namespaces/test/status",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:        "patch",
+				Resource:    schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+				Name:        "test",
+				Subresource: "status",
+			},
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/api/v1/namespaces/test/configmaps",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:      "create",
+				Resource:  schema.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+				Namespace: "test",
+			},
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/apis/example.com/v1/namespaces/test/status",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:      "update",
+				Resource:  schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "status"},
+				Namespace: "test",
+			},
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/api/v1/namespaces/test/pods/test/eviction",
+			mutating: true,
+			expectedOp: PlannedOperation{
+				Verb:        "create",
+				Resource:    schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+				Namespace:   "test",
+				Name:        "test",
+				Subresource: "eviction",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		request := httptest.NewRequest(testCase.method, testCase.path, nil)
+
+		operation, mutating := newPlannedOperation(request)
+		assert.Equal(t, testCase.mutating, mutating)
+
+		if testCase.mutating {
+			assert.Equal(t, testCase.expectedOp, operation)
+		}
+	}
+}
+
+// dryRunTestRequests records the mutating requests received by the test server.
+type dryRunTestRequests struct {
+	mutex    sync.Mutex
+	requests []*http.Request
+}
+
+func (requests *dryRunTestRequests) methods() []string {
+	requests.mutex.Lock()
+	defer requests.mutex.Unlock()
+
+	var methods []string
+	for _, request := range requests.requests {
+		methods = append(methods, request.Method)
+	}
+
+	return methods
+}
+
+func (requests *dryRunTestRequests) dryRunValues() []string {
+	requests.mutex.Lock()
+	defer requests.mutex.Unlock()
+
+	var values []string
+	for _, request := range requests.requests {
+		values = append(values, request.URL.Query().Get("dryRun"))
+	}
+
+	return values
+}
+
+// newDryRunTestServer returns a server which echoes the body of creates, responds to deletes with a success status,
+// and records all mutating requests it receives.
+func newDryRunTestServer(t *testing.T) (*httptest.Server, *dryRunTestRequests) {
+	t.Helper()
+
+	requests := &dryRunTestRequests{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodGet {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		requests.mutex.Lock()
+		requests.requests = append(requests.requests, request)
+		requests.mutex.Unlock()
+
+		if request.Method == http.MethodPost {
+			body, _ := io.ReadAll(request.Body)
+
+			writer.Header().Set("Content-Type", request.Header.Get("Content-Type"))
+			writer.WriteHeader(http.StatusCreated)
+			_, _ = writer.Write(body)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
@@ -0,0 +1,160 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// minTokenExpiration is the shortest expiration the API server accepts for a TokenRequest.
+const minTokenExpiration = 10 * time.Minute
+
+// ServiceAccountUsername returns the username the API server authenticates the ServiceAccount with the provided name
+// in nsname as, in the form system:serviceaccount:<nsname>:<name>.
+func ServiceAccountUsername(name, nsname string) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", nsname, name)
+}
+
+// ServiceAccountGroups returns the groups the API server adds to ServiceAccounts in nsname, including the
+// system:authenticated group.
+func ServiceAccountGroups(nsname string) []string {
+	return []string{"system:serviceaccounts", "system:serviceaccounts:" + nsname, "system:authenticated"}
+}
+
+// Impersonate returns a copy of the Settings where every request, from any of the clients it contains, impersonates
+// the provided user and groups. The credentials of the Settings must be allowed to impersonate them. The Settings must
+// have been created from a rest config, such as through New, since impersonation is configured at the transport level.
+func (settings *Settings) Impersonate(user string, groups ...string) (*Settings, error) {
+	if user == "" {
+		klog.V(100).Info("The user to impersonate is empty")
+
+		return nil, fmt.Errorf("cannot impersonate empty user")
+	}
+
+	config, err := settings.copyConfig("impersonating")
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Creating client impersonating user %s with groups %v", user, groups)
+
+	config.Impersonate = rest.ImpersonationConfig{UserName: user, Groups: groups}
+
+	return settings.newDerivedSettings(config)
+}
+
+// ImpersonateServiceAccount returns a copy of the Settings where every request impersonates the ServiceAccount with
+// the provided name in nsname, along with the groups the API server would add for it.
+func (settings *Settings) ImpersonateServiceAccount(name, nsname string) (*Settings, error) {
+	if name == "" || nsname == "" {
+		klog.V(100).Info("The name or namespace of the serviceaccount to impersonate is empty")
+
+		return nil, fmt.Errorf("cannot impersonate serviceaccount with empty name or namespace")
+	}
+
+	return settings.Impersonate(ServiceAccountUsername(name, nsname), ServiceAccountGroups(nsname)...)
+}
+
+// NewForServiceAccount mints a token for the ServiceAccount with the provided name in nsname using the TokenRequest
+// API and returns a Settings authenticating with it instead of the credentials of the Settings. The token expires
+// after expiration, which the API server requires to be at least 10 minutes, and is not refreshed, so the returned
+// Settings is meant to be short-lived. Unlike impersonation, the ServiceAccount's token goes through the same
+// authentication path as a workload using it. Only the credentials are replaced, so a Settings derived from a dry-run
+// Settings is still dry-run.
+func (settings *Settings) NewForServiceAccount(
+	ctx context.Context, name, nsname string, expiration time.Duration) (*Settings, error) {
+	if name == "" || nsname == "" {
+		klog.V(100).Info("The name or namespace of the serviceaccount is empty")
+
+		return nil, fmt.Errorf("cannot request token for serviceaccount with empty name or namespace")
+	}
+
+	if expiration < minTokenExpiration {
+		klog.V(100).Infof("The token expiration %s is shorter than %s", expiration, minTokenExpiration)
+
+		return nil, fmt.Errorf("token expiration must be at least %s", minTokenExpiration)
+	}
+
+	config, err := settings.copyConfig("serviceaccount")
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Requesting token for serviceaccount %s in namespace %s", name, nsname)
+
+	expirationSeconds := int64(expiration.Seconds())
+	tokenRequest, err := settings.K8sClient.CoreV1().ServiceAccounts(nsname).CreateToken(ctx, name,
+		&authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		klog.V(100).Infof("Failed to request token for serviceaccount %s in namespace %s: %v", name, nsname, err)
+
+		return nil, fmt.Errorf("failed to request token for serviceaccount %s in namespace %s: %w", name, nsname, err)
+	}
+
+	if tokenRequest.Status.Token == "" {
+		return nil, fmt.Errorf("token request for serviceaccount %s in namespace %s returned empty token", name, nsname)
+	}
+
+	setBearerToken(config, tokenRequest.Status.Token)
+
+	return settings.newDerivedSettings(config)
+}
+
+// setBearerToken replaces all of the credentials in config with the bearer token, including impersonation. Everything
+// else is kept, notably the TLS server settings and the transport wrappers, such as the one of a dry-run client, so
+// requests made with the token are handled the same way as those of the original config.
+func setBearerToken(config *rest.Config, token string) {
+	config.BearerToken = token
+	config.BearerTokenFile = ""
+	config.Username = ""
+	config.Password = ""
+	config.TLSClientConfig.CertData = nil
+	config.TLSClientConfig.KeyData = nil
+	config.TLSClientConfig.CertFile = ""
+	config.TLSClientConfig.KeyFile = ""
+	config.ExecProvider = nil
+	config.AuthProvider = nil
+	config.AuthConfigPersister = nil
+	config.Impersonate = rest.ImpersonationConfig{}
+}
+
+// copyConfig returns a copy of the rest config of the Settings, or an error if there is none. The kind is only used in
+// the error message and describes the client being created.
+func (settings *Settings) copyConfig(kind string) (*rest.Config, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, fmt.Errorf("cannot create %s client from nil client", kind)
+	}
+
+	if settings.Config == nil {
+		klog.V(100).Info("The APIClient has no rest config")
+
+		return nil, fmt.Errorf("cannot create %s client without rest config", kind)
+	}
+
+	return rest.CopyConfig(settings.Config), nil
+}
+
+// newDerivedSettings creates a new Settings from config which shares the retry policy, logger, tracer provider, and
+// metrics of the Settings. The KubeconfigPath is not copied since it does not reflect the credentials of the config.
+func (settings *Settings) newDerivedSettings(config *rest.Config) (*Settings, error) {
+	derivedSettings, err := newSettingsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedSettings.RetryPolicy = settings.RetryPolicy
+	derivedSettings.Logger = settings.Logger
+	derivedSettings.TracerProvider = settings.TracerProvider
+	derivedSettings.Metrics = settings.Metrics
+
+	return derivedSettings, nil
+}
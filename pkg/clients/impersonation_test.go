@@ -0,0 +1,310 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	impersonationTestName      = "impersonation-test-name"
+	impersonationTestNamespace = "impersonation-test-namespace"
+	impersonationTestToken     = "minted-token"
+	impersonationTestBaseToken = "admin-token"
+)
+
+func TestSettingsImpersonate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		impersonate    func(*Settings) (*Settings, error)
+		expectedUser   string
+		expectedGroups []string
+	}{
+		{
+			name: "user without groups",
+			impersonate: func(settings *Settings) (*Settings, error) {
+				return settings.Impersonate("developer")
+			},
+			expectedUser: "developer",
+		},
+		{
+			name: "user with groups",
+			impersonate: func(settings *Settings) (*Settings, error) {
+				return settings.Impersonate("developer", "team-a", "team-b")
+			},
+			expectedUser:   "developer",
+			expectedGroups: []string{"team-a", "team-b"},
+		},
+		{
+			name: "serviceaccount",
+			impersonate: func(settings *Settings) (*Settings, error) {
+				return settings.ImpersonateServiceAccount(impersonationTestName, impersonationTestNamespace)
+			},
+			expectedUser: "system:serviceaccount:impersonation-test-namespace:impersonation-test-name",
+			expectedGroups: []string{
+				"system:serviceaccounts",
+				"system:serviceaccounts:impersonation-test-namespace",
+				"system:authenticated",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		server, requests := newImpersonationTestServer(t)
+
+		settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL, BearerToken: impersonationTestBaseToken})
+		require.NoError(t, err)
+
+		settings.Metrics = NewMetrics()
+
+		impersonatingSettings, err := testCase.impersonate(settings)
+		require.NoError(t, err, testCase.name)
+		assert.Equal(t, settings.Metrics, impersonatingSettings.Metrics, testCase.name)
+		assert.Empty(t, settings.Config.Impersonate.UserName, "original config should not be modified")
+
+		_, err = impersonatingSettings.ConfigMaps(impersonationTestNamespace).Get(
+			context.TODO(), impersonationTestName, metav1.GetOptions{})
+		assert.NoError(t, err, testCase.name)
+
+		headers := requests.all()
+		require.Len(t, headers, 1, testCase.name)
+		assert.Equal(t, testCase.expectedUser, headers[0].Get("Impersonate-User"), testCase.name)
+		assert.Equal(t, testCase.expectedGroups, headers[0].Values("Impersonate-Group"), testCase.name)
+		assert.Equal(t, "Bearer "+impersonationTestBaseToken, headers[0].Get("Authorization"), testCase.name)
+	}
+}
+
+func TestSettingsImpersonateErrors(t *testing.T) {
+	var nilSettings *Settings
+
+	_, err := nilSettings.Impersonate("developer")
+	assert.EqualError(t, err, "cannot create impersonating client from nil client")
+
+	_, err = (&Settings{}).Impersonate("developer")
+	assert.EqualError(t, err, "cannot create impersonating client without rest config")
+
+	_, err = (&Settings{Config: &rest.Config{}}).Impersonate("")
+	assert.EqualError(t, err, "cannot impersonate empty user")
+
+	_, err = (&Settings{Config: &rest.Config{}}).ImpersonateServiceAccount(impersonationTestName, "")
+	assert.EqualError(t, err, "cannot impersonate serviceaccount with empty name or namespace")
+}
+
+func TestSettingsNewForServiceAccount(t *testing.T) {
+	server, requests := newImpersonationTestServer(t)
+
+	settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL, BearerToken: impersonationTestBaseToken})
+	require.NoError(t, err)
+
+	serviceAccountSettings, err := settings.NewForServiceAccount(
+		context.TODO(), impersonationTestName, impersonationTestNamespace, time.Hour)
+	require.NoError(t, err)
+
+	_, err = serviceAccountSettings.ConfigMaps(impersonationTestNamespace).Get(
+		context.TODO(), impersonationTestName, metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	headers := requests.all()
+	require.Len(t, headers, 2)
+	assert.Equal(t, "Bearer "+impersonationTestBaseToken, headers[0].Get("Authorization"))
+	assert.Equal(t, "Bearer "+impersonationTestToken, headers[1].Get("Authorization"))
+	assert.Equal(t, "3600", requests.tokenExpiration())
+}
+
+func TestSettingsNewForServiceAccountFromDryRun(t *testing.T) {
+	server, requests := newImpersonationTestServer(t)
+
+	settings, err := newSettingsFromConfig(&rest.Config{
+		Host:        server.URL,
+		BearerToken: impersonationTestBaseToken,
+		Impersonate: rest.ImpersonationConfig{UserName: "impersonated-user"},
+	})
+	require.NoError(t, err)
+
+	dryRunSettings, plan, err := settings.NewDryRun(DryRunLocal)
+	require.NoError(t, err)
+
+	serviceAccountSettings, err := dryRunSettings.NewForServiceAccount(
+		context.TODO(), impersonationTestName, impersonationTestNamespace, time.Hour)
+	require.NoError(t, err)
+
+	// The create is handled by the dry-run transport of the original Settings and never reaches the server.
+	_, err = serviceAccountSettings.ConfigMaps(impersonationTestNamespace).Create(context.TODO(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: impersonationTestName, Namespace: impersonationTestNamespace},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, plan.OperationsWithVerb("create"), 1)
+
+	_, err = serviceAccountSettings.ConfigMaps(impersonationTestNamespace).Get(
+		context.TODO(), impersonationTestName, metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	headers := requests.all()
+	require.Len(t, headers, 2)
+	assert.Equal(t, "Bearer "+impersonationTestBaseToken, headers[0].Get("Authorization"))
+	assert.Equal(t, "impersonated-user", headers[0].Get("Impersonate-User"))
+	assert.Equal(t, "Bearer "+impersonationTestToken, headers[1].Get("Authorization"))
+	assert.Empty(t, headers[1].Get("Impersonate-User"))
+}
+
+func TestSetBearerToken(t *testing.T) {
+	config := &rest.Config{
+		Host:            "https://api.example.com:6443",
+		BearerToken:     impersonationTestBaseToken,
+		BearerTokenFile: "/var/run/token",
+		Username:        "admin",
+		Password:        "password",
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:   []byte("ca"),
+			CertData: []byte("cert"),
+			KeyData:  []byte("key"),
+			CertFile: "/tls.crt",
+			KeyFile:  "/tls.key",
+		},
+		ExecProvider: &clientcmdapi.ExecConfig{Command: "login"},
+		AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "oidc"},
+		Impersonate:  rest.ImpersonationConfig{UserName: "impersonated-user"},
+	}
+
+	setBearerToken(config, impersonationTestToken)
+
+	assert.Equal(t, &rest.Config{
+		Host:            "https://api.example.com:6443",
+		BearerToken:     impersonationTestToken,
+		TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca")},
+	}, config)
+}
+
+func TestSettingsNewForServiceAccountErrors(t *testing.T) {
+	server, _ := newImpersonationTestServer(t)
+
+	settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		nsname        string
+		expiration    time.Duration
+		expectedError string
+	}{
+		{
+			name:          "",
+			nsname:        impersonationTestNamespace,
+			expiration:    time.Hour,
+			expectedError: "cannot request token for serviceaccount with empty name or namespace",
+		},
+		{
+			name:          impersonationTestName,
+			nsname:        impersonationTestNamespace,
+			expiration:    time.Minute,
+			expectedError: "token expiration must be at least 10m0s",
+		},
+		{
+			name:       "missing",
+			nsname:     impersonationTestNamespace,
+			expiration: time.Hour,
+			expectedError: "failed to request token for serviceaccount missing in namespace impersonation-test-namespace: " +
+				"serviceaccounts \"missing\" not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := settings.NewForServiceAccount(context.TODO(), testCase.name, testCase.nsname, testCase.expiration)
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+// impersonationTestRequests records the headers of the requests received by the impersonation test server.
+type impersonationTestRequests struct {
+	mutex      sync.Mutex
+	headers    []http.Header
+	expiration string
+}
+
+func (requests *impersonationTestRequests) all() []http.Header {
+	requests.mutex.Lock()
+	defer requests.mutex.Unlock()
+
+	return requests.headers
+}
+
+func (requests *impersonationTestRequests) tokenExpiration() string {
+	requests.mutex.Lock()
+	defer requests.mutex.Unlock()
+
+	return requests.expiration
+}
+
+// newImpersonationTestServer returns a server that responds to TokenRequests for the impersonationTestName
+// ServiceAccount with impersonationTestToken and to all other requests with an empty ConfigMap.
+func newImpersonationTestServer(t *testing.T) (*httptest.Server, *impersonationTestRequests) {
+	t.Helper()
+
+	requests := &impersonationTestRequests{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requests.mutex.Lock()
+		requests.headers = append(requests.headers, request.Header.Clone())
+		requests.mutex.Unlock()
+
+		writer.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(request.URL.Path, "/token") {
+			_ = json.NewEncoder(writer).Encode(&corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: impersonationTestName, Namespace: impersonationTestNamespace},
+			})
+
+			return
+		}
+
+		if !strings.Contains(request.URL.Path, "/serviceaccounts/"+impersonationTestName+"/") {
+			writer.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(writer).Encode(&metav1.Status{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+				Status:   metav1.StatusFailure,
+				Reason:   metav1.StatusReasonNotFound,
+				Code:     http.StatusNotFound,
+				Message:  "serviceaccounts \"missing\" not found",
+			})
+
+			return
+		}
+
+		// The typed clients send requests for built-in types as protobuf, so the universal deserializer is used.
+		tokenRequest := &authenticationv1.TokenRequest{}
+		body, _ := io.ReadAll(request.Body)
+		_, _, _ = scheme.Codecs.UniversalDeserializer().Decode(body, nil, tokenRequest)
+
+		if tokenRequest.Spec.ExpirationSeconds != nil {
+			requests.mutex.Lock()
+			requests.expiration = strconv.FormatInt(*tokenRequest.Spec.ExpirationSeconds, 10)
+			requests.mutex.Unlock()
+		}
+
+		tokenRequest.TypeMeta = metav1.TypeMeta{APIVersion: "authentication.k8s.io/v1", Kind: "TokenRequest"}
+		tokenRequest.Status.Token = impersonationTestToken
+
+		writer.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(writer).Encode(tokenRequest)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
@@ -0,0 +1,249 @@
+package clients
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// lazyClient is a runtimeClient.WithWatch that creates the underlying client, along with its REST mapper, on first
+// use. If creating the client fails, the error is returned from that call and creation is tried again on the next
+// one. The scheme is shared with the Settings, so types attached after the Settings is created are still known.
+type lazyClient struct {
+	config  *rest.Config
+	options runtimeClient.Options
+
+	mutex  sync.Mutex
+	client runtimeClient.WithWatch
+}
+
+var _ runtimeClient.WithWatch = (*lazyClient)(nil)
+
+// newLazyClient creates a new lazyClient for the config. No clients are created until the first call.
+func newLazyClient(config *rest.Config, options runtimeClient.Options) *lazyClient {
+	return &lazyClient{config: config, options: options}
+}
+
+// getClient returns the underlying client, creating it if it has not been created yet.
+func (client *lazyClient) getClient() (runtimeClient.WithWatch, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if client.client != nil {
+		return client.client, nil
+	}
+
+	klog.V(100).Info("Creating apiClient runtime client")
+
+	underlying, err := runtimeClient.NewWithWatch(client.config, client.options)
+	if err != nil {
+		klog.V(100).Infof("Failed to create apiClient runtime client: %v", err)
+
+		return nil, err
+	}
+
+	client.client = underlying
+
+	return underlying, nil
+}
+
+// Get implements the runtimeClient.Reader interface.
+func (client *lazyClient) Get(
+	ctx context.Context, key runtimeClient.ObjectKey, obj runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+	underlying, err := client.getClient()
+	if err != nil {
+		return err
+	}
+
+	return underlying.Get(ctx, key, obj, opts...)
+}
+
+// List implements the runtimeClient.Reader interface.
+func (client *lazyClient) List(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+	underlying, err := client.getClient()
+	if err != nil {
+		return err
+	}
+
+	return underlying.List(ctx, list, opts...)
+}
+
+// Create implements the runtimeClient.Writer interface.
+func (client *lazyClient) Create(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.CreateOption) error {
+	underlying, err := client.getClient()
+	if err != nil {
+		return err
+	}
+
+	return underlying.Create(ctx, obj, opts...)
+}
+
+// Delete implements the runtimeClient.Writer interface.
+func (client *lazyClient) Delete(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.DeleteOption) error {
+	underlying, err := client.getClient()
+	if err != nil {
+		return err
+	}
+
+	return underlying.Delete(ctx, obj, opts...)
+}
+
+// Update implements the runtimeClient.Writer interface.
+func (client *lazyClient) Update(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.UpdateOption) error {
+	underlying, err := client.getClient()
+	if err != nil {
+		return err
+	}
+
+	return underlying.Update(ctx, obj, opts...)
+}
+
+// Patch implements the runtimeClient.Writer interface.
+func (client *lazyClient) Patch(
+	ctx context.Context, obj runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.PatchOption) error {
+	underlying, err := client.getClient()
+	if err != nil {
+		return err
+	}
+
+	return underlying.Patch(ctx, obj, patch, opts...)
+}
+
+// DeleteAllOf implements the runtimeClient.Writer interface.
+func (client *lazyClient) DeleteAllOf(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.DeleteAllOfOption) error {
+	underlying, err := client.getClient()
+	if err != nil {
+		return err
+	}
+
+	return underlying.DeleteAllOf(ctx, obj, opts...)
+}
+
+// Watch implements the runtimeClient.WithWatch interface.
+func (client *lazyClient) Watch(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) (watch.Interface, error) {
+	underlying, err := client.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return underlying.Watch(ctx, list, opts...)
+}
+
+// Status implements the runtimeClient.StatusClient interface. The underlying client is created when one of the methods
+// of the returned writer is called.
+func (client *lazyClient) Status() runtimeClient.SubResourceWriter {
+	return &lazySubResourceClient{client: client, subResource: "status"}
+}
+
+// SubResource implements the runtimeClient.SubResourceClientConstructor interface. The underlying client is created
+// when one of the methods of the returned client is called.
+func (client *lazyClient) SubResource(subResource string) runtimeClient.SubResourceClient {
+	return &lazySubResourceClient{client: client, subResource: subResource}
+}
+
+// Scheme returns the scheme of the client, which does not require creating the underlying client.
+func (client *lazyClient) Scheme() *runtime.Scheme {
+	return client.options.Scheme
+}
+
+// RESTMapper returns the REST mapper of the underlying client. Since there is no way to return an error, an empty
+// mapper that fails every lookup is returned if the client cannot be created.
+func (client *lazyClient) RESTMapper() meta.RESTMapper {
+	underlying, err := client.getClient()
+	if err != nil {
+		return meta.MultiRESTMapper{}
+	}
+
+	return underlying.RESTMapper()
+}
+
+// GroupVersionKindFor returns the GroupVersionKind of the object from the scheme, which does not require creating the
+// underlying client.
+func (client *lazyClient) GroupVersionKindFor(obj runtime.Object) (schema.GroupVersionKind, error) {
+	return apiutil.GVKForObject(obj, client.options.Scheme)
+}
+
+// IsObjectNamespaced implements the runtimeClient.Client interface.
+func (client *lazyClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	underlying, err := client.getClient()
+	if err != nil {
+		return false, err
+	}
+
+	return underlying.IsObjectNamespaced(obj)
+}
+
+// lazySubResourceClient is a runtimeClient.SubResourceClient that gets the underlying client from its lazyClient on
+// each call.
+type lazySubResourceClient struct {
+	client      *lazyClient
+	subResource string
+}
+
+// getSubResourceClient returns the subresource client of the underlying client, creating it if needed.
+func (subResourceClient *lazySubResourceClient) getSubResourceClient() (runtimeClient.SubResourceClient, error) {
+	underlying, err := subResourceClient.client.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return underlying.SubResource(subResourceClient.subResource), nil
+}
+
+// Get implements the runtimeClient.SubResourceReader interface.
+func (subResourceClient *lazySubResourceClient) Get(ctx context.Context,
+	obj runtimeClient.Object, subResource runtimeClient.Object, opts ...runtimeClient.SubResourceGetOption) error {
+	client, err := subResourceClient.getSubResourceClient()
+	if err != nil {
+		return err
+	}
+
+	return client.Get(ctx, obj, subResource, opts...)
+}
+
+// Create implements the runtimeClient.SubResourceWriter interface.
+func (subResourceClient *lazySubResourceClient) Create(ctx context.Context,
+	obj runtimeClient.Object, subResource runtimeClient.Object, opts ...runtimeClient.SubResourceCreateOption) error {
+	client, err := subResourceClient.getSubResourceClient()
+	if err != nil {
+		return err
+	}
+
+	return client.Create(ctx, obj, subResource, opts...)
+}
+
+// Update implements the runtimeClient.SubResourceWriter interface.
+func (subResourceClient *lazySubResourceClient) Update(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.SubResourceUpdateOption) error {
+	client, err := subResourceClient.getSubResourceClient()
+	if err != nil {
+		return err
+	}
+
+	return client.Update(ctx, obj, opts...)
+}
+
+// Patch implements the runtimeClient.SubResourceWriter interface.
+func (subResourceClient *lazySubResourceClient) Patch(ctx context.Context,
+	obj runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.SubResourcePatchOption) error {
+	client, err := subResourceClient.getSubResourceClient()
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(ctx, obj, patch, opts...)
+}
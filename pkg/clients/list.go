@@ -0,0 +1,314 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultListPageSize is the number of objects requested in each page when listing without a PageSize. Lists made
+// using the common List functions and ListPages are always paginated so that only one page is held in memory at once
+// while iterating, which keeps listing thousands of pods or events on large clusters from requesting them all in one
+// call.
+const DefaultListPageSize int64 = 500
+
+// ListOptionsFrom returns the metav1.ListOptions for the controller-runtime options, such as the selectors returned by
+// MatchingLabelSelector and MatchingNodeName. This allows the same typed options to be passed to List functions which
+// take metav1.ListOptions as to those which take runtimeclient.ListOption.
+func ListOptionsFrom(options ...runtimeclient.ListOption) metav1.ListOptions {
+	return *(&runtimeclient.ListOptions{}).ApplyOptions(options).AsListOptions()
+}
+
+// RuntimeListOptionsFrom returns the runtimeclient.ListOptions for the options. This allows the typed options to be
+// passed to List functions which take runtimeclient.ListOptions.
+func RuntimeListOptionsFrom(options ...runtimeclient.ListOption) runtimeclient.ListOptions {
+	return *(&runtimeclient.ListOptions{}).ApplyOptions(options)
+}
+
+// MatchingLabelSelector returns a list option that selects objects matching the selector, such as the selector from the
+// spec of a Deployment. An error is returned if the selector is invalid.
+func MatchingLabelSelector(selector *metav1.LabelSelector) (runtimeclient.MatchingLabelsSelector, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return runtimeclient.MatchingLabelsSelector{}, fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	return runtimeclient.MatchingLabelsSelector{Selector: labelSelector}, nil
+}
+
+// MatchingFieldSelector returns a list option that selects objects matching the field selector.
+func MatchingFieldSelector(selector fields.Selector) runtimeclient.MatchingFieldsSelector {
+	return runtimeclient.MatchingFieldsSelector{Selector: selector}
+}
+
+// MatchingName returns a list option that selects the object with the provided name.
+func MatchingName(name string) runtimeclient.MatchingFields {
+	return runtimeclient.MatchingFields{"metadata.name": name}
+}
+
+// MatchingNodeName returns a list option that selects pods scheduled on the provided node.
+func MatchingNodeName(nodeName string) runtimeclient.MatchingFields {
+	return runtimeclient.MatchingFields{"spec.nodeName": nodeName}
+}
+
+// MatchingPodPhase returns a list option that selects pods in the provided phase, such as Running or Failed.
+func MatchingPodPhase(phase corev1.PodPhase) runtimeclient.MatchingFields {
+	return runtimeclient.MatchingFields{"status.phase": string(phase)}
+}
+
+// MatchingInvolvedObject returns a list option that selects events about the object with the provided kind and name.
+func MatchingInvolvedObject(kind, name string) runtimeclient.MatchingFieldsSelector {
+	return runtimeclient.MatchingFieldsSelector{Selector: fields.AndSelectors(
+		fields.OneTermEqualSelector("involvedObject.kind", kind),
+		fields.OneTermEqualSelector("involvedObject.name", name))}
+}
+
+// PageSize is a list option that sets the number of objects requested in each page of a paginated list. Unlike a
+// Limit, which caps the total number of objects listed, it only changes how many requests are needed to list them.
+// It is not part of runtimeclient.ListOptions, so it is read from the options using PageSizeFrom.
+type PageSize int64
+
+// ApplyToList implements runtimeclient.ListOption. It does not modify the options since they have no page size.
+func (size PageSize) ApplyToList(*runtimeclient.ListOptions) {}
+
+// WithPageSize returns a list option that sets the number of objects requested in each page of a paginated list.
+func WithPageSize(size int64) PageSize {
+	return PageSize(size)
+}
+
+// PageSizeFrom returns the page size from the last PageSize in the options, or DefaultListPageSize if there is none or
+// it is not positive.
+func PageSizeFrom(options ...runtimeclient.ListOption) int64 {
+	pageSize := DefaultListPageSize
+
+	for _, option := range options {
+		if size, ok := option.(PageSize); ok && size > 0 {
+			pageSize = int64(size)
+		}
+	}
+
+	return pageSize
+}
+
+// PageLimit returns the Limit to request for the next page of a list capped at limit objects, of which listed have
+// already been returned. A limit of 0 means the list is not capped, so pageSize is used.
+func PageLimit(limit, pageSize, listed int64) int64 {
+	if limit == 0 {
+		return pageSize
+	}
+
+	return min(pageSize, limit-listed)
+}
+
+// ListPages returns an iterator over the pages of a list made using the typed or dynamic clientsets. The list function
+// is called for each page, requesting pageSize objects or DefaultListPageSize if pageSize is not positive, until a
+// page is returned without a continue token. The Limit of the options caps the total number of objects across all
+// pages, rather than the size of each page, so the last page is truncated if the server returns more than that. If an
+// error occurs, it is yielded and iteration stops.
+func ListPages[L runtime.Object](
+	ctx context.Context,
+	options metav1.ListOptions,
+	pageSize int64,
+	list func(ctx context.Context, options metav1.ListOptions) (L, error)) iter.Seq2[L, error] {
+	return func(yield func(L, error) bool) {
+		var empty L
+
+		if pageSize <= 0 {
+			pageSize = DefaultListPageSize
+		}
+
+		limit := options.Limit
+		listed := int64(0)
+
+		for {
+			options.Limit = PageLimit(limit, pageSize, listed)
+
+			page, err := list(ctx, options)
+			if err != nil {
+				yield(empty, err)
+
+				return
+			}
+
+			listAccessor, err := meta.ListAccessor(page)
+			if err != nil {
+				yield(empty, fmt.Errorf("failed to get continue token of list: %w", err))
+
+				return
+			}
+
+			count, err := TruncateList(page, limit, listed)
+			if err != nil {
+				yield(empty, err)
+
+				return
+			}
+
+			if !yield(page, nil) {
+				return
+			}
+
+			listed += count
+			options.Continue = listAccessor.GetContinue()
+
+			if options.Continue == "" || (limit > 0 && listed >= limit) {
+				return
+			}
+		}
+	}
+}
+
+// TruncateList removes any items from list past the limit on the total number of items, of which listed have already
+// been returned by earlier pages, and returns the number of items left in it. This is for list calls which return more
+// items than they were asked for, such as when the server ignores the Limit. A limit of 0 means the list is not capped,
+// so nothing is removed even if the page is larger than requested.
+func TruncateList(list runtime.Object, limit, listed int64) (int64, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract list: %w", err)
+	}
+
+	remaining := limit - listed
+	if limit == 0 || int64(len(items)) <= remaining {
+		return int64(len(items)), nil
+	}
+
+	err = meta.SetList(list, items[:remaining])
+	if err != nil {
+		return 0, fmt.Errorf("failed to truncate list: %w", err)
+	}
+
+	return remaining, nil
+}
+
+// CollectPages returns a single list holding every item of the pages returned by ListPages, using DefaultListPageSize.
+// It allows List functions that return all of their items at once to still request them a page at a time, with the
+// Limit of the options capping the total number of items.
+func CollectPages[L runtime.Object](
+	ctx context.Context,
+	options metav1.ListOptions,
+	list func(ctx context.Context, options metav1.ListOptions) (L, error)) (L, error) {
+	var (
+		collected L
+		items     []runtime.Object
+		first     = true
+	)
+
+	for page, err := range ListPages(ctx, options, 0, list) {
+		if err != nil {
+			var empty L
+
+			return empty, err
+		}
+
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			var empty L
+
+			return empty, fmt.Errorf("failed to extract list: %w", err)
+		}
+
+		if first {
+			collected = page
+			first = false
+		}
+
+		items = append(items, pageItems...)
+	}
+
+	err := setCollectedItems(collected, items)
+	if err != nil {
+		var empty L
+
+		return empty, err
+	}
+
+	return collected, nil
+}
+
+// ListInto lists the objects matching the options into list using the controller-runtime client, a page at a time.
+// The page size is taken from the options, as described in PageSizeFrom, and a Limit in the options caps the total
+// number of objects. Once it returns, list holds the objects from every page, as though they were listed in one call.
+func ListInto(
+	ctx context.Context,
+	apiClient runtimeclient.Reader,
+	list runtimeclient.ObjectList,
+	options ...runtimeclient.ListOption) error {
+	listOptions := (&runtimeclient.ListOptions{}).ApplyOptions(options)
+	pageSize := PageSizeFrom(options...)
+	limit := listOptions.Limit
+	listed := int64(0)
+
+	// Each page is listed into a fresh copy of the empty list since decoding into the same list may reuse the memory
+	// of the items from the previous page.
+	template, ok := list.DeepCopyObject().(runtimeclient.ObjectList)
+	if !ok {
+		return fmt.Errorf("failed to copy list of type %T", list)
+	}
+
+	err := meta.SetList(template, nil)
+	if err != nil {
+		return fmt.Errorf("failed to empty list: %w", err)
+	}
+
+	var items []runtime.Object
+
+	for {
+		page, ok := template.DeepCopyObject().(runtimeclient.ObjectList)
+		if !ok {
+			return fmt.Errorf("failed to copy list of type %T", list)
+		}
+
+		listOptions.Limit = PageLimit(limit, pageSize, listed)
+
+		err = apiClient.List(ctx, page, listOptions)
+		if err != nil {
+			return err
+		}
+
+		count, err := TruncateList(page, limit, listed)
+		if err != nil {
+			return err
+		}
+
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			return fmt.Errorf("failed to extract list: %w", err)
+		}
+
+		items = append(items, pageItems...)
+		listed += count
+		listOptions.Continue = page.GetContinue()
+
+		if listOptions.Continue == "" || (limit > 0 && listed >= limit) {
+			list.SetResourceVersion(page.GetResourceVersion())
+
+			return setCollectedItems(list, items)
+		}
+	}
+}
+
+// setCollectedItems sets the items of list to those collected from every page and clears its continue token, since
+// there are no more pages to request.
+func setCollectedItems(list runtime.Object, items []runtime.Object) error {
+	err := meta.SetList(list, items)
+	if err != nil {
+		return fmt.Errorf("failed to set list items: %w", err)
+	}
+
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return fmt.Errorf("failed to get list metadata: %w", err)
+	}
+
+	listAccessor.SetContinue("")
+
+	return nil
+}
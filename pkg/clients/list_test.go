@@ -0,0 +1,393 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestListOptionsFrom(t *testing.T) {
+	labelSelector, err := MatchingLabelSelector(&metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "test"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"backend"},
+		}},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		options         []runtimeClient.ListOption
+		expectedOptions metav1.ListOptions
+	}{
+		{
+			options:         nil,
+			expectedOptions: metav1.ListOptions{},
+		},
+		{
+			options:         []runtimeClient.ListOption{labelSelector, runtimeClient.Limit(10), WithPageSize(5)},
+			expectedOptions: metav1.ListOptions{LabelSelector: "app=test,tier in (backend)", Limit: 10},
+		},
+		{
+			options:         []runtimeClient.ListOption{MatchingNodeName("worker-0")},
+			expectedOptions: metav1.ListOptions{FieldSelector: "spec.nodeName=worker-0"},
+		},
+		{
+			options:         []runtimeClient.ListOption{MatchingPodPhase(corev1.PodRunning)},
+			expectedOptions: metav1.ListOptions{FieldSelector: "status.phase=Running"},
+		},
+		{
+			options:         []runtimeClient.ListOption{MatchingInvolvedObject("Pod", "test")},
+			expectedOptions: metav1.ListOptions{FieldSelector: "involvedObject.kind=Pod,involvedObject.name=test"},
+		},
+		{
+			options: []runtimeClient.ListOption{
+				MatchingFieldSelector(fields.OneTermNotEqualSelector("metadata.name", "test"))},
+			expectedOptions: metav1.ListOptions{FieldSelector: "metadata.name!=test"},
+		},
+		{
+			options:         []runtimeClient.ListOption{MatchingName("test"), runtimeClient.InNamespace("test-ns")},
+			expectedOptions: metav1.ListOptions{FieldSelector: "metadata.name=test"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedOptions, ListOptionsFrom(testCase.options...))
+	}
+
+	runtimeOptions := RuntimeListOptionsFrom(runtimeClient.InNamespace("test-ns"), WithPageSize(10))
+	assert.Equal(t, "test-ns", runtimeOptions.Namespace)
+	assert.Equal(t, int64(0), runtimeOptions.Limit)
+}
+
+func TestPageSizeFrom(t *testing.T) {
+	testCases := []struct {
+		options          []runtimeClient.ListOption
+		expectedPageSize int64
+	}{
+		{
+			options:          nil,
+			expectedPageSize: DefaultListPageSize,
+		},
+		{
+			options:          []runtimeClient.ListOption{runtimeClient.Limit(10)},
+			expectedPageSize: DefaultListPageSize,
+		},
+		{
+			options:          []runtimeClient.ListOption{WithPageSize(10), runtimeClient.Limit(5)},
+			expectedPageSize: 10,
+		},
+		{
+			options:          []runtimeClient.ListOption{WithPageSize(10), WithPageSize(0)},
+			expectedPageSize: 10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedPageSize, PageSizeFrom(testCase.options...))
+	}
+}
+
+func TestMatchingLabelSelector(t *testing.T) {
+	_, err := MatchingLabelSelector(&metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "bad"}},
+	})
+	assert.ErrorContains(t, err, "invalid label selector")
+}
+
+func TestListPages(t *testing.T) {
+	testCases := []struct {
+		pageSize        int64
+		limit           int64
+		stopAfter       int
+		listError       error
+		expectedLimit   int64
+		expectedPages   int
+		expectedPodsLen int
+	}{
+		{
+			pageSize:        0,
+			expectedLimit:   DefaultListPageSize,
+			expectedPages:   1,
+			expectedPodsLen: 5,
+		},
+		{
+			pageSize:        2,
+			expectedLimit:   2,
+			expectedPages:   3,
+			expectedPodsLen: 5,
+		},
+		{
+			pageSize:        2,
+			stopAfter:       1,
+			expectedLimit:   2,
+			expectedPages:   1,
+			expectedPodsLen: 2,
+		},
+		{
+			pageSize:        2,
+			limit:           3,
+			expectedLimit:   1,
+			expectedPages:   2,
+			expectedPodsLen: 3,
+		},
+		{
+			pageSize:        0,
+			limit:           3,
+			expectedLimit:   3,
+			expectedPages:   1,
+			expectedPodsLen: 3,
+		},
+		{
+			pageSize:        2,
+			limit:           10,
+			expectedLimit:   2,
+			expectedPages:   3,
+			expectedPodsLen: 5,
+		},
+		{
+			pageSize:      2,
+			listError:     fmt.Errorf("test error"),
+			expectedLimit: 2,
+			expectedPages: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		lister := &pagedPodLister{pods: 5, err: testCase.listError}
+
+		var (
+			pages int
+			pods  int
+			err   error
+		)
+
+		for page, pageErr := range ListPages(
+			context.TODO(), metav1.ListOptions{Limit: testCase.limit}, testCase.pageSize, lister.list) {
+			if pageErr != nil {
+				err = pageErr
+
+				continue
+			}
+
+			pages++
+			pods += len(page.Items)
+
+			if pages == testCase.stopAfter {
+				break
+			}
+		}
+
+		assert.Equal(t, testCase.listError, err)
+		assert.Equal(t, testCase.expectedPages, lister.calls)
+		assert.Equal(t, testCase.expectedLimit, lister.limit)
+		assert.Equal(t, testCase.expectedPodsLen, pods)
+	}
+}
+
+func TestListPagesTruncatesPage(t *testing.T) {
+	// The lister ignores the Limit, as the fake clientsets do, so the page must be truncated to respect it.
+	lister := &pagedPodLister{pods: 5, ignoreLimit: true}
+
+	var pods []string
+
+	for page, err := range ListPages(context.TODO(), metav1.ListOptions{Limit: 3}, 0, lister.list) {
+		require.NoError(t, err)
+
+		for _, pod := range page.Items {
+			pods = append(pods, pod.Name)
+		}
+	}
+
+	assert.Equal(t, []string{"0", "1", "2"}, pods)
+	assert.Equal(t, 1, lister.calls)
+}
+
+func TestListIgnoringLimitWithoutLimit(t *testing.T) {
+	// A server which ignores the Limit returns every object in one page with no continue token, so without a Limit in
+	// the options none of them may be dropped, even though there are more than DefaultListPageSize.
+	pods := int(DefaultListPageSize) + 100
+	lister := &pagedPodLister{pods: pods, ignoreLimit: true}
+
+	var listed int
+
+	for page, err := range ListPages(context.TODO(), metav1.ListOptions{}, 0, lister.list) {
+		require.NoError(t, err)
+
+		listed += len(page.Items)
+	}
+
+	assert.Equal(t, pods, listed)
+
+	podList, err := CollectPages(context.TODO(), metav1.ListOptions{}, lister.list)
+	require.NoError(t, err)
+	assert.Len(t, podList.Items, pods)
+
+	podList = &corev1.PodList{}
+	err = ListInto(context.TODO(), lister, podList)
+	require.NoError(t, err)
+	assert.Len(t, podList.Items, pods)
+	assert.Equal(t, 3, lister.calls)
+}
+
+func TestCollectPages(t *testing.T) {
+	testCases := []struct {
+		limit         int64
+		listError     error
+		expectedNames []string
+	}{
+		{
+			expectedNames: []string{"0", "1", "2", "3", "4"},
+		},
+		{
+			limit:         2,
+			expectedNames: []string{"0", "1"},
+		},
+		{
+			listError: fmt.Errorf("test error"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		lister := &pagedPodLister{pods: 5, err: testCase.listError}
+
+		podList, err := CollectPages(context.TODO(), metav1.ListOptions{Limit: testCase.limit}, lister.list)
+
+		assert.Equal(t, testCase.listError, err)
+
+		if testCase.listError != nil {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedNames, podNames(podList.Items))
+		assert.Empty(t, podList.Continue)
+	}
+}
+
+func TestListInto(t *testing.T) {
+	testCases := []struct {
+		options       []runtimeClient.ListOption
+		listError     error
+		expectedCalls int
+		expectedNames []string
+	}{
+		{
+			expectedCalls: 1,
+			expectedNames: []string{"0", "1", "2", "3", "4"},
+		},
+		{
+			options:       []runtimeClient.ListOption{WithPageSize(2)},
+			expectedCalls: 3,
+			expectedNames: []string{"0", "1", "2", "3", "4"},
+		},
+		{
+			options:       []runtimeClient.ListOption{WithPageSize(2), runtimeClient.Limit(3)},
+			expectedCalls: 2,
+			expectedNames: []string{"0", "1", "2"},
+		},
+		{
+			listError:     fmt.Errorf("test error"),
+			expectedCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		lister := &pagedPodLister{pods: 5, err: testCase.listError}
+		podList := &corev1.PodList{}
+
+		err := ListInto(context.TODO(), lister, podList, testCase.options...)
+
+		assert.Equal(t, testCase.listError, err)
+		assert.Equal(t, testCase.expectedCalls, lister.calls)
+
+		if testCase.listError != nil {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedNames, podNames(podList.Items))
+		assert.Empty(t, podList.Continue)
+	}
+}
+
+func podNames(pods []corev1.Pod) []string {
+	var names []string
+
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+
+	return names
+}
+
+// pagedPodLister serves a list of pods a page at a time, using the offset of the next page as the continue token. It
+// may be used as either the list function of a clientset or a controller-runtime client.Reader. If ignoreLimit is set,
+// every remaining pod is returned in one page, as the fake clients do.
+type pagedPodLister struct {
+	runtimeClient.Reader
+
+	pods        int
+	ignoreLimit bool
+	err         error
+	calls       int
+	limit       int64
+}
+
+func (lister *pagedPodLister) List(
+	ctx context.Context, list runtimeClient.ObjectList, options ...runtimeClient.ListOption) error {
+	podList, ok := list.(*corev1.PodList)
+	if !ok {
+		return fmt.Errorf("unexpected list type %T", list)
+	}
+
+	page, err := lister.list(ctx, *(&runtimeClient.ListOptions{}).ApplyOptions(options).AsListOptions())
+	if err != nil {
+		return err
+	}
+
+	*podList = *page
+
+	return nil
+}
+
+func (lister *pagedPodLister) list(_ context.Context, options metav1.ListOptions) (*corev1.PodList, error) {
+	lister.calls++
+	lister.limit = options.Limit
+
+	if lister.err != nil {
+		return nil, lister.err
+	}
+
+	start := 0
+
+	if options.Continue != "" {
+		var err error
+
+		start, err = strconv.Atoi(options.Continue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	end := lister.pods
+	if !lister.ignoreLimit {
+		end = min(start+int(options.Limit), lister.pods)
+	}
+	podList := &corev1.PodList{}
+
+	for index := start; index < end; index++ {
+		podList.Items = append(podList.Items, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: strconv.Itoa(index)}})
+	}
+
+	if end < lister.pods {
+		podList.Continue = strconv.Itoa(end)
+	}
+
+	return podList, nil
+}
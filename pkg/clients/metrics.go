@@ -0,0 +1,248 @@
+package clients
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// metricsNamespace is the namespace of all metrics collected by Metrics.
+const metricsNamespace = "eco_goinfra"
+
+// metricsLabels are the labels shared by all metrics collected by Metrics.
+var metricsLabels = []string{"group", "version", "kind", "operation"}
+
+// Metrics collects per-GVK, per-operation counts, latencies, and retries for the operations performed by the common
+// builder functions, such as creates, updates, lists, and waits. It may be shared between multiple Settings. The
+// metrics are exposed both through a Prometheus registry and as a summary meant to be dumped at the end of a test
+// suite. A nil Metrics collects nothing.
+//
+// Of the builders which do not use the common functions, only the pod, deployment, and configmap builders record their
+// gets, creates, updates, deletes, and waits. They do not retry API calls, so they never record retries.
+type Metrics struct {
+	registry   *prometheus.Registry
+	operations *prometheus.CounterVec
+	durations  *prometheus.HistogramVec
+	retries    *prometheus.CounterVec
+
+	mutex     sync.Mutex
+	summaries map[operationKey]*OperationSummary
+}
+
+// operationKey identifies the operations aggregated into a single OperationSummary.
+type operationKey struct {
+	gvk       schema.GroupVersionKind
+	operation string
+}
+
+// OperationSummary aggregates all of the operations of one type performed on resources of one GVK.
+type OperationSummary struct {
+	GVK       schema.GroupVersionKind
+	Operation string
+	// Count is the number of operations, including failed ones.
+	Count int
+	// Failures is the number of operations that ended in an error.
+	Failures int
+	// Retries is the number of API calls retried across all operations.
+	Retries int
+	// TotalDuration is the sum of the durations of all operations, including retries.
+	TotalDuration time.Duration
+	// MaxDuration is the duration of the longest operation.
+	MaxDuration time.Duration
+}
+
+// MeanDuration returns the mean duration of the operations, or zero if there were none.
+func (summary OperationSummary) MeanDuration() time.Duration {
+	if summary.Count == 0 {
+		return 0
+	}
+
+	return summary.TotalDuration / time.Duration(summary.Count)
+}
+
+// NewMetrics returns a new Metrics with its own Prometheus registry.
+func NewMetrics() *Metrics {
+	metrics := &Metrics{
+		registry: prometheus.NewRegistry(),
+		operations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "operations_total",
+			Help:      "Number of operations performed by eco-goinfra builders, partitioned by outcome.",
+		}, append(slices.Clone(metricsLabels), "outcome")),
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of operations performed by eco-goinfra builders, including retries and waits.",
+			// Waits may take hours, so the buckets range from 5ms up to about 6 hours.
+			Buckets: prometheus.ExponentialBuckets(0.005, 4, 12),
+		}, metricsLabels),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "retries_total",
+			Help:      "Number of API calls retried by eco-goinfra builders.",
+		}, metricsLabels),
+		summaries: make(map[operationKey]*OperationSummary),
+	}
+
+	metrics.registry.MustRegister(metrics.operations, metrics.durations, metrics.retries)
+
+	return metrics
+}
+
+// GetMetrics returns the Metrics of the Settings, which may be nil.
+func (settings *Settings) GetMetrics() *Metrics {
+	if settings == nil {
+		return nil
+	}
+
+	return settings.Metrics
+}
+
+// MetricsFrom returns the Metrics of the client if it has a GetMetrics method, such as Settings. Otherwise, it returns
+// nil, which collects nothing.
+func MetricsFrom(client any) *Metrics {
+	if getter, ok := client.(interface{ GetMetrics() *Metrics }); ok {
+		return getter.GetMetrics()
+	}
+
+	return nil
+}
+
+// Registry returns the Prometheus registry containing the metrics. It may be served using promhttp or gathered
+// directly.
+func (metrics *Metrics) Registry() *prometheus.Registry {
+	if metrics == nil {
+		return nil
+	}
+
+	return metrics.registry
+}
+
+// ObserveOperation records an operation on a resource of the provided GVK which took duration and ended with outcome,
+// such as success or not_found. Outcomes other than success count as failures in the summary.
+func (metrics *Metrics) ObserveOperation(
+	gvk schema.GroupVersionKind, operation, outcome string, duration time.Duration) {
+	if metrics == nil {
+		return
+	}
+
+	metrics.operations.WithLabelValues(gvk.Group, gvk.Version, gvk.Kind, operation, outcome).Inc()
+	metrics.durations.WithLabelValues(gvk.Group, gvk.Version, gvk.Kind, operation).Observe(duration.Seconds())
+
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	summary := metrics.getSummary(gvk, operation)
+	summary.Count++
+	summary.TotalDuration += duration
+	summary.MaxDuration = max(summary.MaxDuration, duration)
+
+	if outcome != "success" {
+		summary.Failures++
+	}
+}
+
+// ObserveRetries records that retries API calls were retried during an operation on a resource of the provided GVK.
+func (metrics *Metrics) ObserveRetries(gvk schema.GroupVersionKind, operation string, retries int) {
+	if metrics == nil || retries <= 0 {
+		return
+	}
+
+	metrics.retries.WithLabelValues(gvk.Group, gvk.Version, gvk.Kind, operation).Add(float64(retries))
+
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	metrics.getSummary(gvk, operation).Retries += retries
+}
+
+// Summary returns the summaries of all operations observed so far, sorted by total duration with the longest first.
+func (metrics *Metrics) Summary() []OperationSummary {
+	if metrics == nil {
+		return nil
+	}
+
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	summaries := make([]OperationSummary, 0, len(metrics.summaries))
+	for _, summary := range metrics.summaries {
+		summaries = append(summaries, *summary)
+	}
+
+	slices.SortFunc(summaries, func(a, b OperationSummary) int {
+		return cmp.Or(
+			cmp.Compare(b.TotalDuration, a.TotalDuration),
+			cmp.Compare(a.GVK.String(), b.GVK.String()),
+			cmp.Compare(a.Operation, b.Operation))
+	})
+
+	return summaries
+}
+
+// WriteSummary writes the summary as a table, with the operations taking the longest in total first. It is meant to be
+// called at the end of a test suite to show which waits dominated the runtime and which resources were used most.
+func (metrics *Metrics) WriteSummary(writer io.Writer) error {
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	_, err := fmt.Fprintln(tabWriter, "GVK\tOPERATION\tCOUNT\tFAILURES\tRETRIES\tTOTAL\tMEAN\tMAX")
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range metrics.Summary() {
+		_, err = fmt.Fprintf(tabWriter, "%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n",
+			formatGVK(summary.GVK), summary.Operation, summary.Count, summary.Failures, summary.Retries,
+			summary.TotalDuration.Round(time.Millisecond),
+			summary.MeanDuration().Round(time.Millisecond),
+			summary.MaxDuration.Round(time.Millisecond))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tabWriter.Flush()
+}
+
+// SummaryProperties returns the summary as name/value pairs, one for each GVK and operation, which may be added as
+// properties to a report, such as using reportxml.CreateWithProperties. The names are formatted as
+// `eco-goinfra-<operation>:<gvk>`.
+func (metrics *Metrics) SummaryProperties() map[string]string {
+	properties := make(map[string]string)
+
+	for _, summary := range metrics.Summary() {
+		name := fmt.Sprintf("eco-goinfra-%s:%s", summary.Operation, formatGVK(summary.GVK))
+		properties[name] = fmt.Sprintf("count=%d failures=%d retries=%d total=%s mean=%s max=%s",
+			summary.Count, summary.Failures, summary.Retries,
+			summary.TotalDuration.Round(time.Millisecond),
+			summary.MeanDuration().Round(time.Millisecond),
+			summary.MaxDuration.Round(time.Millisecond))
+	}
+
+	return properties
+}
+
+// getSummary returns the summary for the GVK and operation, creating it if necessary. The mutex must be held.
+func (metrics *Metrics) getSummary(gvk schema.GroupVersionKind, operation string) *OperationSummary {
+	key := operationKey{gvk: gvk, operation: operation}
+
+	summary, ok := metrics.summaries[key]
+	if !ok {
+		summary = &OperationSummary{GVK: gvk, Operation: operation}
+		metrics.summaries[key] = summary
+	}
+
+	return summary
+}
+
+// formatGVK formats the GVK as group/version/kind, omitting the group for the core API group.
+func formatGVK(gvk schema.GroupVersionKind) string {
+	return gvk.GroupVersion().String() + "/" + gvk.Kind
+}
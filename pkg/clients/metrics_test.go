@@ -0,0 +1,78 @@
+package clients
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestMetricsSummary(t *testing.T) {
+	t.Parallel()
+
+	configMapGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	deploymentGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	metrics := NewMetrics()
+	metrics.ObserveOperation(configMapGVK, "create", "success", time.Second)
+	metrics.ObserveOperation(configMapGVK, "create", "already_exists", 3*time.Second)
+	metrics.ObserveRetries(configMapGVK, "create", 2)
+	metrics.ObserveRetries(configMapGVK, "create", 0)
+	metrics.ObserveOperation(deploymentGVK, "wait", "success", time.Minute)
+
+	summaries := metrics.Summary()
+	assert.Equal(t, []OperationSummary{
+		{
+			GVK:           deploymentGVK,
+			Operation:     "wait",
+			Count:         1,
+			TotalDuration: time.Minute,
+			MaxDuration:   time.Minute,
+		},
+		{
+			GVK:           configMapGVK,
+			Operation:     "create",
+			Count:         2,
+			Failures:      1,
+			Retries:       2,
+			TotalDuration: 4 * time.Second,
+			MaxDuration:   3 * time.Second,
+		},
+	}, summaries)
+	assert.Equal(t, 2*time.Second, summaries[1].MeanDuration())
+	assert.Equal(t, time.Duration(0), OperationSummary{}.MeanDuration())
+
+	var buffer bytes.Buffer
+
+	err := metrics.WriteSummary(&buffer)
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.Contains(t, lines[1], "apps/v1/Deployment")
+	assert.Contains(t, lines[2], "v1/ConfigMap")
+
+	assert.Equal(t, map[string]string{
+		"eco-goinfra-wait:apps/v1/Deployment": "count=1 failures=0 retries=0 total=1m0s mean=1m0s max=1m0s",
+		"eco-goinfra-create:v1/ConfigMap":     "count=2 failures=1 retries=2 total=4s mean=2s max=3s",
+	}, metrics.SummaryProperties())
+}
+
+func TestMetricsNil(t *testing.T) {
+	t.Parallel()
+
+	var metrics *Metrics
+
+	metrics.ObserveOperation(schema.GroupVersionKind{}, "create", "success", time.Second)
+	metrics.ObserveRetries(schema.GroupVersionKind{}, "create", 1)
+
+	assert.Nil(t, metrics.Registry())
+	assert.Empty(t, metrics.Summary())
+	assert.Empty(t, metrics.SummaryProperties())
+	assert.Nil(t, MetricsFrom(&Settings{}))
+	assert.Nil(t, MetricsFrom("not a client"))
+	assert.Equal(t, metrics, MetricsFrom(&Settings{Metrics: metrics}))
+}
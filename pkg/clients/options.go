@@ -0,0 +1,278 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+)
+
+// Option configures the Settings created by NewWithOptions and NewFromRESTConfig.
+type Option func(options *options) error
+
+// options holds the values set by each Option. Zero values keep the value of the rest config.
+type options struct {
+	qps               float32
+	burst             int
+	timeout           time.Duration
+	userAgent         string
+	kubeconfigContext string
+	lazyTransport     bool
+}
+
+// WithQPS sets the maximum sustained queries per second of each of the clients of the Settings. It must be positive.
+// If not set, the client-go default of 5 is used unless the rest config sets it.
+func WithQPS(qps float32) Option {
+	return func(options *options) error {
+		if qps <= 0 {
+			return fmt.Errorf("qps must be positive, got %v", qps)
+		}
+
+		options.qps = qps
+
+		return nil
+	}
+}
+
+// WithBurst sets the maximum burst of queries of each of the clients of the Settings. It must be positive. If not set,
+// the client-go default of 10 is used unless the rest config sets it.
+func WithBurst(burst int) Option {
+	return func(options *options) error {
+		if burst <= 0 {
+			return fmt.Errorf("burst must be positive, got %d", burst)
+		}
+
+		options.burst = burst
+
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of each request sent by the clients of the Settings. It must be positive. Watches are
+// also subject to this timeout, so it should be longer than any wait that relies on them.
+func WithTimeout(timeout time.Duration) Option {
+	return func(options *options) error {
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive, got %s", timeout)
+		}
+
+		options.timeout = timeout
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent by the clients of the Settings, which shows up in the audit logs of
+// the API server. It cannot be empty.
+func WithUserAgent(userAgent string) Option {
+	return func(options *options) error {
+		if userAgent == "" {
+			return fmt.Errorf("user agent cannot be empty")
+		}
+
+		options.userAgent = userAgent
+
+		return nil
+	}
+}
+
+// WithKubeconfigContext selects the context to use from a kubeconfig with multiple contexts instead of its current
+// context. It only applies to NewWithOptions with a kubeconfig and cannot be empty.
+func WithKubeconfigContext(name string) Option {
+	return func(options *options) error {
+		if name == "" {
+			return fmt.Errorf("kubeconfig context cannot be empty")
+		}
+
+		options.kubeconfigContext = name
+
+		return nil
+	}
+}
+
+// WithLazyTransport defers building the transport shared by all of the clientsets of the Settings until the first
+// request is sent. Building the transport loads certificates and may run exec credential plugins, so this lets a
+// Settings be created before credentials are available. Errors building the transport are returned from the request
+// that triggered it, and the transport is built again on the next request, so a Settings created too early recovers
+// once the credentials become available. The runtime client and its REST mapper are also only created on first use.
+// The typed clientsets are still created by the constructor, but they only wrap the shared transport and do not send
+// requests or load credentials until they are used.
+func WithLazyTransport() Option {
+	return func(options *options) error {
+		options.lazyTransport = true
+
+		return nil
+	}
+}
+
+// NewWithOptions returns a *Settings with the given kubeconfig and options. If kubeconfig is empty, the KUBECONFIG
+// environment variable is used and, if that is also empty, the in-cluster config. Unlike New, it returns the error
+// when the Settings cannot be created.
+func NewWithOptions(kubeconfig string, opts ...Option) (*Settings, error) {
+	clientOptions, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if kubeconfig == "" {
+		kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
+	config, err := loadConfig(kubeconfig, clientOptions.kubeconfigContext)
+	if err != nil {
+		klog.V(100).Infof("Failed to load kubeconfig: %v", err)
+
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	clientSet, err := newSettingsWithOptions(config, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	clientSet.KubeconfigPath = kubeconfig
+
+	return clientSet, nil
+}
+
+// NewFromRESTConfig returns a *Settings from the provided rest config and options. The config is copied, so it is not
+// modified by the options. The KubeconfigPath of the returned Settings is left empty.
+func NewFromRESTConfig(config *rest.Config, opts ...Option) (*Settings, error) {
+	if config == nil {
+		klog.V(100).Info("The rest config is nil")
+
+		return nil, fmt.Errorf("cannot create client from nil rest config")
+	}
+
+	clientOptions, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientOptions.kubeconfigContext != "" {
+		return nil, fmt.Errorf("cannot select kubeconfig context when creating client from rest config")
+	}
+
+	return newSettingsWithOptions(rest.CopyConfig(config), clientOptions)
+}
+
+// newOptions applies all of the opts and returns the resulting options, or the first error.
+func newOptions(opts []Option) (*options, error) {
+	clientOptions := &options{}
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+
+		err := opt(clientOptions)
+		if err != nil {
+			klog.V(100).Infof("Invalid client option: %v", err)
+
+			return nil, fmt.Errorf("invalid client option: %w", err)
+		}
+	}
+
+	return clientOptions, nil
+}
+
+// loadConfig loads the rest config from the kubeconfig at the provided path, using kubeconfigContext if it is not
+// empty. If the path is empty, the in-cluster config is used instead.
+func loadConfig(kubeconfig, kubeconfigContext string) (*rest.Config, error) {
+	if kubeconfig == "" {
+		if kubeconfigContext != "" {
+			return nil, fmt.Errorf("cannot select kubeconfig context %s with in-cluster config", kubeconfigContext)
+		}
+
+		klog.V(100).Info("Using in-cluster kube client config")
+
+		return rest.InClusterConfig()
+	}
+
+	klog.V(100).Infof("Loading kube client config from path %s", kubeconfig)
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		&clientcmd.ConfigOverrides{CurrentContext: kubeconfigContext},
+	).ClientConfig()
+}
+
+// newSettingsWithOptions applies the options to the config, which is modified, and creates a new Settings from it.
+func newSettingsWithOptions(config *rest.Config, clientOptions *options) (*Settings, error) {
+	if clientOptions.qps > 0 {
+		config.QPS = clientOptions.qps
+	}
+
+	if clientOptions.burst > 0 {
+		config.Burst = clientOptions.burst
+	}
+
+	if clientOptions.timeout > 0 {
+		config.Timeout = clientOptions.timeout
+	}
+
+	if clientOptions.userAgent != "" {
+		config.UserAgent = clientOptions.userAgent
+	}
+
+	if !clientOptions.lazyTransport {
+		return newSettingsFromConfig(config)
+	}
+
+	klog.V(100).Info("Deferring creation of apiClient transport until first request")
+
+	return newSettingsFromConfigAndClient(config, &http.Client{
+		Transport: &lazyTransport{config: config},
+		Timeout:   config.Timeout,
+	}, true)
+}
+
+// lazyTransport is an http.RoundTripper that builds the transport for its config on the first request. If building the
+// transport fails, it is tried again on the next request.
+type lazyTransport struct {
+	config    *rest.Config
+	mutex     sync.Mutex
+	transport http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (transport *lazyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	roundTripper, err := transport.getTransport()
+	if err != nil {
+		if request.Body != nil {
+			_ = request.Body.Close()
+		}
+
+		return nil, fmt.Errorf("failed to create transport: %w", err)
+	}
+
+	return roundTripper.RoundTrip(request)
+}
+
+// getTransport returns the transport for the config, building it if it has not been built successfully yet.
+func (transport *lazyTransport) getTransport() (http.RoundTripper, error) {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	if transport.transport != nil {
+		return transport.transport, nil
+	}
+
+	klog.V(100).Info("Creating apiClient transport")
+
+	roundTripper, err := rest.TransportFor(transport.config)
+	if err != nil {
+		klog.V(100).Infof("Failed to create apiClient transport: %v", err)
+
+		return nil, err
+	}
+
+	transport.transport = roundTripper
+
+	return roundTripper, nil
+}
@@ -0,0 +1,281 @@
+package clients
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const optionsTestKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: first
+  cluster:
+    server: https://first.example.com:6443
+- name: second
+  cluster:
+    server: https://second.example.com:6443
+contexts:
+- name: first
+  context:
+    cluster: first
+    user: admin
+- name: second
+  context:
+    cluster: second
+    user: admin
+current-context: first
+users:
+- name: admin
+  user:
+    token: test-token
+`
+
+func TestNewWithOptions(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	require.NoError(t, os.WriteFile(kubeconfig, []byte(optionsTestKubeconfig), 0o600))
+
+	testCases := []struct {
+		name          string
+		kubeconfig    string
+		options       []Option
+		expectedHost  string
+		expectedError string
+	}{
+		{
+			name:         "current context",
+			kubeconfig:   kubeconfig,
+			expectedHost: "https://first.example.com:6443",
+		},
+		{
+			name:         "selected context",
+			kubeconfig:   kubeconfig,
+			options:      []Option{WithKubeconfigContext("second")},
+			expectedHost: "https://second.example.com:6443",
+		},
+		{
+			name:          "missing context",
+			kubeconfig:    kubeconfig,
+			options:       []Option{WithKubeconfigContext("missing")},
+			expectedError: "failed to load kubeconfig: context \"missing\" does not exist",
+		},
+		{
+			name:          "missing kubeconfig",
+			kubeconfig:    filepath.Join(t.TempDir(), "missing"),
+			expectedError: "failed to load kubeconfig:",
+		},
+		{
+			name:          "invalid option",
+			kubeconfig:    kubeconfig,
+			options:       []Option{WithQPS(-1)},
+			expectedError: "invalid client option: qps must be positive, got -1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings, err := NewWithOptions(testCase.kubeconfig, testCase.options...)
+
+		if testCase.expectedError != "" {
+			require.Error(t, err, testCase.name)
+			assert.Contains(t, err.Error(), testCase.expectedError, testCase.name)
+			assert.Nil(t, settings, testCase.name)
+
+			continue
+		}
+
+		require.NoError(t, err, testCase.name)
+		assert.Equal(t, testCase.expectedHost, settings.Config.Host, testCase.name)
+		assert.Equal(t, testCase.kubeconfig, settings.KubeconfigPath, testCase.name)
+	}
+}
+
+func TestNewFromRESTConfig(t *testing.T) {
+	config := &rest.Config{Host: "https://api.example.com:6443"}
+
+	settings, err := NewFromRESTConfig(config,
+		WithQPS(50), WithBurst(100), WithTimeout(time.Minute), WithUserAgent("eco-goinfra-test"))
+	require.NoError(t, err)
+
+	assert.Equal(t, float32(50), settings.Config.QPS)
+	assert.Equal(t, 100, settings.Config.Burst)
+	assert.Equal(t, time.Minute, settings.Config.Timeout)
+	assert.Equal(t, "eco-goinfra-test", settings.Config.UserAgent)
+	assert.Empty(t, settings.KubeconfigPath)
+	assert.Equal(t, &rest.Config{Host: "https://api.example.com:6443"}, config, "config should not be modified")
+}
+
+func TestNewFromRESTConfigErrors(t *testing.T) {
+	testCases := []struct {
+		config        *rest.Config
+		options       []Option
+		expectedError string
+	}{
+		{
+			config:        nil,
+			expectedError: "cannot create client from nil rest config",
+		},
+		{
+			config:        &rest.Config{},
+			options:       []Option{WithKubeconfigContext("first")},
+			expectedError: "cannot select kubeconfig context when creating client from rest config",
+		},
+		{
+			config:        &rest.Config{},
+			options:       []Option{WithBurst(0)},
+			expectedError: "invalid client option: burst must be positive, got 0",
+		},
+		{
+			config:        &rest.Config{},
+			options:       []Option{WithTimeout(-time.Second)},
+			expectedError: "invalid client option: timeout must be positive, got -1s",
+		},
+		{
+			config:        &rest.Config{},
+			options:       []Option{WithUserAgent("")},
+			expectedError: "invalid client option: user agent cannot be empty",
+		},
+		{
+			config:        &rest.Config{},
+			options:       []Option{WithKubeconfigContext("")},
+			expectedError: "invalid client option: kubeconfig context cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings, err := NewFromRESTConfig(testCase.config, testCase.options...)
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.Nil(t, settings)
+	}
+}
+
+func TestNewFromRESTConfigUserAgent(t *testing.T) {
+	var (
+		mutex      sync.Mutex
+		userAgents []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		mutex.Lock()
+		userAgents = append(userAgents, request.UserAgent())
+		mutex.Unlock()
+
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`))
+	}))
+	defer server.Close()
+
+	for _, lazyTransport := range []bool{false, true} {
+		options := []Option{WithUserAgent("eco-goinfra-test")}
+		if lazyTransport {
+			options = append(options, WithLazyTransport())
+		}
+
+		settings, err := NewFromRESTConfig(&rest.Config{Host: server.URL}, options...)
+		require.NoError(t, err)
+
+		_, err = settings.ConfigMaps("test").Get(context.TODO(), "test", metav1.GetOptions{})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"eco-goinfra-test", "eco-goinfra-test"}, userAgents)
+}
+
+func TestNewFromRESTConfigLazyTransport(t *testing.T) {
+	config := &rest.Config{
+		Host:            "https://api.example.com:6443",
+		TLSClientConfig: rest.TLSClientConfig{CAFile: filepath.Join(t.TempDir(), "missing-ca.crt")},
+	}
+
+	_, err := NewFromRESTConfig(config)
+	assert.Error(t, err, "an eager transport should fail to load the missing CA")
+
+	settings, err := NewFromRESTConfig(config, WithLazyTransport())
+	require.NoError(t, err)
+
+	_, err = settings.ConfigMaps("test").Get(context.TODO(), "test", metav1.GetOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create transport")
+}
+
+func TestNewFromRESTConfigLazyTransportRetry(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`))
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	settings, err := NewFromRESTConfig(&rest.Config{
+		Host:            server.URL,
+		TLSClientConfig: rest.TLSClientConfig{CAFile: caFile},
+	}, WithLazyTransport())
+	require.NoError(t, err)
+
+	_, err = settings.ConfigMaps("test").Get(context.TODO(), "test", metav1.GetOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create transport")
+
+	// Once the CA is available, the next request builds the transport instead of returning the first error again.
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+
+	_, err = settings.ConfigMaps("test").Get(context.TODO(), "test", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestNewFromRESTConfigLazyRuntimeClient(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requests.Add(1)
+
+		writer.Header().Set("Content-Type", "application/json")
+
+		switch request.URL.Path {
+		case "/api":
+			_, _ = writer.Write([]byte(`{"kind":"APIVersions","versions":["v1"]}`))
+		case "/apis":
+			_, _ = writer.Write([]byte(`{"kind":"APIGroupList","groups":[]}`))
+		case "/api/v1":
+			_, _ = writer.Write([]byte(`{"kind":"APIResourceList","groupVersion":"v1","resources":[` +
+				`{"name":"configmaps","singularName":"configmap","namespaced":true,"kind":"ConfigMap","verbs":["get"]}]}`))
+		default:
+			_, _ = writer.Write([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`))
+		}
+	}))
+	defer server.Close()
+
+	settings, err := NewFromRESTConfig(&rest.Config{Host: server.URL}, WithLazyTransport())
+	require.NoError(t, err)
+
+	lazy, ok := settings.Client.(*lazyClient)
+	require.True(t, ok)
+	assert.Nil(t, lazy.client)
+
+	// The scheme and kinds are available without creating the runtime client.
+	gvk, err := settings.GroupVersionKindFor(&corev1.ConfigMap{})
+	assert.NoError(t, err)
+	assert.Equal(t, "ConfigMap", gvk.Kind)
+	assert.Nil(t, lazy.client)
+	assert.Equal(t, int32(0), requests.Load())
+
+	configMap := &corev1.ConfigMap{}
+	err = settings.Get(context.TODO(), runtimeClient.ObjectKey{Name: "test", Namespace: "test"}, configMap)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", configMap.Name)
+	assert.NotNil(t, lazy.client)
+}
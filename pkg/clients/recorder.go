@@ -0,0 +1,222 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewRecording returns a copy of the Settings whose runtime client records every request it makes. The returned
+// RecordingClient provides the recorded cassette. Only requests made through the runtime client are recorded, not
+// those made through the typed or dynamic clients.
+func (settings *Settings) NewRecording() (*Settings, *RecordingClient, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, nil, fmt.Errorf("cannot record using nil client")
+	}
+
+	if settings.Client == nil {
+		klog.V(100).Info("The APIClient has no runtime client")
+
+		return nil, nil, fmt.Errorf("cannot record without runtime client")
+	}
+
+	recorder := NewRecordingClient(settings.Client)
+	recordingSettings := *settings
+	recordingSettings.Client = recorder
+
+	return &recordingSettings, recorder, nil
+}
+
+// RecordingClient wraps a runtime client and records each request it makes, along with the response, into a
+// cassette. It does not support watches, so waiting on a RecordingClient will poll and each poll will be recorded.
+type RecordingClient struct {
+	runtimeClient.Client
+
+	mutex    sync.Mutex
+	cassette Cassette
+}
+
+var _ runtimeClient.Client = (*RecordingClient)(nil)
+
+// NewRecordingClient returns a RecordingClient wrapping the provided client.
+func NewRecordingClient(client runtimeClient.Client) *RecordingClient {
+	return &RecordingClient{Client: client}
+}
+
+// Cassette returns a copy of the cassette containing all of the interactions recorded so far.
+func (client *RecordingClient) Cassette() *Cassette {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	return &Cassette{Interactions: slices.Clone(client.cassette.Interactions)}
+}
+
+// Get implements the runtimeClient.Reader interface.
+func (client *RecordingClient) Get(
+	ctx context.Context, key runtimeClient.ObjectKey, obj runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+	err := client.Client.Get(ctx, key, obj, opts...)
+	client.record(cassetteOperationGet, "", key.Namespace, key.Name, obj, nil, nil, obj, err)
+
+	return err
+}
+
+// List implements the runtimeClient.Reader interface.
+func (client *RecordingClient) List(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+	err := client.Client.List(ctx, list, opts...)
+	listOptions := (&runtimeClient.ListOptions{}).ApplyOptions(opts)
+	client.record(cassetteOperationList, "", listOptions.Namespace, "", list, listOptions, nil, list, err)
+
+	return err
+}
+
+// Create implements the runtimeClient.Writer interface.
+func (client *RecordingClient) Create(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.CreateOption) error {
+	// The key uses the namespace and name as they were requested, since the name of an object using generateName is only
+	// set once it is created and would not be known when the create is replayed.
+	namespace, name := obj.GetNamespace(), obj.GetName()
+	request, _ := json.Marshal(obj)
+	err := client.Client.Create(ctx, obj, opts...)
+	client.record(cassetteOperationCreate, "", namespace, name, obj, nil, request, obj, err)
+
+	return err
+}
+
+// Update implements the runtimeClient.Writer interface.
+func (client *RecordingClient) Update(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.UpdateOption) error {
+	request, _ := json.Marshal(obj)
+	err := client.Client.Update(ctx, obj, opts...)
+	client.record(cassetteOperationUpdate, "", obj.GetNamespace(), obj.GetName(), obj, nil, request, obj, err)
+
+	return err
+}
+
+// Patch implements the runtimeClient.Writer interface.
+func (client *RecordingClient) Patch(
+	ctx context.Context, obj runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.PatchOption) error {
+	request, _ := patch.Data(obj)
+	err := client.Client.Patch(ctx, obj, patch, opts...)
+	client.record(cassetteOperationPatch, "", obj.GetNamespace(), obj.GetName(), obj, nil, request, obj, err)
+
+	return err
+}
+
+// Delete implements the runtimeClient.Writer interface.
+func (client *RecordingClient) Delete(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.DeleteOption) error {
+	err := client.Client.Delete(ctx, obj, opts...)
+	client.record(cassetteOperationDelete, "", obj.GetNamespace(), obj.GetName(), obj, nil, nil, nil, err)
+
+	return err
+}
+
+// DeleteAllOf implements the runtimeClient.Writer interface.
+func (client *RecordingClient) DeleteAllOf(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.DeleteAllOfOption) error {
+	err := client.Client.DeleteAllOf(ctx, obj, opts...)
+	deleteOptions := (&runtimeClient.DeleteAllOfOptions{}).ApplyOptions(opts)
+	client.record(cassetteOperationDeleteAllOf, "", deleteOptions.Namespace, "", obj, &deleteOptions.ListOptions,
+		nil, nil, err)
+
+	return err
+}
+
+// Status implements the runtimeClient.StatusClient interface.
+func (client *RecordingClient) Status() runtimeClient.SubResourceWriter {
+	return client.SubResource("status")
+}
+
+// SubResource implements the runtimeClient.SubResourceClientConstructor interface.
+func (client *RecordingClient) SubResource(subResource string) runtimeClient.SubResourceClient {
+	return &recordingSubResourceClient{
+		client:      client,
+		inner:       client.Client.SubResource(subResource),
+		subResource: subResource,
+	}
+}
+
+// record appends an interaction to the cassette. The keyObject is only used to determine the GroupVersionKind of the
+// request and the listOptions its selectors, while the response is marshaled only if err is nil.
+func (client *RecordingClient) record(
+	operation, subResource, namespace, name string,
+	keyObject runtime.Object, listOptions *runtimeClient.ListOptions,
+	request []byte, response runtime.Object, err error) {
+	interaction := Interaction{
+		InteractionKey: newInteractionKey(
+			client.Client, operation, subResource, namespace, name, keyObject, listOptions),
+		Request: request,
+	}
+
+	if err != nil {
+		interaction.Error = newCassetteStatus(err)
+	} else if response != nil {
+		interaction.Response, _ = json.Marshal(response)
+	}
+
+	klog.V(100).Infof("Recording interaction: %s", interaction.String())
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.cassette.Interactions = append(client.cassette.Interactions, interaction)
+}
+
+// recordingSubResourceClient records the requests made to a subresource through a RecordingClient.
+type recordingSubResourceClient struct {
+	client      *RecordingClient
+	inner       runtimeClient.SubResourceClient
+	subResource string
+}
+
+// Get implements the runtimeClient.SubResourceReader interface.
+func (subClient *recordingSubResourceClient) Get(
+	ctx context.Context, obj, subResource runtimeClient.Object, opts ...runtimeClient.SubResourceGetOption) error {
+	err := subClient.inner.Get(ctx, obj, subResource, opts...)
+	subClient.client.record(cassetteOperationGet, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, nil, subResource, err)
+
+	return err
+}
+
+// Create implements the runtimeClient.SubResourceWriter interface.
+func (subClient *recordingSubResourceClient) Create(
+	ctx context.Context, obj, subResource runtimeClient.Object, opts ...runtimeClient.SubResourceCreateOption) error {
+	request, _ := json.Marshal(subResource)
+	err := subClient.inner.Create(ctx, obj, subResource, opts...)
+	subClient.client.record(cassetteOperationCreate, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, request, subResource, err)
+
+	return err
+}
+
+// Update implements the runtimeClient.SubResourceWriter interface.
+func (subClient *recordingSubResourceClient) Update(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.SubResourceUpdateOption) error {
+	request, _ := json.Marshal(obj)
+	err := subClient.inner.Update(ctx, obj, opts...)
+	subClient.client.record(cassetteOperationUpdate, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, request, obj, err)
+
+	return err
+}
+
+// Patch implements the runtimeClient.SubResourceWriter interface.
+func (subClient *recordingSubResourceClient) Patch(ctx context.Context,
+	obj runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.SubResourcePatchOption) error {
+	request, _ := patch.Data(obj)
+	err := subClient.inner.Patch(ctx, obj, patch, opts...)
+	subClient.client.record(cassetteOperationPatch, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, request, obj, err)
+
+	return err
+}
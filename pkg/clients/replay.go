@@ -0,0 +1,225 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeRuntimeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// GetReplayTestClients returns a fake clientset for testing where the runtime client serves the interactions from the
+// cassette rather than using the fake runtime client. The other clients are the same as with GetTestClients.
+func GetReplayTestClients(tcp TestClientParams, cassette *Cassette) *Settings {
+	clientSet, _ := GetModifiableTestClients(tcp)
+	if clientSet == nil {
+		return nil
+	}
+
+	clientSet.Client = NewReplayClient(cassette, clientSet.scheme)
+
+	return clientSet
+}
+
+// ReplayClient is a runtime client that serves the interactions recorded in a cassette rather than talking to a
+// cluster. Requests are matched to interactions using their InteractionKey and interactions with the same key are
+// served in the order they were recorded. Once all of the interactions for a get or list are used, the last one is
+// served again so that polling beyond what was recorded sees a stable state. Mutating requests with no remaining
+// interactions fail.
+//
+// Like RecordingClient, it does not support watches so waits will poll.
+type ReplayClient struct {
+	runtimeClient.Client
+
+	mutex     sync.Mutex
+	remaining map[InteractionKey][]Interaction
+	last      map[InteractionKey]Interaction
+}
+
+var _ runtimeClient.Client = (*ReplayClient)(nil)
+
+// NewReplayClient returns a ReplayClient serving the interactions from the cassette. The scheme is used to determine
+// the GroupVersionKind of requests and must contain all of the types in the cassette.
+func NewReplayClient(cassette *Cassette, scheme *runtime.Scheme) *ReplayClient {
+	client := &ReplayClient{
+		Client:    fakeRuntimeClient.NewClientBuilder().WithScheme(scheme).Build(),
+		remaining: make(map[InteractionKey][]Interaction),
+		last:      make(map[InteractionKey]Interaction),
+	}
+
+	if cassette != nil {
+		for _, interaction := range cassette.Interactions {
+			client.remaining[interaction.InteractionKey] = append(
+				client.remaining[interaction.InteractionKey], interaction)
+		}
+	}
+
+	return client
+}
+
+// Remaining returns the number of interactions that have not yet been served. This can be used to check that a test
+// made all of the requests that were recorded.
+func (client *ReplayClient) Remaining() int {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	count := 0
+	for _, interactions := range client.remaining {
+		count += len(interactions)
+	}
+
+	return count
+}
+
+// Get implements the runtimeClient.Reader interface.
+func (client *ReplayClient) Get(
+	_ context.Context, key runtimeClient.ObjectKey, obj runtimeClient.Object, _ ...runtimeClient.GetOption) error {
+	return client.replay(cassetteOperationGet, "", key.Namespace, key.Name, obj, nil, obj)
+}
+
+// List implements the runtimeClient.Reader interface.
+func (client *ReplayClient) List(_ context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+	listOptions := (&runtimeClient.ListOptions{}).ApplyOptions(opts)
+
+	return client.replay(cassetteOperationList, "", listOptions.Namespace, "", list, listOptions, list)
+}
+
+// Create implements the runtimeClient.Writer interface.
+func (client *ReplayClient) Create(_ context.Context, obj runtimeClient.Object, _ ...runtimeClient.CreateOption) error {
+	return client.replay(cassetteOperationCreate, "", obj.GetNamespace(), obj.GetName(), obj, nil, obj)
+}
+
+// Update implements the runtimeClient.Writer interface.
+func (client *ReplayClient) Update(_ context.Context, obj runtimeClient.Object, _ ...runtimeClient.UpdateOption) error {
+	return client.replay(cassetteOperationUpdate, "", obj.GetNamespace(), obj.GetName(), obj, nil, obj)
+}
+
+// Patch implements the runtimeClient.Writer interface.
+func (client *ReplayClient) Patch(
+	_ context.Context, obj runtimeClient.Object, _ runtimeClient.Patch, _ ...runtimeClient.PatchOption) error {
+	return client.replay(cassetteOperationPatch, "", obj.GetNamespace(), obj.GetName(), obj, nil, obj)
+}
+
+// Delete implements the runtimeClient.Writer interface.
+func (client *ReplayClient) Delete(_ context.Context, obj runtimeClient.Object, _ ...runtimeClient.DeleteOption) error {
+	return client.replay(cassetteOperationDelete, "", obj.GetNamespace(), obj.GetName(), obj, nil, nil)
+}
+
+// DeleteAllOf implements the runtimeClient.Writer interface.
+func (client *ReplayClient) DeleteAllOf(
+	_ context.Context, obj runtimeClient.Object, opts ...runtimeClient.DeleteAllOfOption) error {
+	deleteOptions := (&runtimeClient.DeleteAllOfOptions{}).ApplyOptions(opts)
+
+	return client.replay(
+		cassetteOperationDeleteAllOf, "", deleteOptions.Namespace, "", obj, &deleteOptions.ListOptions, nil)
+}
+
+// Status implements the runtimeClient.StatusClient interface.
+func (client *ReplayClient) Status() runtimeClient.SubResourceWriter {
+	return client.SubResource("status")
+}
+
+// SubResource implements the runtimeClient.SubResourceClientConstructor interface.
+func (client *ReplayClient) SubResource(subResource string) runtimeClient.SubResourceClient {
+	return &replaySubResourceClient{client: client, subResource: subResource}
+}
+
+// replay finds the next interaction for the request and, if the interaction was successful, unmarshals its response
+// into the response object. The keyObject is only used to determine the GroupVersionKind of the request and the
+// listOptions its selectors.
+func (client *ReplayClient) replay(
+	operation, subResource, namespace, name string,
+	keyObject runtime.Object, listOptions *runtimeClient.ListOptions, response runtime.Object) error {
+	key := newInteractionKey(client.Client, operation, subResource, namespace, name, keyObject, listOptions)
+
+	interaction, err := client.next(key)
+	if err != nil {
+		klog.V(100).Infof("Failed to replay request: %v", err)
+
+		return err
+	}
+
+	klog.V(100).Infof("Replaying interaction: %s", key.String())
+
+	if interaction.Error != nil {
+		return &k8serrors.StatusError{ErrStatus: *interaction.Error}
+	}
+
+	if response == nil || len(interaction.Response) == 0 {
+		return nil
+	}
+
+	// Unmarshaling into a non-empty object merges maps and slices rather than replacing them, so the object is reset
+	// first.
+	responseValue := reflect.ValueOf(response).Elem()
+	responseValue.Set(reflect.Zero(responseValue.Type()))
+
+	err = json.Unmarshal(interaction.Response, response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response for %s: %w", key.String(), err)
+	}
+
+	return nil
+}
+
+// next removes and returns the next interaction for the key. Gets and lists fall back to the last interaction served
+// for the key.
+func (client *ReplayClient) next(key InteractionKey) (Interaction, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if interactions := client.remaining[key]; len(interactions) > 0 {
+		client.remaining[key] = interactions[1:]
+		client.last[key] = interactions[0]
+
+		return interactions[0], nil
+	}
+
+	if key.Operation == cassetteOperationGet || key.Operation == cassetteOperationList {
+		if interaction, ok := client.last[key]; ok {
+			return interaction, nil
+		}
+	}
+
+	return Interaction{}, fmt.Errorf("no recorded interaction remaining for %s", key.String())
+}
+
+// replaySubResourceClient serves requests to a subresource through a ReplayClient.
+type replaySubResourceClient struct {
+	client      *ReplayClient
+	subResource string
+}
+
+// Get implements the runtimeClient.SubResourceReader interface.
+func (subClient *replaySubResourceClient) Get(
+	_ context.Context, obj, subResource runtimeClient.Object, _ ...runtimeClient.SubResourceGetOption) error {
+	return subClient.client.replay(cassetteOperationGet, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, subResource)
+}
+
+// Create implements the runtimeClient.SubResourceWriter interface.
+func (subClient *replaySubResourceClient) Create(
+	_ context.Context, obj, subResource runtimeClient.Object, _ ...runtimeClient.SubResourceCreateOption) error {
+	return subClient.client.replay(cassetteOperationCreate, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, subResource)
+}
+
+// Update implements the runtimeClient.SubResourceWriter interface.
+func (subClient *replaySubResourceClient) Update(
+	_ context.Context, obj runtimeClient.Object, _ ...runtimeClient.SubResourceUpdateOption) error {
+	return subClient.client.replay(cassetteOperationUpdate, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, obj)
+}
+
+// Patch implements the runtimeClient.SubResourceWriter interface.
+func (subClient *replaySubResourceClient) Patch(_ context.Context,
+	obj runtimeClient.Object, _ runtimeClient.Patch, _ ...runtimeClient.SubResourcePatchOption) error {
+	return subClient.client.replay(cassetteOperationPatch, subClient.subResource,
+		obj.GetNamespace(), obj.GetName(), obj, nil, obj)
+}
@@ -0,0 +1,161 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// retryPolicyContextKey is the key used to store a RetryPolicy in a context.
+type retryPolicyContextKey struct{}
+
+// RetryPolicy controls how API calls made by the common builder functions are retried when they fail with transient
+// errors, such as throttling or connection resets while the API server restarts. A nil RetryPolicy makes a single
+// attempt.
+type RetryPolicy struct {
+	// Backoff controls the delay between attempts. Backoff.Steps is the maximum number of attempts, including the
+	// first one.
+	Backoff wait.Backoff
+	// IsRetriable returns true if an error should be retried. If nil, IsTransientError is used. Conflicts are only
+	// retried for updates, where the latest resource version is fetched before each attempt, regardless of this
+	// function.
+	IsRetriable func(error) bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to 6 attempts over roughly 30 seconds, which is enough to ride
+// out an API server or etcd leader change.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Backoff: wait.Backoff{
+			Steps:    6,
+			Duration: time.Second,
+			Factor:   2,
+			Jitter:   0.1,
+			Cap:      16 * time.Second,
+		},
+	}
+}
+
+// NoRetryPolicy returns a RetryPolicy that makes a single attempt. It may be used with WithRetryPolicy to disable
+// retries for a single call when the Settings has a RetryPolicy.
+func NoRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{Backoff: wait.Backoff{Steps: 1}}
+}
+
+// WithRetryPolicy returns a copy of the context carrying the RetryPolicy. Common builder functions called with the
+// returned context use this policy rather than the one from the Settings.
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// GetRetryPolicy returns the RetryPolicy of the Settings, which may be nil.
+func (settings *Settings) GetRetryPolicy() *RetryPolicy {
+	if settings == nil {
+		return nil
+	}
+
+	return settings.RetryPolicy
+}
+
+// RetryPolicyFrom returns the RetryPolicy to use for an API call. The policy from the context, set by
+// WithRetryPolicy, takes precedence over the policy of the client, which is used if the client has a
+// GetRetryPolicy method, such as Settings. It returns nil if neither has a policy.
+func RetryPolicyFrom(ctx context.Context, client any) *RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyContextKey{}).(*RetryPolicy); ok {
+		return policy
+	}
+
+	if getter, ok := client.(interface{ GetRetryPolicy() *RetryPolicy }); ok {
+		return getter.GetRetryPolicy()
+	}
+
+	return nil
+}
+
+// Do calls apiCall until it succeeds, returns an error which is not retriable, the attempts are exhausted, or the
+// context is done. It returns the error from the last attempt. If the policy is nil, apiCall is called once.
+func (policy *RetryPolicy) Do(ctx context.Context, apiCall func(ctx context.Context) error) error {
+	return policy.do(ctx, apiCall, false)
+}
+
+// DoRetryingConflicts is the same as Do but also retries conflicts. The apiCall should fetch the latest version of the
+// resource before each attempt so conflicting changes are not overwritten blindly.
+func (policy *RetryPolicy) DoRetryingConflicts(ctx context.Context, apiCall func(ctx context.Context) error) error {
+	return policy.do(ctx, apiCall, true)
+}
+
+func (policy *RetryPolicy) do(ctx context.Context, apiCall func(ctx context.Context) error, retryConflicts bool) error {
+	if policy == nil {
+		return apiCall(ctx)
+	}
+
+	backoff := policy.Backoff
+
+	for attempt := 1; ; attempt++ {
+		err := apiCall(ctx)
+		if err == nil || backoff.Steps <= 1 || !policy.shouldRetry(err, retryConflicts) {
+			return err
+		}
+
+		delay := backoff.Step()
+
+		logging.FromContext(ctx, nil).V(logging.LevelDebug).Info(
+			"Retrying API call", "attempt", attempt, "error", err, "delay", delay)
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+func (policy *RetryPolicy) shouldRetry(err error, retryConflicts bool) bool {
+	if retryConflicts && k8serrors.IsConflict(err) {
+		return true
+	}
+
+	if policy.IsRetriable != nil {
+		return policy.IsRetriable(err)
+	}
+
+	return IsTransientError(err)
+}
+
+// IsTransientError returns true if the error is likely to succeed when retried. This includes throttling, server
+// timeouts, unavailable or internal server errors such as etcd leader changes, and connection errors seen while the
+// API server restarts. Conflicts are not considered transient since they require fetching the latest resource.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case k8serrors.IsTooManyRequests(err),
+		k8serrors.IsServerTimeout(err),
+		k8serrors.IsTimeout(err),
+		k8serrors.IsServiceUnavailable(err),
+		k8serrors.IsInternalError(err):
+		return true
+	case utilnet.IsConnectionReset(err),
+		utilnet.IsConnectionRefused(err),
+		utilnet.IsProbableEOF(err),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.EPIPE):
+		return true
+	}
+
+	return strings.Contains(err.Error(), "etcdserver: leader changed")
+}
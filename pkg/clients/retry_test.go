@@ -0,0 +1,172 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var (
+	errRetryTestTransient = k8serrors.NewTooManyRequests("slow down", 0)
+	errRetryTestConflict  = k8serrors.NewConflict(
+		schema.GroupResource{Resource: "configmaps"}, "test", errors.New("object was modified"))
+	errRetryTestPermanent = errors.New("permanent failure")
+)
+
+func TestRetryPolicyDo(t *testing.T) {
+	testCases := []struct {
+		name             string
+		policy           *RetryPolicy
+		retryConflicts   bool
+		errs             []error
+		expectedAttempts int
+		expectedError    error
+	}{
+		{
+			name:             "nil policy makes a single attempt",
+			policy:           nil,
+			errs:             []error{errRetryTestTransient, nil},
+			expectedAttempts: 1,
+			expectedError:    errRetryTestTransient,
+		},
+		{
+			name:             "transient error is retried",
+			policy:           buildTestRetryPolicy(3),
+			errs:             []error{errRetryTestTransient, errRetryTestTransient, nil},
+			expectedAttempts: 3,
+			expectedError:    nil,
+		},
+		{
+			name:             "attempts are exhausted",
+			policy:           buildTestRetryPolicy(2),
+			errs:             []error{errRetryTestTransient, errRetryTestTransient, nil},
+			expectedAttempts: 2,
+			expectedError:    errRetryTestTransient,
+		},
+		{
+			name:             "permanent error is not retried",
+			policy:           buildTestRetryPolicy(3),
+			errs:             []error{errRetryTestPermanent, nil},
+			expectedAttempts: 1,
+			expectedError:    errRetryTestPermanent,
+		},
+		{
+			name:             "conflict is not retried by default",
+			policy:           buildTestRetryPolicy(3),
+			errs:             []error{errRetryTestConflict, nil},
+			expectedAttempts: 1,
+			expectedError:    errRetryTestConflict,
+		},
+		{
+			name:             "conflict is retried when retrying conflicts",
+			policy:           buildTestRetryPolicy(3),
+			retryConflicts:   true,
+			errs:             []error{errRetryTestConflict, nil},
+			expectedAttempts: 2,
+			expectedError:    nil,
+		},
+		{
+			name: "custom retriable function is used",
+			policy: &RetryPolicy{
+				Backoff:     wait.Backoff{Steps: 3, Duration: time.Millisecond},
+				IsRetriable: func(err error) bool { return errors.Is(err, errRetryTestPermanent) },
+			},
+			errs:             []error{errRetryTestPermanent, nil},
+			expectedAttempts: 2,
+			expectedError:    nil,
+		},
+		{
+			name:             "no retry policy makes a single attempt",
+			policy:           NoRetryPolicy(),
+			errs:             []error{errRetryTestTransient, nil},
+			expectedAttempts: 1,
+			expectedError:    errRetryTestTransient,
+		},
+	}
+
+	for _, testCase := range testCases {
+		attempts := 0
+		apiCall := func(context.Context) error {
+			err := testCase.errs[attempts]
+			attempts++
+
+			return err
+		}
+
+		var err error
+		if testCase.retryConflicts {
+			err = testCase.policy.DoRetryingConflicts(context.TODO(), apiCall)
+		} else {
+			err = testCase.policy.Do(context.TODO(), apiCall)
+		}
+
+		assert.Equal(t, testCase.expectedError, err, testCase.name)
+		assert.Equal(t, testCase.expectedAttempts, attempts, testCase.name)
+	}
+}
+
+func TestRetryPolicyDoContextDone(t *testing.T) {
+	policy := &RetryPolicy{Backoff: wait.Backoff{Steps: 3, Duration: time.Hour}}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+
+	attempts := 0
+	err := policy.Do(ctx, func(context.Context) error {
+		attempts++
+
+		return errRetryTestTransient
+	})
+
+	assert.Equal(t, errRetryTestTransient, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyFrom(t *testing.T) {
+	settingsPolicy := buildTestRetryPolicy(2)
+	contextPolicy := buildTestRetryPolicy(3)
+	settings := &Settings{RetryPolicy: settingsPolicy}
+
+	assert.Nil(t, RetryPolicyFrom(context.TODO(), nil))
+	assert.Nil(t, RetryPolicyFrom(context.TODO(), &Settings{}))
+	assert.Same(t, settingsPolicy, RetryPolicyFrom(context.TODO(), settings))
+	assert.Same(t, contextPolicy, RetryPolicyFrom(WithRetryPolicy(context.TODO(), contextPolicy), settings))
+}
+
+func TestIsTransientError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		transient bool
+	}{
+		{err: nil, transient: false},
+		{err: errRetryTestTransient, transient: true},
+		{err: fmt.Errorf("wrapped: %w", errRetryTestTransient), transient: true},
+		{err: k8serrors.NewServiceUnavailable("unavailable"), transient: true},
+		{err: k8serrors.NewInternalError(errors.New("etcdserver: leader changed")), transient: true},
+		{err: k8serrors.NewServerTimeout(schema.GroupResource{Resource: "pods"}, "get", 1), transient: true},
+		{err: io.ErrUnexpectedEOF, transient: true},
+		{err: fmt.Errorf("write: %w", syscall.ECONNRESET), transient: true},
+		{err: errors.New("rpc error: etcdserver: leader changed"), transient: true},
+		{err: errRetryTestConflict, transient: false},
+		{err: k8serrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "test"), transient: false},
+		{err: errRetryTestPermanent, transient: false},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.transient, IsTransientError(testCase.err), "error: %v", testCase.err)
+	}
+}
+
+// buildTestRetryPolicy returns a retry policy with the provided number of attempts and a negligible delay.
+func buildTestRetryPolicy(steps int) *RetryPolicy {
+	return &RetryPolicy{Backoff: wait.Backoff{Steps: steps, Duration: time.Millisecond}}
+}
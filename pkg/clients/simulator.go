@@ -0,0 +1,320 @@
+package clients
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// simulatedWatchInterval is how often the objects covered by an open watch are read by the statusSimulator.
+const simulatedWatchInterval = 10 * time.Millisecond
+
+// StatusTransition simulates a controller updating an object. It is called each time an object of the type it was
+// registered for is read, before the object is returned, with reads being the number of times that object has been
+// read so far, including the current read. It returns whether it changed the object, in which case the change is
+// stored and seen by all clients.
+type StatusTransition func(object runtime.Object, reads int) bool
+
+// AfterReads returns a StatusTransition that calls update on an object of type T once it has been read reads times, so
+// that the first reads reads return the object unchanged and all later reads return the updated object. Multiple
+// AfterReads transitions may be registered for the same type to script a sequence of states, such as a pod going from
+// Pending to Running to Succeeded.
+func AfterReads[T runtime.Object](reads int, update func(object T)) StatusTransition {
+	return func(object runtime.Object, objectReads int) bool {
+		typedObject, ok := object.(T)
+		if !ok || objectReads != reads+1 {
+			return false
+		}
+
+		update(typedObject)
+
+		return true
+	}
+}
+
+// statusTransitions holds the transitions registered for a type before the scheme is available to get its GVK.
+type statusTransitions struct {
+	object      runtime.Object
+	transitions []StatusTransition
+}
+
+// WithStatusTransitions registers transitions that simulate a controller updating objects of the same type as object,
+// such as a Deployment becoming ready or a CGU completing. This lets the wait functions of builders be tested without
+// a cluster. Reads are counted per object and include Get and List calls through any of the clients. While a watch is
+// open through the controller-runtime client, the objects it covers are also read every 10 milliseconds and updates
+// are sent on the watch, so that waits using watches, such as those of the common builders, advance as well. Watches
+// through the typed and dynamic clients do not count as reads.
+func (builder *TestClientBuilder) WithStatusTransitions(
+	object runtime.Object, transitions ...StatusTransition) *TestClientBuilder {
+	builder.statusTransitions = append(builder.statusTransitions, statusTransitions{
+		object:      object,
+		transitions: transitions,
+	})
+
+	return builder
+}
+
+// statusSimulator applies the registered StatusTransitions to objects in the tracker as they are read.
+type statusSimulator struct {
+	tracker     k8stesting.ObjectTracker
+	kinds       map[schema.GroupVersionResource]schema.GroupVersionKind
+	transitions map[schema.GroupVersionResource][]StatusTransition
+
+	mutex sync.Mutex
+	reads map[simulatedObjectKey]int
+}
+
+// simulatedObjectKey identifies an object whose reads are counted by the statusSimulator.
+type simulatedObjectKey struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// newStatusSimulator returns a statusSimulator for the registered transitions.
+func newStatusSimulator(
+	tracker k8stesting.ObjectTracker, scheme *runtime.Scheme, registered []statusTransitions) (*statusSimulator, error) {
+	simulator := &statusSimulator{
+		tracker:     tracker,
+		kinds:       make(map[schema.GroupVersionResource]schema.GroupVersionKind),
+		transitions: make(map[schema.GroupVersionResource][]StatusTransition),
+		reads:       make(map[simulatedObjectKey]int),
+	}
+
+	for _, registration := range registered {
+		gvk, err := apiutil.GVKForObject(registration.object, scheme)
+		if err != nil {
+			return nil, err
+		}
+
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		simulator.kinds[gvr] = gvk
+		simulator.transitions[gvr] = append(simulator.transitions[gvr], registration.transitions...)
+	}
+
+	return simulator, nil
+}
+
+// reactor returns a reactor for the fake typed and dynamic clients that simulates reads before they are served.
+func (simulator *statusSimulator) reactor() k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "" {
+			return false, nil, nil
+		}
+
+		if getAction, ok := action.(k8stesting.GetAction); ok && action.GetVerb() == "get" {
+			simulator.simulateGet(getAction.GetResource(), getAction.GetNamespace(), getAction.GetName())
+		}
+
+		if action.GetVerb() == "list" {
+			simulator.simulateList(action.GetResource(), action.GetNamespace())
+		}
+
+		return false, nil, nil
+	}
+}
+
+// interceptorFuncs returns funcs which simulate reads before they are served by the controller-runtime client, then
+// call the corresponding funcs, if any.
+func (simulator *statusSimulator) interceptorFuncs(
+	scheme *runtime.Scheme, funcs interceptor.Funcs) interceptor.Funcs {
+	get := funcs.Get
+	list := funcs.List
+	watchFunc := funcs.Watch
+
+	funcs.Get = func(ctx context.Context, client runtimeClient.WithWatch, key runtimeClient.ObjectKey,
+		object runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+		if gvk, err := apiutil.GVKForObject(object, scheme); err == nil {
+			gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+			simulator.simulateGet(gvr, key.Namespace, key.Name)
+		}
+
+		if get != nil {
+			return get(ctx, client, key, object, opts...)
+		}
+
+		return client.Get(ctx, key, object, opts...)
+	}
+
+	funcs.List = func(ctx context.Context, client runtimeClient.WithWatch, objectList runtimeClient.ObjectList,
+		opts ...runtimeClient.ListOption) error {
+		if gvk, err := apiutil.GVKForObject(objectList, scheme); err == nil {
+			gvr, _ := meta.UnsafeGuessKindToResource(gvk.GroupVersion().WithKind(strings.TrimSuffix(gvk.Kind, "List")))
+			simulator.simulateList(gvr, (&runtimeClient.ListOptions{}).ApplyOptions(opts).Namespace)
+		}
+
+		if list != nil {
+			return list(ctx, client, objectList, opts...)
+		}
+
+		return client.List(ctx, objectList, opts...)
+	}
+
+	funcs.Watch = func(ctx context.Context, client runtimeClient.WithWatch, objectList runtimeClient.ObjectList,
+		opts ...runtimeClient.ListOption) (watch.Interface, error) {
+		var (
+			watcher watch.Interface
+			err     error
+		)
+
+		if watchFunc != nil {
+			watcher, err = watchFunc(ctx, client, objectList, opts...)
+		} else {
+			watcher, err = client.Watch(ctx, objectList, opts...)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		gvk, err := apiutil.GVKForObject(objectList, scheme)
+		if err != nil {
+			return watcher, nil
+		}
+
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk.GroupVersion().WithKind(strings.TrimSuffix(gvk.Kind, "List")))
+		if len(simulator.transitions[gvr]) == 0 {
+			return watcher, nil
+		}
+
+		return simulator.simulateWatch(ctx, gvr, (&runtimeClient.ListOptions{}).ApplyOptions(opts), watcher), nil
+	}
+
+	return funcs
+}
+
+// simulateWatch reads the objects covered by the watch every simulatedWatchInterval until the watch is stopped or the
+// context is done. Since the watch is served from the same tracker, the updates made by the transitions are sent on it.
+func (simulator *statusSimulator) simulateWatch(ctx context.Context, gvr schema.GroupVersionResource,
+	options *runtimeClient.ListOptions, watcher watch.Interface) watch.Interface {
+	name := ""
+
+	if options.FieldSelector != nil {
+		name, _ = options.FieldSelector.RequiresExactMatch("metadata.name")
+	}
+
+	simulatedWatcher := &simulatedWatch{Interface: watcher, stopped: make(chan struct{})}
+
+	go func() {
+		ticker := time.NewTicker(simulatedWatchInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-simulatedWatcher.stopped:
+				return
+			case <-ticker.C:
+				if name != "" {
+					simulator.simulateGet(gvr, options.Namespace, name)
+				} else {
+					simulator.simulateList(gvr, options.Namespace)
+				}
+			}
+		}
+	}()
+
+	return simulatedWatcher
+}
+
+// simulatedWatch is a watch whose covered objects are being read by the statusSimulator. Stopping it also stops the
+// reads.
+type simulatedWatch struct {
+	watch.Interface
+	once    sync.Once
+	stopped chan struct{}
+}
+
+// Stop implements the watch.Interface interface.
+func (watcher *simulatedWatch) Stop() {
+	watcher.once.Do(func() { close(watcher.stopped) })
+	watcher.Interface.Stop()
+}
+
+// simulateGet counts a read of the object and applies the transitions registered for its resource.
+func (simulator *statusSimulator) simulateGet(gvr schema.GroupVersionResource, nsname, name string) {
+	if len(simulator.transitions[gvr]) == 0 {
+		return
+	}
+
+	simulator.mutex.Lock()
+	defer simulator.mutex.Unlock()
+
+	object, err := simulator.tracker.Get(gvr, nsname, name)
+	if err != nil {
+		return
+	}
+
+	simulator.simulateRead(gvr, nsname, object)
+}
+
+// simulateList counts a read of each object in the list and applies the transitions registered for their resource.
+func (simulator *statusSimulator) simulateList(gvr schema.GroupVersionResource, nsname string) {
+	if len(simulator.transitions[gvr]) == 0 {
+		return
+	}
+
+	simulator.mutex.Lock()
+	defer simulator.mutex.Unlock()
+
+	list, err := simulator.tracker.List(gvr, simulator.kinds[gvr], nsname)
+	if err != nil {
+		return
+	}
+
+	objects, err := meta.ExtractList(list)
+	if err != nil {
+		return
+	}
+
+	for _, object := range objects {
+		accessor, err := meta.Accessor(object)
+		if err != nil {
+			continue
+		}
+
+		simulator.simulateRead(gvr, accessor.GetNamespace(), object)
+	}
+}
+
+// simulateRead counts a read of the object and applies the transitions to it, storing it if any changed it. The mutex
+// must be held.
+func (simulator *statusSimulator) simulateRead(gvr schema.GroupVersionResource, nsname string, object runtime.Object) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return
+	}
+
+	key := simulatedObjectKey{gvr: gvr, namespace: nsname, name: accessor.GetName()}
+	simulator.reads[key]++
+
+	changed := false
+
+	for _, transition := range simulator.transitions[gvr] {
+		changed = transition(object, simulator.reads[key]) || changed
+	}
+
+	if !changed {
+		return
+	}
+
+	// The controller-runtime fake client expects a numeric resourceVersion that changes on every update.
+	if resourceVersion, err := strconv.ParseUint(accessor.GetResourceVersion(), 10, 64); err == nil {
+		accessor.SetResourceVersion(strconv.FormatUint(resourceVersion+1, 10))
+	}
+
+	_ = simulator.tracker.Update(gvr, object, nsname, metav1.UpdateOptions{})
+}
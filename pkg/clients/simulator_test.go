@@ -0,0 +1,146 @@
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestStatusTransitionsGet(t *testing.T) {
+	testSettings, err := NewTestClientBuilder().
+		WithObjects(buildSimulatorTestPod()).
+		WithStatusTransitions(&corev1.Pod{},
+			AfterReads(2, func(pod *corev1.Pod) { pod.Status.Phase = corev1.PodRunning }),
+			AfterReads(3, func(pod *corev1.Pod) { pod.Status.Phase = corev1.PodSucceeded }),
+		).
+		Build()
+	require.NoError(t, err)
+
+	key := runtimeClient.ObjectKey{Name: testClientName, Namespace: testClientNamespace}
+
+	// Reads through the typed and controller-runtime clients share the same count.
+	expectedPhases := []corev1.PodPhase{corev1.PodPending, corev1.PodPending, corev1.PodRunning, corev1.PodSucceeded}
+	for index, expectedPhase := range expectedPhases {
+		pod := &corev1.Pod{}
+
+		if index%2 == 0 {
+			err = testSettings.Get(context.TODO(), key, pod)
+		} else {
+			pod, err = testSettings.Pods(testClientNamespace).Get(context.TODO(), testClientName, metav1.GetOptions{})
+		}
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedPhase, pod.Status.Phase, "read %d", index+1)
+	}
+
+	// The status stays the same once all transitions have happened.
+	pod := &corev1.Pod{}
+
+	err = testSettings.Get(context.TODO(), key, pod)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.PodSucceeded, pod.Status.Phase)
+}
+
+func TestStatusTransitionsList(t *testing.T) {
+	secondPod := buildSimulatorTestPod()
+	secondPod.Name = "second-pod"
+
+	testSettings, err := NewTestClientBuilder().
+		WithObjects(buildSimulatorTestPod(), secondPod).
+		WithStatusTransitions(&corev1.Pod{},
+			AfterReads(1, func(pod *corev1.Pod) { pod.Status.Phase = corev1.PodRunning })).
+		Build()
+	require.NoError(t, err)
+
+	for _, expectedPhase := range []corev1.PodPhase{corev1.PodPending, corev1.PodRunning} {
+		podList := &corev1.PodList{}
+
+		err = testSettings.List(context.TODO(), podList, runtimeClient.InNamespace(testClientNamespace))
+		require.NoError(t, err)
+		require.Len(t, podList.Items, 2)
+
+		for _, pod := range podList.Items {
+			assert.Equal(t, expectedPhase, pod.Status.Phase)
+		}
+	}
+}
+
+func TestStatusTransitionsWatch(t *testing.T) {
+	testSettings, err := NewTestClientBuilder().
+		WithObjects(buildSimulatorTestPod()).
+		WithStatusTransitions(&corev1.Pod{},
+			AfterReads(3, func(pod *corev1.Pod) { pod.Status.Phase = corev1.PodRunning })).
+		Build()
+	require.NoError(t, err)
+
+	watcher, err := testSettings.Watch(context.TODO(), &corev1.PodList{},
+		runtimeClient.InNamespace(testClientNamespace), runtimeClient.MatchingFields{"metadata.name": testClientName})
+	require.NoError(t, err)
+
+	defer watcher.Stop()
+
+	// No reads are made through the client, so the update can only come from the reads of the open watch.
+	select {
+	case event := <-watcher.ResultChan():
+		pod, ok := event.Object.(*corev1.Pod)
+		require.True(t, ok)
+		assert.Equal(t, corev1.PodRunning, pod.Status.Phase)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the simulated update on the watch")
+	}
+}
+
+func TestStatusTransitionsInterceptorFuncs(t *testing.T) {
+	intercepted := 0
+
+	testSettings, err := NewTestClientBuilder().
+		WithObjects(buildSimulatorTestPod()).
+		WithInterceptorFuncs(interceptor.Funcs{Get: func(
+			ctx context.Context, client runtimeClient.WithWatch, key runtimeClient.ObjectKey, object runtimeClient.Object,
+			opts ...runtimeClient.GetOption,
+		) error {
+			intercepted++
+
+			return client.Get(ctx, key, object, opts...)
+		}}).
+		WithStatusTransitions(&corev1.Pod{},
+			AfterReads(0, func(pod *corev1.Pod) { pod.Status.Phase = corev1.PodRunning })).
+		Build()
+	require.NoError(t, err)
+
+	pod := &corev1.Pod{}
+
+	err = testSettings.Get(context.TODO(), runtimeClient.ObjectKey{Name: testClientName, Namespace: testClientNamespace}, pod)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.PodRunning, pod.Status.Phase)
+	assert.Equal(t, 1, intercepted)
+}
+
+func TestStatusTransitionsUnregistered(t *testing.T) {
+	_, err := NewTestClientBuilder().
+		WithStatusTransitions(&unregisteredObject{}, AfterReads(0, func(*unregisteredObject) {})).
+		Build()
+	assert.ErrorContains(t, err, "failed to register test client status transitions")
+}
+
+// unregisteredObject is an object type that is not registered in any scheme.
+type unregisteredObject struct {
+	corev1.Pod
+}
+
+func buildSimulatorTestPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testClientName,
+			Namespace: testClientNamespace,
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+}
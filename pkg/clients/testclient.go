@@ -0,0 +1,256 @@
+package clients
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	k8sFakeClient "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	fakeRuntimeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// TestClientBuilder builds a fake Settings for unit tests. Unlike GetTestClients, it accepts objects of any type
+// registered in the scheme, which includes the types added by its SchemeAttachers, and returns an error for objects of
+// unregistered types rather than dropping them. The typed, dynamic, and controller-runtime clients of the Settings
+// share a single object tracker, so an object created through one of them is visible through all of them.
+type TestClientBuilder struct {
+	objects           []runtime.Object
+	schemeAttachers   []SchemeAttacher
+	interceptorFuncs  interceptor.Funcs
+	statusTransitions []statusTransitions
+}
+
+// NewTestClientBuilder returns a new TestClientBuilder with no objects.
+func NewTestClientBuilder() *TestClientBuilder {
+	return &TestClientBuilder{}
+}
+
+// WithObjects adds objects that exist when the Settings is built. Their types must be registered in the scheme.
+func (builder *TestClientBuilder) WithObjects(objects ...runtime.Object) *TestClientBuilder {
+	builder.objects = append(builder.objects, objects...)
+
+	return builder
+}
+
+// WithSchemeAttachers adds SchemeAttachers that register the types of custom resources used in the test.
+func (builder *TestClientBuilder) WithSchemeAttachers(attachers ...SchemeAttacher) *TestClientBuilder {
+	builder.schemeAttachers = append(builder.schemeAttachers, attachers...)
+
+	return builder
+}
+
+// WithInterceptorFuncs sets the functions used to intercept calls to the controller-runtime client.
+func (builder *TestClientBuilder) WithInterceptorFuncs(funcs interceptor.Funcs) *TestClientBuilder {
+	builder.interceptorFuncs = funcs
+
+	return builder
+}
+
+// Build returns the fake Settings. The status subresource is enabled for every type registered in the scheme that has
+// a Status field, so updates only change the status through the status client, as they would on a real cluster.
+func (builder *TestClientBuilder) Build() (*Settings, error) {
+	clientSet := &Settings{scheme: runtime.NewScheme()}
+
+	err := SetScheme(clientSet.scheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load test client scheme: %w", err)
+	}
+
+	for _, attacher := range builder.schemeAttachers {
+		err = clientSet.AttachScheme(attacher)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach scheme to test client: %w", err)
+		}
+	}
+
+	for _, object := range builder.objects {
+		if _, err := apiutil.GVKForObject(object, clientSet.scheme); err != nil {
+			return nil, fmt.Errorf("test client object of type %T is not registered in the scheme: %w", object, err)
+		}
+	}
+
+	tracker := &typedTracker{
+		ObjectTracker: k8stesting.NewObjectTracker(
+			clientSet.scheme, serializer.NewCodecFactory(clientSet.scheme).UniversalDecoder()),
+		scheme: clientSet.scheme,
+	}
+
+	interceptorFuncs := builder.interceptorFuncs
+	k8sClient := k8sFakeClient.NewSimpleClientset()
+	dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(clientSet.scheme, nil)
+
+	useTracker(&k8sClient.Fake, tracker)
+	useTracker(&dynamicClient.Fake, tracker)
+
+	if len(builder.statusTransitions) > 0 {
+		simulator, err := newStatusSimulator(tracker, clientSet.scheme, builder.statusTransitions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register test client status transitions: %w", err)
+		}
+
+		interceptorFuncs = simulator.interceptorFuncs(clientSet.scheme, interceptorFuncs)
+
+		k8sClient.PrependReactor("*", "*", simulator.reactor())
+		dynamicClient.PrependReactor("*", "*", simulator.reactor())
+	}
+
+	clientSet.Client = fakeRuntimeClient.NewClientBuilder().
+		WithScheme(clientSet.scheme).
+		WithObjectTracker(tracker).
+		WithRuntimeObjects(builder.objects...).
+		WithStatusSubresource(objectsWithStatus(clientSet.scheme)...).
+		WithInterceptorFuncs(interceptorFuncs).
+		Build()
+
+	clientSet.K8sClient = k8sClient
+	clientSet.CoreV1Interface = k8sClient.CoreV1()
+	clientSet.AppsV1Interface = k8sClient.AppsV1()
+	clientSet.NetworkingV1Interface = k8sClient.NetworkingV1()
+	clientSet.RbacV1Interface = k8sClient.RbacV1()
+	clientSet.StorageV1Interface = k8sClient.StorageV1()
+	clientSet.PolicyV1Interface = k8sClient.PolicyV1()
+	clientSet.Interface = dynamicClient
+
+	return clientSet, nil
+}
+
+// useTracker replaces the reactors of the fake so that all requests are served from the tracker.
+func useTracker(fake *k8stesting.Fake, tracker k8stesting.ObjectTracker) {
+	fake.ReactionChain = nil
+	fake.WatchReactionChain = nil
+
+	fake.AddReactor("*", "*", k8stesting.ObjectReaction(tracker))
+	fake.AddWatchReactor("*", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		var options []metav1.ListOptions
+		if watchAction, ok := action.(k8stesting.WatchActionImpl); ok {
+			options = append(options, watchAction.ListOptions)
+		}
+
+		watcher, err := tracker.Watch(action.GetResource(), action.GetNamespace(), options...)
+		if err != nil {
+			return false, nil, err
+		}
+
+		return true, watcher, nil
+	})
+}
+
+// objectsWithStatus returns an empty object for every non-list type registered in the scheme that has a Status field.
+func objectsWithStatus(scheme *runtime.Scheme) []runtimeClient.Object {
+	var objects []runtimeClient.Object
+
+	for gvk, objectType := range scheme.AllKnownTypes() {
+		if gvk.Version == runtime.APIVersionInternal || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+
+		if _, hasStatus := objectType.FieldByName("Status"); !hasStatus {
+			continue
+		}
+
+		object, ok := reflect.New(objectType).Interface().(runtimeClient.Object)
+		if !ok {
+			continue
+		}
+
+		object.GetObjectKind().SetGroupVersionKind(gvk)
+
+		// Types registered as unversioned or ambiguously cannot be passed to the fake client.
+		if _, err := apiutil.GVKForObject(object, scheme); err != nil {
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects
+}
+
+// typedTracker is an ObjectTracker that converts unstructured objects to their typed form when their type is
+// registered in the scheme. This lets the dynamic client share a tracker with the typed and controller-runtime
+// clients, which expect typed objects.
+type typedTracker struct {
+	k8stesting.ObjectTracker
+	scheme *runtime.Scheme
+}
+
+// Add converts the object to its typed form and adds it to the tracker.
+func (tracker *typedTracker) Add(object runtime.Object) error {
+	typedObject, err := tracker.toTyped(object)
+	if err != nil {
+		return err
+	}
+
+	return tracker.ObjectTracker.Add(typedObject)
+}
+
+// Create converts the object to its typed form and creates it in the tracker.
+func (tracker *typedTracker) Create(
+	gvr schema.GroupVersionResource, object runtime.Object, nsname string, opts ...metav1.CreateOptions) error {
+	typedObject, err := tracker.toTyped(object)
+	if err != nil {
+		return err
+	}
+
+	return tracker.ObjectTracker.Create(gvr, typedObject, nsname, opts...)
+}
+
+// Update converts the object to its typed form and updates it in the tracker.
+func (tracker *typedTracker) Update(
+	gvr schema.GroupVersionResource, object runtime.Object, nsname string, opts ...metav1.UpdateOptions) error {
+	typedObject, err := tracker.toTyped(object)
+	if err != nil {
+		return err
+	}
+
+	return tracker.ObjectTracker.Update(gvr, typedObject, nsname, opts...)
+}
+
+// Patch converts the object to its typed form and patches it in the tracker.
+func (tracker *typedTracker) Patch(
+	gvr schema.GroupVersionResource, object runtime.Object, nsname string, opts ...metav1.PatchOptions) error {
+	typedObject, err := tracker.toTyped(object)
+	if err != nil {
+		return err
+	}
+
+	return tracker.ObjectTracker.Patch(gvr, typedObject, nsname, opts...)
+}
+
+// toTyped returns the typed form of the object if it is unstructured and its type is registered in the scheme.
+// Otherwise, it returns the object unchanged.
+func (tracker *typedTracker) toTyped(object runtime.Object) (runtime.Object, error) {
+	unstructuredObject, ok := object.(runtime.Unstructured)
+	if !ok {
+		return object, nil
+	}
+
+	gvk := object.GetObjectKind().GroupVersionKind()
+	if !tracker.scheme.Recognizes(gvk) {
+		return object, nil
+	}
+
+	typedObject, err := tracker.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObject.UnstructuredContent(), typedObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert unstructured %s to typed object: %w", gvk, err)
+	}
+
+	typedObject.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return typedObject, nil
+}
@@ -0,0 +1,150 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	hivev1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/hive/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testClientName      = "test-client-name"
+	testClientNamespace = "test-client-namespace"
+)
+
+var clusterDeploymentGVR = schema.GroupVersionResource{
+	Group: "hive.openshift.io", Version: "v1", Resource: "clusterdeployments",
+}
+
+func TestTestClientBuilderUnregisteredObject(t *testing.T) {
+	_, err := NewTestClientBuilder().WithObjects(buildTestClusterDeployment()).Build()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "test client object of type *v1.ClusterDeployment is not registered in the scheme")
+
+	testSettings, err := NewTestClientBuilder().
+		WithObjects(buildTestClusterDeployment()).
+		WithSchemeAttachers(hivev1.AddToScheme).
+		Build()
+	require.NoError(t, err)
+
+	clusterDeployment := &hivev1.ClusterDeployment{}
+	err = testSettings.Get(context.TODO(), runtimeClient.ObjectKey{
+		Name: testClientName, Namespace: testClientNamespace}, clusterDeployment)
+	assert.NoError(t, err)
+
+	unstructuredClusterDeployment, err := testSettings.Resource(clusterDeploymentGVR).
+		Namespace(testClientNamespace).Get(context.TODO(), testClientName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "ClusterDeployment", unstructuredClusterDeployment.GetKind())
+}
+
+func TestTestClientBuilderSharedTracker(t *testing.T) {
+	testSettings, err := NewTestClientBuilder().
+		WithObjects(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: testClientName, Namespace: testClientNamespace}}).
+		Build()
+	require.NoError(t, err)
+
+	// Objects passed to the builder are visible through all of the clients.
+	_, err = testSettings.Pods(testClientNamespace).Get(context.TODO(), testClientName, metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	err = testSettings.Get(context.TODO(), runtimeClient.ObjectKey{
+		Name: testClientName, Namespace: testClientNamespace}, &corev1.Pod{})
+	assert.NoError(t, err)
+
+	// Objects created through the typed client are visible through the dynamic and controller-runtime clients.
+	_, err = testSettings.Secrets(testClientNamespace).Create(context.TODO(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testClientName, Namespace: testClientNamespace},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	secrets, err := testSettings.Resource(corev1.SchemeGroupVersion.WithResource("secrets")).
+		Namespace(testClientNamespace).List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, secrets.Items, 1)
+
+	err = testSettings.Get(context.TODO(), runtimeClient.ObjectKey{
+		Name: testClientName, Namespace: testClientNamespace}, &corev1.Secret{})
+	assert.NoError(t, err)
+
+	// Objects created through the dynamic client are stored in their typed form.
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName(testClientName)
+	configMap.SetNamespace(testClientNamespace)
+	configMap.Object["data"] = map[string]any{"key": "value"}
+
+	_, err = testSettings.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).
+		Namespace(testClientNamespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	typedConfigMap, err := testSettings.ConfigMaps(testClientNamespace).Get(
+		context.TODO(), testClientName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, typedConfigMap.Data)
+}
+
+func TestTestClientBuilderStatusSubresource(t *testing.T) {
+	testSettings, err := NewTestClientBuilder().
+		WithObjects(buildTestClusterDeployment()).
+		WithSchemeAttachers(hivev1.AddToScheme).
+		Build()
+	require.NoError(t, err)
+
+	key := runtimeClient.ObjectKey{Name: testClientName, Namespace: testClientNamespace}
+	clusterDeployment := &hivev1.ClusterDeployment{}
+
+	err = testSettings.Get(context.TODO(), key, clusterDeployment)
+	require.NoError(t, err)
+
+	clusterDeployment.Spec.ClusterName = "updated"
+	clusterDeployment.Status.InstallRestarts = 1
+
+	err = testSettings.Update(context.TODO(), clusterDeployment)
+	require.NoError(t, err)
+
+	err = testSettings.Get(context.TODO(), key, clusterDeployment)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", clusterDeployment.Spec.ClusterName)
+	assert.Equal(t, 0, clusterDeployment.Status.InstallRestarts, "update should not change status")
+
+	clusterDeployment.Status.InstallRestarts = 1
+
+	err = testSettings.Status().Update(context.TODO(), clusterDeployment)
+	require.NoError(t, err)
+
+	err = testSettings.Get(context.TODO(), key, clusterDeployment)
+	require.NoError(t, err)
+	assert.Equal(t, 1, clusterDeployment.Status.InstallRestarts)
+}
+
+func TestObjectsWithStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, hivev1.AddToScheme(scheme))
+
+	kinds := map[string]bool{}
+	for _, object := range objectsWithStatus(scheme) {
+		kinds[object.GetObjectKind().GroupVersionKind().Kind] = true
+	}
+
+	assert.True(t, kinds["ClusterDeployment"])
+	assert.False(t, kinds["ClusterDeploymentList"])
+}
+
+func buildTestClusterDeployment() *hivev1.ClusterDeployment {
+	return &hivev1.ClusterDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testClientName,
+			Namespace: testClientNamespace,
+		},
+	}
+}
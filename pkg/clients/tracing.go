@@ -0,0 +1,12 @@
+package clients
+
+import "go.opentelemetry.io/otel/trace"
+
+// GetTracerProvider returns the TracerProvider of the Settings, which may be nil.
+func (settings *Settings) GetTracerProvider() trace.TracerProvider {
+	if settings == nil {
+		return nil
+	}
+
+	return settings.TracerProvider
+}
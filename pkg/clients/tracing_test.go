@@ -0,0 +1,23 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestGetTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	var nilSettings *Settings
+
+	assert.Nil(t, nilSettings.GetTracerProvider())
+	assert.Nil(t, GetTestClients(TestClientParams{}).GetTracerProvider())
+
+	provider := sdktrace.NewTracerProvider()
+	testSettings := GetTestClients(TestClientParams{})
+	testSettings.TracerProvider = provider
+
+	assert.Equal(t, provider, testSettings.GetTracerProvider())
+}
@@ -0,0 +1,326 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// trackerPollInterval is how often Cleanup checks whether deleted resources are gone.
+const trackerPollInterval = time.Second
+
+// TrackedResource is a resource created through a tracked Settings.
+type TrackedResource struct {
+	Resource  schema.GroupVersionResource
+	Namespace string
+	Name      string
+}
+
+// String returns a short, human-readable description of the resource.
+func (resource TrackedResource) String() string {
+	if resource.Namespace == "" {
+		return fmt.Sprintf("%s %s", resource.Resource.Resource, resource.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", resource.Resource.Resource, resource.Namespace, resource.Name)
+}
+
+// ResourceTracker records the resources created through a tracked Settings so they may be deleted by Cleanup. It is
+// safe for concurrent use.
+type ResourceTracker struct {
+	mutex         sync.Mutex
+	resources     []TrackedResource
+	dynamicClient dynamic.Interface
+}
+
+// NewTracked returns a copy of the Settings where every resource successfully created, through any of the clients it
+// contains, is recorded in the returned ResourceTracker. Since creates are intercepted at the transport level, this
+// covers all builders regardless of which client they use. The Settings must have been created from a rest config,
+// such as through New. Server-side apply patches which create the resource are tracked as creates. Subresource
+// creates, dry-run creates, and creates of virtual resources that return no name, such as reviews, are not tracked.
+func (settings *Settings) NewTracked() (*Settings, *ResourceTracker, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, nil, fmt.Errorf("cannot create tracked client from nil client")
+	}
+
+	if settings.Config == nil {
+		klog.V(100).Info("The APIClient has no rest config")
+
+		return nil, nil, fmt.Errorf("cannot create tracked client without rest config")
+	}
+
+	klog.V(100).Info("Creating tracked client")
+
+	dynamicClient, err := dynamic.NewForConfig(settings.Config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create dynamic client for tracker: %w", err)
+	}
+
+	tracker := &ResourceTracker{dynamicClient: dynamicClient}
+	config := rest.CopyConfig(settings.Config)
+	config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+		return &trackingRoundTripper{next: roundTripper, tracker: tracker}
+	})
+
+	trackedSettings, err := newSettingsFromConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trackedSettings.KubeconfigPath = settings.KubeconfigPath
+	trackedSettings.RetryPolicy = settings.RetryPolicy
+	trackedSettings.Logger = settings.Logger
+	trackedSettings.TracerProvider = settings.TracerProvider
+	trackedSettings.Metrics = settings.Metrics
+
+	return trackedSettings, tracker, nil
+}
+
+// Resources returns the resources currently tracked, in the order they were created.
+func (tracker *ResourceTracker) Resources() []TrackedResource {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	return slices.Clone(tracker.resources)
+}
+
+// Cleanup deletes all of the tracked resources and waits for them to be removed, including waiting for any
+// finalizers. Resources are deleted in reverse creation order in three stages: first all other resources, then
+// CustomResourceDefinitions, and finally Namespaces. Every resource in a stage is deleted before any are waited on, and
+// the stage waits for all of them to be gone before the next starts, so custom resources are removed before their
+// definitions and namespaced resources before their namespaces.
+//
+// Cleanup continues past failures and returns all of them joined together. Resources which were removed are no longer
+// tracked, while those which failed remain tracked so Cleanup may be retried. The context bounds how long Cleanup waits.
+func (tracker *ResourceTracker) Cleanup(ctx context.Context) error {
+	if tracker == nil {
+		return fmt.Errorf("cannot cleanup using nil tracker")
+	}
+
+	resources := tracker.Resources()
+	slices.Reverse(resources)
+
+	klog.V(100).Infof("Cleaning up %d tracked resources", len(resources))
+
+	var errs []error
+
+	for _, stage := range groupTrackedResourcesByStage(resources) {
+		var deleted []TrackedResource
+
+		for _, resource := range stage {
+			err := tracker.delete(ctx, resource)
+			if err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			deleted = append(deleted, resource)
+		}
+
+		errs = append(errs, tracker.waitForDeletion(ctx, deleted)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// delete deletes the resource without waiting for it to be removed. Resources that are already gone are not considered
+// an error.
+func (tracker *ResourceTracker) delete(ctx context.Context, resource TrackedResource) error {
+	klog.V(100).Infof("Deleting tracked resource %s", resource.String())
+
+	err := tracker.resourceClient(resource).Delete(ctx, resource.Name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s: %w", resource.String(), err)
+	}
+
+	return nil
+}
+
+// waitForDeletion waits until none of the resources exist, untracking each one as soon as it is gone. If the context
+// is done first, an error is returned for each resource which still exists, so one resource stuck on a finalizer does
+// not prevent the others from being waited on.
+func (tracker *ResourceTracker) waitForDeletion(ctx context.Context, resources []TrackedResource) []error {
+	remaining := slices.Clone(resources)
+
+	err := wait.PollUntilContextCancel(ctx, trackerPollInterval, true, func(ctx context.Context) (bool, error) {
+		remaining = slices.DeleteFunc(remaining, func(resource TrackedResource) bool {
+			_, err := tracker.resourceClient(resource).Get(ctx, resource.Name, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				tracker.untrack(resource)
+
+				return true
+			}
+
+			if err != nil {
+				klog.V(100).Infof("Failed to get %s while waiting for deletion: %v", resource.String(), err)
+			}
+
+			return false
+		})
+
+		return len(remaining) == 0, nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	errs := make([]error, 0, len(remaining))
+
+	for _, resource := range remaining {
+		errs = append(errs, fmt.Errorf("failed waiting for %s to be deleted: %w", resource.String(), err))
+	}
+
+	return errs
+}
+
+// resourceClient returns the dynamic client for the resource.
+func (tracker *ResourceTracker) resourceClient(resource TrackedResource) dynamic.ResourceInterface {
+	return tracker.dynamicClient.Resource(resource.Resource).Namespace(resource.Namespace)
+}
+
+func (tracker *ResourceTracker) track(resource TrackedResource) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	if !slices.Contains(tracker.resources, resource) {
+		tracker.resources = append(tracker.resources, resource)
+	}
+}
+
+func (tracker *ResourceTracker) untrack(resource TrackedResource) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	tracker.resources = slices.DeleteFunc(tracker.resources, func(tracked TrackedResource) bool {
+		return tracked == resource
+	})
+}
+
+// groupTrackedResourcesByStage splits the resources into the stages used by Cleanup, preserving their order within
+// each stage.
+func groupTrackedResourcesByStage(resources []TrackedResource) [][]TrackedResource {
+	var others, definitions, namespaces []TrackedResource
+
+	for _, resource := range resources {
+		switch {
+		case resource.Resource.Group == "" && resource.Resource.Resource == "namespaces":
+			namespaces = append(namespaces, resource)
+		case resource.Resource.Group == "apiextensions.k8s.io" && resource.Resource.Resource == "customresourcedefinitions":
+			definitions = append(definitions, resource)
+		default:
+			others = append(others, resource)
+		}
+	}
+
+	return [][]TrackedResource{others, definitions, namespaces}
+}
+
+// trackingRoundTripper records the resources created by successful create requests, including server-side apply
+// patches which create the resource.
+type trackingRoundTripper struct {
+	next    http.RoundTripper
+	tracker *ResourceTracker
+}
+
+var _ http.RoundTripper = (*trackingRoundTripper)(nil)
+
+// RoundTrip implements the http.RoundTripper interface.
+func (roundTripper *trackingRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := roundTripper.next.RoundTrip(request)
+	if err != nil || !isCreateResponse(request, response) {
+		return response, err
+	}
+
+	operation, _ := newPlannedOperation(request)
+	if operation.Resource.Resource == "" || operation.Subresource != "" {
+		return response, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	object, err := decodeObjectMeta(body, response.Header.Get("Content-Type"))
+	if err != nil {
+		klog.V(100).Infof("Failed to decode created %s, it will not be tracked: %v", operation.Resource.Resource, err)
+
+		return response, nil
+	}
+
+	if object.GetName() == "" {
+		return response, nil
+	}
+
+	resource := TrackedResource{Resource: operation.Resource, Namespace: object.GetNamespace(), Name: object.GetName()}
+	roundTripper.tracker.track(resource)
+
+	klog.V(100).Infof("Tracking created resource %s", resource.String())
+
+	return response, nil
+}
+
+// isCreateResponse returns whether the response is for a request which created a resource: either a successful POST
+// or a PATCH answered with 201 Created, which is how the server responds to a server-side apply that creates the
+// resource. Dry-run requests create nothing.
+func isCreateResponse(request *http.Request, response *http.Response) bool {
+	if request.URL.Query().Has("dryRun") {
+		return false
+	}
+
+	switch request.Method {
+	case http.MethodPost:
+		return response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices
+	case http.MethodPatch:
+		return response.StatusCode == http.StatusCreated
+	default:
+		return false
+	}
+}
+
+// decodeObjectMeta decodes the metadata of an object from a response body. Protobuf is only supported for built-in
+// types, while JSON is supported for all types.
+func decodeObjectMeta(body []byte, contentType string) (metav1.Object, error) {
+	if strings.HasPrefix(contentType, runtime.ContentTypeProtobuf) {
+		object, _, err := scheme.Codecs.UniversalDeserializer().Decode(body, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return meta.Accessor(object)
+	}
+
+	object := &unstructured.Unstructured{}
+
+	err := object.UnmarshalJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
@@ -0,0 +1,205 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1apply "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+const trackerTestNamespace = "tracker-test-namespace"
+
+var trackerTestCRDResource = schema.GroupVersionResource{
+	Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+func TestResourceTrackerCleanup(t *testing.T) {
+	server, deletedPaths := newTrackerTestServer(t)
+
+	settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	trackedSettings, tracker, err := settings.NewTracked()
+	require.NoError(t, err)
+
+	_, err = trackedSettings.Namespaces().Create(context.TODO(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: trackerTestNamespace}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1")
+	crd.SetKind("CustomResourceDefinition")
+	crd.SetName("tests.example.com")
+
+	_, err = trackedSettings.Resource(trackerTestCRDResource).Create(context.TODO(), crd, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	for _, name := range []string{"first", "second"} {
+		_, err = trackedSettings.ConfigMaps(trackerTestNamespace).Create(context.TODO(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: trackerTestNamespace}}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	_, err = trackedSettings.ConfigMaps(trackerTestNamespace).Create(context.TODO(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "dry-run", Namespace: trackerTestNamespace}},
+		metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	require.NoError(t, err)
+
+	assert.Len(t, tracker.Resources(), 4)
+
+	err = tracker.Cleanup(context.TODO())
+	assert.NoError(t, err)
+	assert.Empty(t, tracker.Resources())
+	assert.Equal(t, []string{
+		"/api/v1/namespaces/tracker-test-namespace/configmaps/second",
+		"/api/v1/namespaces/tracker-test-namespace/configmaps/first",
+		"/apis/apiextensions.k8s.io/v1/customresourcedefinitions/tests.example.com",
+		"/api/v1/namespaces/tracker-test-namespace",
+	}, deletedPaths.get())
+}
+
+func TestResourceTrackerServerSideApply(t *testing.T) {
+	server, _ := newTrackerTestServer(t)
+
+	settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	trackedSettings, tracker, err := settings.NewTracked()
+	require.NoError(t, err)
+
+	// The test server answers apply patches for the created name with 201 Created and all others with 200 OK.
+	for _, name := range []string{"created", "updated"} {
+		_, err = trackedSettings.ConfigMaps(trackerTestNamespace).Apply(context.TODO(),
+			corev1apply.ConfigMap(name, trackerTestNamespace), metav1.ApplyOptions{FieldManager: "test"})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []TrackedResource{{
+		Resource:  corev1.SchemeGroupVersion.WithResource("configmaps"),
+		Namespace: trackerTestNamespace,
+		Name:      "created",
+	}}, tracker.Resources())
+}
+
+func TestResourceTrackerCleanupStuckResource(t *testing.T) {
+	server, deletedPaths := newTrackerTestServer(t, "stuck")
+
+	settings, err := newSettingsFromConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	trackedSettings, tracker, err := settings.NewTracked()
+	require.NoError(t, err)
+
+	for _, name := range []string{"removed", "stuck"} {
+		_, err = trackedSettings.ConfigMaps(trackerTestNamespace).Create(context.TODO(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: trackerTestNamespace}}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*trackerPollInterval)
+	defer cancel()
+
+	// The stuck resource is deleted first but never goes away, which must not stop the other from being deleted.
+	err = tracker.Cleanup(ctx)
+	assert.ErrorContains(t, err, "failed waiting for configmaps tracker-test-namespace/stuck to be deleted")
+	assert.NotContains(t, err.Error(), "removed")
+	assert.Equal(t, []string{
+		"/api/v1/namespaces/tracker-test-namespace/configmaps/stuck",
+		"/api/v1/namespaces/tracker-test-namespace/configmaps/removed",
+	}, deletedPaths.get())
+	assert.Equal(t, []TrackedResource{{
+		Resource:  corev1.SchemeGroupVersion.WithResource("configmaps"),
+		Namespace: trackerTestNamespace,
+		Name:      "stuck",
+	}}, tracker.Resources())
+}
+
+func TestSettingsNewTrackedErrors(t *testing.T) {
+	var nilSettings *Settings
+
+	_, _, err := nilSettings.NewTracked()
+	assert.EqualError(t, err, "cannot create tracked client from nil client")
+
+	_, _, err = (&Settings{}).NewTracked()
+	assert.EqualError(t, err, "cannot create tracked client without rest config")
+
+	var nilTracker *ResourceTracker
+
+	err = nilTracker.Cleanup(context.TODO())
+	assert.EqualError(t, err, "cannot cleanup using nil tracker")
+}
+
+// trackerTestPaths records the paths of the delete requests received by the test server.
+type trackerTestPaths struct {
+	mutex sync.Mutex
+	paths []string
+}
+
+func (paths *trackerTestPaths) get() []string {
+	paths.mutex.Lock()
+	defer paths.mutex.Unlock()
+
+	return paths.paths
+}
+
+// newTrackerTestServer returns a server which echoes the body of creates and apply patches, records deletes, and
+// responds to all gets with not found so resources appear deleted immediately. Apply patches are answered with 201
+// Created only for resources named created. Resources named in stuck are never deleted, so gets for them succeed.
+func newTrackerTestServer(t *testing.T, stuck ...string) (*httptest.Server, *trackerTestPaths) {
+	t.Helper()
+
+	deletedPaths := &trackerTestPaths{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		name := path.Base(request.URL.Path)
+
+		switch {
+		case request.Method == http.MethodPost:
+			body, _ := io.ReadAll(request.Body)
+
+			writer.Header().Set("Content-Type", request.Header.Get("Content-Type"))
+			writer.WriteHeader(http.StatusCreated)
+			_, _ = writer.Write(body)
+		case request.Method == http.MethodPatch:
+			body, _ := io.ReadAll(request.Body)
+
+			writer.Header().Set("Content-Type", "application/json")
+
+			if name == "created" {
+				writer.WriteHeader(http.StatusCreated)
+			}
+
+			_, _ = writer.Write(body)
+		case request.Method == http.MethodGet && slices.Contains(stuck, name):
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(writer, `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":%q}}`, name)
+		case request.Method == http.MethodDelete:
+			deletedPaths.mutex.Lock()
+			deletedPaths.paths = append(deletedPaths.paths, request.URL.Path)
+			deletedPaths.mutex.Unlock()
+
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+		default:
+			writer.Header().Set("Content-Type", "application/json")
+			writer.WriteHeader(http.StatusNotFound)
+			_, _ = writer.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`))
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, deletedPaths
+}
@@ -115,13 +115,25 @@ func (builder *Builder) IsAvailable() bool {
 
 	klog.V(100).Infof("Verify the availability of %s clusterOperator", builder.Definition.Name)
 
-	for _, condition := range builder.Object.Status.Conditions {
+	available, _ := isClusterOperatorAvailable(builder.Object)
+
+	return available
+}
+
+// isClusterOperatorAvailable returns true if the clusterOperator exists and its Available condition is true. It has
+// the signature of a common.ObjectCondition so it can be used when waiting.
+func isClusterOperatorAvailable(clusterOperator *configv1.ClusterOperator) (bool, error) {
+	if clusterOperator == nil {
+		return false, nil
+	}
+
+	for _, condition := range clusterOperator.Status.Conditions {
 		if condition.Type == "Available" {
-			return condition.Status == isTrue
+			return condition.Status == isTrue, nil
 		}
 	}
 
-	return false
+	return false, nil
 }
 
 // IsDegraded checks if the clusterOperator is degraded.
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -30,7 +32,7 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 
 	klog.V(100).Infof("%v", logMessage)
 
-	coList, err := apiClient.ClusterOperators().List(logging.DiscardContext(), passedOptions)
+	coList, err := clients.CollectPages(logging.DiscardContext(), passedOptions, apiClient.ClusterOperators().List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list clusterOperators due to %s", err.Error())
 
@@ -58,25 +60,14 @@ func WaitForAllClusteroperatorsAvailable(
 	apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
 	klog.V(100).Info("Waiting for all clusterOperators to be in available state")
 
-	err := wait.PollUntilContextTimeout(context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-		coList, err := List(apiClient, options...)
-		if err != nil {
-			klog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
-
-			return false, err
-		}
-
-		for _, clusteroperator := range coList {
-			if !clusteroperator.IsAvailable() {
-				klog.V(100).Infof("The %s clusterOperator is not available",
-					clusteroperator.Object.Name)
+	coList, err := List(apiClient, options...)
+	if err != nil {
+		klog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
 
-				return false, nil
-			}
-		}
+		return false, err
+	}
 
-		return true, nil
-	})
+	err = waitUntilAllAvailable(coList, timeout)
 	if err == nil {
 		klog.V(100).Infof("All clusterOperators were found available before timeout: %v",
 			timeout)
@@ -91,6 +82,25 @@ func WaitForAllClusteroperatorsAvailable(
 	return false, err
 }
 
+// waitUntilAllAvailable watches each clusterOperator in turn until it is available. The timeout applies to the whole
+// wait rather than to each clusterOperator.
+func waitUntilAllAvailable(coList []*Builder, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+	defer cancel()
+
+	for _, clusteroperator := range coList {
+		_, err := common.WaitUntilObject(ctx, clusteroperator.apiClient,
+			configv1.GroupVersion.WithKind(APIKind), clusteroperator.Definition, timeout, isClusterOperatorAvailable)
+		if err != nil {
+			klog.V(100).Infof("The %s clusterOperator is not available", clusteroperator.Definition.Name)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // WaitForAllClusteroperatorsStopProgressing waits until all clusterOperators stopped progressing.
 func WaitForAllClusteroperatorsStopProgressing(
 	apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
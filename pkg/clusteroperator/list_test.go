@@ -1,10 +1,18 @@
 package clusteroperator
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	configV1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestVerifyClusterOperatorsVersion(t *testing.T) {
@@ -53,3 +61,50 @@ func TestVerifyClusterOperatorsVersion(t *testing.T) {
 		assert.Equal(t, testCase.expectedError, err)
 	}
 }
+
+func TestWaitUntilAllAvailable(t *testing.T) {
+	testCases := []struct {
+		available     bool
+		expectedError error
+	}{
+		{
+			available:     true,
+			expectedError: nil,
+		},
+		{
+			available:     false,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		status := configV1.ConditionFalse
+		if testCase.available {
+			status = configV1.ConditionTrue
+		}
+
+		clusterOperator := &configV1.ClusterOperator{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultClusterOperatorName},
+			Status: configV1.ClusterOperatorStatus{
+				Conditions: []configV1.ClusterOperatorStatusCondition{{Type: configV1.OperatorAvailable, Status: status}},
+			},
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{clusterOperator},
+			GVK:            []schema.GroupVersionKind{clusterOperatorGVK},
+		})
+
+		coList := []*Builder{newBuilder(testSettings, defaultClusterOperatorName, configV1.ClusterOperatorStatus{})}
+
+		err := waitUntilAllAvailable(coList, time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+
+			continue
+		}
+
+		assert.True(t, goinfraerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+		assert.ErrorIs(t, err, testCase.expectedError)
+	}
+}
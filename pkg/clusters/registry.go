@@ -0,0 +1,334 @@
+package clusters
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/hive"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ocm"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// HubName is the name under which the hub client passed to NewRegistry is registered.
+	HubName = "hub"
+	// adminKubeconfigKey is the key of the kubeconfig in the admin kubeconfig secret created by hive.
+	adminKubeconfigKey = "kubeconfig"
+	// defaultRefreshInterval is how long Get returns a cached spoke client before checking the hub for changes to its
+	// admin kubeconfig again.
+	defaultRefreshInterval = 30 * time.Second
+)
+
+// Registry holds named clients for a hub cluster and its spokes. Spoke clients built from a ClusterDeployment or a
+// ManagedCluster on the hub are cached and rebuilt automatically when the spoke is reinstalled or its admin kubeconfig
+// changes. A Registry is safe for concurrent use and never makes API calls while holding its lock, so a slow hub does
+// not block lookups of other clients.
+type Registry struct {
+	mutex           sync.Mutex
+	hub             *clients.Settings
+	entries         map[string]*registryEntry
+	refreshInterval time.Duration
+}
+
+// registryEntry is a client held by the Registry. For spokes, source identifies the ClusterDeployment the client was
+// built from, fingerprint identifies the admin kubeconfig it was built with, and checkedAt is when the fingerprint was
+// last compared against the hub. All are empty for clients registered directly.
+type registryEntry struct {
+	client      *clients.Settings
+	source      *clusterDeploymentRef
+	fingerprint string
+	checkedAt   time.Time
+}
+
+// clusterDeploymentRef identifies the ClusterDeployment on the hub that a spoke client is built from.
+type clusterDeploymentRef struct {
+	name      string
+	namespace string
+}
+
+// NewRegistry returns a new Registry with the provided hub client registered as HubName. The hub client is used to
+// look up spokes.
+func NewRegistry(hub *clients.Settings) (*Registry, error) {
+	klog.V(100).Info("Creating new cluster registry")
+
+	if hub == nil {
+		klog.V(100).Info("The hub apiClient of the registry is nil")
+
+		return nil, fmt.Errorf("registry 'hub' cannot be nil")
+	}
+
+	return &Registry{
+		hub:             hub,
+		entries:         map[string]*registryEntry{HubName: {client: hub}},
+		refreshInterval: defaultRefreshInterval,
+	}, nil
+}
+
+// WithRefreshInterval sets how long Get returns a cached spoke client before looking up its admin kubeconfig on the hub
+// again. An interval of zero checks the hub on every call to Get. It defaults to 30 seconds.
+func (registry *Registry) WithRefreshInterval(interval time.Duration) *Registry {
+	klog.V(100).Infof("Setting registry refresh interval to %s", interval)
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.refreshInterval = max(interval, 0)
+
+	return registry
+}
+
+// Hub returns the hub client of the registry.
+func (registry *Registry) Hub() *clients.Settings {
+	return registry.hub
+}
+
+// Register adds the client to the registry under the provided name. Clients registered this way, such as ones created
+// from kubeconfig files, are never refreshed. It is an error to register a name that is already in use.
+func (registry *Registry) Register(name string, client *clients.Settings) error {
+	klog.V(100).Infof("Registering client %s", name)
+
+	if name == "" {
+		return fmt.Errorf("registry client 'name' cannot be empty")
+	}
+
+	if client == nil {
+		return fmt.Errorf("registry client %s cannot be nil", name)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if _, ok := registry.entries[name]; ok {
+		return fmt.Errorf("registry client %s is already registered", name)
+	}
+
+	registry.entries[name] = &registryEntry{client: client}
+
+	return nil
+}
+
+// RegisterClusterDeployment registers a spoke under the provided name whose client is built from the admin kubeconfig
+// secret of the ClusterDeployment with cdName in cdNamespace on the hub. The client is built immediately and returned.
+func (registry *Registry) RegisterClusterDeployment(name, cdName, cdNamespace string) (*clients.Settings, error) {
+	klog.V(100).Infof("Registering spoke %s from clusterdeployment %s in namespace %s", name, cdName, cdNamespace)
+
+	if name == "" {
+		return nil, fmt.Errorf("registry client 'name' cannot be empty")
+	}
+
+	return registry.registerSpoke(name, &clusterDeploymentRef{name: cdName, namespace: cdNamespace})
+}
+
+// RegisterManagedCluster registers a spoke under the provided name whose client is built from the ManagedCluster with
+// mcName on the hub. Only ManagedClusters installed by hive, such as through ZTP, are supported, and their
+// ClusterDeployment must be named mcName and live in the namespace mcName, which is the layout used by ZTP and the
+// assisted installer. ManagedClusters that were imported or whose ClusterDeployment lives elsewhere must be registered
+// using RegisterClusterDeployment or Register instead.
+func (registry *Registry) RegisterManagedCluster(name, mcName string) (*clients.Settings, error) {
+	klog.V(100).Infof("Registering spoke %s from managedcluster %s", name, mcName)
+
+	if name == "" {
+		return nil, fmt.Errorf("registry client 'name' cannot be empty")
+	}
+
+	_, err := ocm.PullManagedCluster(registry.hub, mcName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get managedcluster %s for spoke %s: %w", mcName, name, err)
+	}
+
+	return registry.registerSpoke(name, &clusterDeploymentRef{name: mcName, namespace: mcName})
+}
+
+// Get returns the client registered under the provided name. For spokes, once the refresh interval has passed since the
+// last check, the admin kubeconfig is looked up on the hub and the client is rebuilt if the spoke was reinstalled or
+// its kubeconfig changed since the client was built. See WithRefreshInterval and Refresh.
+func (registry *Registry) Get(name string) (*clients.Settings, error) {
+	return registry.get(name, false)
+}
+
+// Refresh is the same as Get but always looks up the admin kubeconfig of a spoke on the hub, regardless of when it was
+// last checked. It is meant to be called after an operation that is known to reinstall the spoke.
+func (registry *Registry) Refresh(name string) (*clients.Settings, error) {
+	klog.V(100).Infof("Refreshing client %s", name)
+
+	return registry.get(name, true)
+}
+
+// Remove removes the client registered under the provided name, if any. The hub cannot be removed.
+func (registry *Registry) Remove(name string) error {
+	klog.V(100).Infof("Removing client %s from registry", name)
+
+	if name == HubName {
+		return fmt.Errorf("registry client %s cannot be removed", HubName)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	delete(registry.entries, name)
+
+	return nil
+}
+
+// Names returns the sorted names of all registered clients, including the hub.
+func (registry *Registry) Names() []string {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	names := make([]string, 0, len(registry.entries))
+	for name := range registry.entries {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// get returns the client registered under the provided name, refreshing it if it is a spoke and either force is true
+// or the refresh interval has passed. The lock is only held to read and update the entry, never during API calls.
+func (registry *Registry) get(name string, force bool) (*clients.Settings, error) {
+	registry.mutex.Lock()
+
+	entry, ok := registry.entries[name]
+	if !ok {
+		registry.mutex.Unlock()
+
+		return nil, fmt.Errorf("registry client %s is not registered", name)
+	}
+
+	if entry.source == nil || (!force && time.Since(entry.checkedAt) < registry.refreshInterval) {
+		client := entry.client
+		registry.mutex.Unlock()
+
+		return client, nil
+	}
+
+	source, fingerprint := entry.source, entry.fingerprint
+	registry.mutex.Unlock()
+
+	kubeconfig, newFingerprint, err := registry.getAdminKubeconfig(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh spoke %s: %w", name, err)
+	}
+
+	var client *clients.Settings
+
+	if newFingerprint != fingerprint {
+		klog.V(100).Infof("Admin kubeconfig of spoke %s changed, rebuilding its client", name)
+
+		client, err = registry.buildSpokeClient(kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rebuild client for spoke %s: %w", name, err)
+		}
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// The entry may have been removed or replaced while the lock was released, in which case it must not be updated.
+	if registry.entries[name] != entry {
+		return nil, fmt.Errorf("registry client %s was removed while it was being refreshed", name)
+	}
+
+	// Another call may have already rebuilt the client from the same kubeconfig, in which case its client is kept.
+	if client != nil && entry.fingerprint != newFingerprint {
+		entry.client = client
+		entry.fingerprint = newFingerprint
+	}
+
+	entry.checkedAt = time.Now()
+
+	return entry.client, nil
+}
+
+// registerSpoke builds a client for the spoke from the ClusterDeployment referenced by source and registers it. The
+// name is checked both before and after building the client since the lock is not held during the API calls.
+func (registry *Registry) registerSpoke(name string, source *clusterDeploymentRef) (*clients.Settings, error) {
+	if registry.isRegistered(name) {
+		return nil, fmt.Errorf("registry client %s is already registered", name)
+	}
+
+	kubeconfig, fingerprint, err := registry.getAdminKubeconfig(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register spoke %s: %w", name, err)
+	}
+
+	client, err := registry.buildSpokeClient(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client for spoke %s: %w", name, err)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if _, ok := registry.entries[name]; ok {
+		return nil, fmt.Errorf("registry client %s is already registered", name)
+	}
+
+	registry.entries[name] = &registryEntry{
+		client: client, source: source, fingerprint: fingerprint, checkedAt: time.Now()}
+
+	return client, nil
+}
+
+// isRegistered returns whether a client is registered under the provided name.
+func (registry *Registry) isRegistered(name string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	_, ok := registry.entries[name]
+
+	return ok
+}
+
+// getAdminKubeconfig returns the admin kubeconfig of the ClusterDeployment referenced by source along with a
+// fingerprint that changes whenever the spoke is reinstalled or the kubeconfig secret is updated.
+func (registry *Registry) getAdminKubeconfig(source *clusterDeploymentRef) ([]byte, string, error) {
+	clusterDeployment, err := hive.PullClusterDeployment(registry.hub, source.name, source.namespace)
+	if err != nil {
+		return nil, "", err
+	}
+
+	metadata := clusterDeployment.Object.Spec.ClusterMetadata
+	if metadata == nil || metadata.AdminKubeconfigSecretRef.Name == "" {
+		return nil, "", fmt.Errorf("clusterdeployment %s in namespace %s has no admin kubeconfig secret",
+			source.name, source.namespace)
+	}
+
+	kubeconfigSecret, err := secret.Pull(registry.hub, metadata.AdminKubeconfigSecretRef.Name, source.namespace)
+	if err != nil {
+		return nil, "", err
+	}
+
+	kubeconfig, ok := kubeconfigSecret.Object.Data[adminKubeconfigKey]
+	if !ok {
+		return nil, "", fmt.Errorf("secret %s in namespace %s has no %s key",
+			kubeconfigSecret.Object.Name, source.namespace, adminKubeconfigKey)
+	}
+
+	fingerprint := fmt.Sprintf("%s/%s/%s",
+		clusterDeployment.Object.UID, kubeconfigSecret.Object.UID, kubeconfigSecret.Object.ResourceVersion)
+
+	return kubeconfig, fingerprint, nil
+}
+
+// buildSpokeClient builds a client from the kubeconfig that shares the retry policy, logger, tracer provider, and
+// metrics of the hub client.
+func (registry *Registry) buildSpokeClient(kubeconfig []byte) (*clients.Settings, error) {
+	client, err := clients.NewFromKubeconfigData(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client.RetryPolicy = registry.hub.RetryPolicy
+	client.Logger = registry.hub.Logger
+	client.TracerProvider = registry.hub.TracerProvider
+	client.Metrics = registry.hub.Metrics
+
+	return client, nil
+}
@@ -0,0 +1,360 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	hivev1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/hive/api/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ocm/clusterv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	defaultSpokeName       = "spoke1"
+	defaultKubeconfigName  = "spoke1-admin-kubeconfig"
+	defaultSpokeServerURL  = "https://api.spoke1.example.com:6443"
+	reinstalledServerURL   = "https://api.spoke1-reinstalled.example.com:6443"
+	defaultRegisteredName  = "first-spoke"
+	defaultClusterUID      = "clusterdeployment-uid"
+	reinstalledClusterUID  = "reinstalled-clusterdeployment-uid"
+	testKubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: spoke
+  cluster:
+    server: %s
+contexts:
+- name: admin
+  context:
+    cluster: spoke
+    user: admin
+current-context: admin
+users:
+- name: admin
+  user:
+    token: test-token
+`
+)
+
+var registryTestSchemes = []clients.SchemeAttacher{
+	hivev1.AddToScheme,
+	clusterv1.Install,
+}
+
+func TestNewRegistry(t *testing.T) {
+	testCases := []struct {
+		hub           *clients.Settings
+		expectedError string
+	}{
+		{
+			hub:           clients.GetTestClients(clients.TestClientParams{}),
+			expectedError: "",
+		},
+		{
+			hub:           nil,
+			expectedError: "registry 'hub' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		registry, err := NewRegistry(testCase.hub)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, registry)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.hub, registry.Hub())
+		assert.Equal(t, []string{HubName}, registry.Names())
+
+		client, err := registry.Get(HubName)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.hub, client)
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	testCases := []struct {
+		name          string
+		client        *clients.Settings
+		expectedError string
+	}{
+		{
+			name:          defaultRegisteredName,
+			client:        clients.GetTestClients(clients.TestClientParams{}),
+			expectedError: "",
+		},
+		{
+			name:          "",
+			client:        clients.GetTestClients(clients.TestClientParams{}),
+			expectedError: "registry client 'name' cannot be empty",
+		},
+		{
+			name:          defaultRegisteredName,
+			client:        nil,
+			expectedError: "registry client first-spoke cannot be nil",
+		},
+		{
+			name:          HubName,
+			client:        clients.GetTestClients(clients.TestClientParams{}),
+			expectedError: "registry client hub is already registered",
+		},
+	}
+
+	for _, testCase := range testCases {
+		registry, err := NewRegistry(clients.GetTestClients(clients.TestClientParams{}))
+		require.NoError(t, err)
+
+		err = registry.Register(testCase.name, testCase.client)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{defaultRegisteredName, HubName}, registry.Names())
+
+		client, err := registry.Get(testCase.name)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.client, client)
+
+		assert.NoError(t, registry.Remove(testCase.name))
+		assert.Equal(t, []string{HubName}, registry.Names())
+
+		_, err = registry.Get(testCase.name)
+		assert.EqualError(t, err, "registry client first-spoke is not registered")
+	}
+}
+
+func TestRegistryRemoveHub(t *testing.T) {
+	registry, err := NewRegistry(clients.GetTestClients(clients.TestClientParams{}))
+	require.NoError(t, err)
+
+	assert.EqualError(t, registry.Remove(HubName), "registry client hub cannot be removed")
+}
+
+func TestRegistryRegisterClusterDeployment(t *testing.T) {
+	testCases := []struct {
+		objects       []runtime.Object
+		expectedError string
+	}{
+		{
+			objects: []runtime.Object{
+				buildDummyClusterDeployment(defaultKubeconfigName),
+				buildDummyKubeconfigSecret(defaultSpokeServerURL),
+			},
+			expectedError: "",
+		},
+		{
+			objects: []runtime.Object{buildDummyKubeconfigSecret(defaultSpokeServerURL)},
+			expectedError: "failed to register spoke first-spoke: " +
+				"clusterdeployment object spoke1 does not exist in namespace spoke1",
+		},
+		{
+			objects: []runtime.Object{buildDummyClusterDeployment("")},
+			expectedError: "failed to register spoke first-spoke: " +
+				"clusterdeployment spoke1 in namespace spoke1 has no admin kubeconfig secret",
+		},
+		{
+			objects: []runtime.Object{buildDummyClusterDeployment(defaultKubeconfigName)},
+			expectedError: "failed to register spoke first-spoke: " +
+				"secret object spoke1-admin-kubeconfig does not exist in namespace spoke1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		hub := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  testCase.objects,
+			SchemeAttachers: registryTestSchemes,
+		})
+		hub.Metrics = clients.NewMetrics()
+
+		registry, err := NewRegistry(hub)
+		require.NoError(t, err)
+
+		client, err := registry.RegisterClusterDeployment(defaultRegisteredName, defaultSpokeName, defaultSpokeName)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, client)
+			assert.Equal(t, []string{HubName}, registry.Names())
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, defaultSpokeServerURL, client.Config.Host)
+		assert.Equal(t, hub.Metrics, client.Metrics)
+		assert.Equal(t, []string{defaultRegisteredName, HubName}, registry.Names())
+
+		cachedClient, err := registry.Get(defaultRegisteredName)
+		assert.NoError(t, err)
+		assert.Same(t, client, cachedClient)
+
+		_, err = registry.RegisterClusterDeployment(defaultRegisteredName, defaultSpokeName, defaultSpokeName)
+		assert.EqualError(t, err, "registry client first-spoke is already registered")
+	}
+}
+
+func TestRegistryRegisterManagedCluster(t *testing.T) {
+	testCases := []struct {
+		objects       []runtime.Object
+		expectedError string
+	}{
+		{
+			objects: []runtime.Object{
+				buildDummyManagedCluster(),
+				buildDummyClusterDeployment(defaultKubeconfigName),
+				buildDummyKubeconfigSecret(defaultSpokeServerURL),
+			},
+			expectedError: "",
+		},
+		{
+			objects: []runtime.Object{
+				buildDummyClusterDeployment(defaultKubeconfigName),
+				buildDummyKubeconfigSecret(defaultSpokeServerURL),
+			},
+			expectedError: "failed to get managedcluster spoke1 for spoke first-spoke: " +
+				"managedCluster object spoke1 does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		registry, err := NewRegistry(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  testCase.objects,
+			SchemeAttachers: registryTestSchemes,
+		}))
+		require.NoError(t, err)
+
+		client, err := registry.RegisterManagedCluster(defaultRegisteredName, defaultSpokeName)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, client)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, defaultSpokeServerURL, client.Config.Host)
+	}
+}
+
+func TestRegistryGetRefreshesReinstalledSpoke(t *testing.T) {
+	hub := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildDummyClusterDeployment(defaultKubeconfigName),
+			buildDummyKubeconfigSecret(defaultSpokeServerURL),
+		},
+		SchemeAttachers: registryTestSchemes,
+	})
+
+	registry, err := NewRegistry(hub)
+	require.NoError(t, err)
+
+	client, err := registry.RegisterClusterDeployment(defaultRegisteredName, defaultSpokeName, defaultSpokeName)
+	require.NoError(t, err)
+
+	// Reinstalling the spoke recreates the ClusterDeployment and its admin kubeconfig secret.
+	err = hub.Delete(context.TODO(), buildDummyClusterDeployment(defaultKubeconfigName))
+	require.NoError(t, err)
+
+	err = hub.Secrets(defaultSpokeName).Delete(context.TODO(), defaultKubeconfigName, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	reinstalledClusterDeployment := buildDummyClusterDeployment(defaultKubeconfigName)
+	reinstalledClusterDeployment.UID = reinstalledClusterUID
+
+	err = hub.Create(context.TODO(), reinstalledClusterDeployment)
+	require.NoError(t, err)
+
+	_, err = hub.Secrets(defaultSpokeName).Create(
+		context.TODO(), buildDummyKubeconfigSecret(reinstalledServerURL), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// Within the refresh interval, Get returns the cached client without looking at the hub.
+	cachedClient, err := registry.Get(defaultRegisteredName)
+	assert.NoError(t, err)
+	assert.Same(t, client, cachedClient)
+
+	refreshedClient, err := registry.Refresh(defaultRegisteredName)
+	assert.NoError(t, err)
+	assert.NotSame(t, client, refreshedClient)
+	assert.Equal(t, reinstalledServerURL, refreshedClient.Config.Host)
+
+	cachedClient, err = registry.Refresh(defaultRegisteredName)
+	assert.NoError(t, err)
+	assert.Same(t, refreshedClient, cachedClient)
+
+	err = hub.Delete(context.TODO(), reinstalledClusterDeployment)
+	require.NoError(t, err)
+
+	cachedClient, err = registry.Get(defaultRegisteredName)
+	assert.NoError(t, err)
+	assert.Same(t, refreshedClient, cachedClient)
+
+	// With a zero refresh interval, every Get checks the hub.
+	_, err = registry.WithRefreshInterval(0).Get(defaultRegisteredName)
+	assert.EqualError(t, err, "failed to refresh spoke first-spoke: "+
+		"clusterdeployment object spoke1 does not exist in namespace spoke1")
+}
+
+func TestRegistryRefresh(t *testing.T) {
+	registry, err := NewRegistry(clients.GetTestClients(clients.TestClientParams{}))
+	require.NoError(t, err)
+
+	client, err := registry.Refresh(HubName)
+	assert.NoError(t, err)
+	assert.Same(t, registry.Hub(), client)
+
+	_, err = registry.Refresh(defaultRegisteredName)
+	assert.EqualError(t, err, "registry client first-spoke is not registered")
+}
+
+func buildDummyClusterDeployment(kubeconfigSecretName string) *hivev1.ClusterDeployment {
+	return &hivev1.ClusterDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultSpokeName,
+			Namespace: defaultSpokeName,
+			UID:       defaultClusterUID,
+		},
+		Spec: hivev1.ClusterDeploymentSpec{
+			ClusterMetadata: &hivev1.ClusterMetadata{
+				AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: kubeconfigSecretName},
+			},
+		},
+	}
+}
+
+func buildDummyKubeconfigSecret(server string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultKubeconfigName,
+			Namespace: defaultSpokeName,
+			UID:       types.UID(server),
+		},
+		Data: map[string][]byte{
+			adminKubeconfigKey: fmt.Appendf(nil, testKubeconfigTemplate, server),
+		},
+	}
+}
+
+func buildDummyManagedCluster() *clusterv1.ManagedCluster {
+	return &clusterv1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultSpokeName,
+		},
+	}
+}
@@ -1,19 +1,27 @@
 package configmap
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	corev1Typed "k8s.io/client-go/kubernetes/typed/core/v1"
-	"k8s.io/klog/v2"
 )
 
+// resourceCRD is the kind of the resource, used in the errors returned by the builder.
+const resourceCRD = "ConfigMap"
+
 // Builder provides struct for configmap object containing connection to the cluster and the configmap definitions.
 type Builder struct {
 	// ConfigMap definition. Used to create configmap object.
@@ -24,6 +32,10 @@ type Builder struct {
 	// object is created.
 	errorMsg  string
 	apiClient corev1Typed.CoreV1Interface
+	// clientLogger is the logger of the clients.Settings the builder was created with. See getLogger.
+	clientLogger logr.Logger
+	// metrics are the metrics of the clients.Settings the builder was created with. See observe.
+	metrics *clients.Metrics
 }
 
 // AdditionalOptions additional options for configmap object.
@@ -31,8 +43,15 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // Pull retrieves an existing configmap object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the same as Pull but uses the provided context for the API call.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	builder := Builder{
-		apiClient: apiClient.CoreV1Interface,
+		apiClient:    apiClient.CoreV1Interface,
+		clientLogger: logging.FromClient(apiClient),
+		metrics:      apiClient.GetMetrics(),
 		Definition: &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -41,23 +60,24 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		},
 	}
 
+	logger := builder.getLogger(ctx)
+
 	if name == "" {
-		klog.V(100).Info("The name of the configmap is empty")
+		logger.V(logging.LevelDebug).Info("The name of the configmap is empty")
 
-		return nil, fmt.Errorf("configmap 'name' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "configmap 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the configmap is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the configmap is empty")
 
-		return nil, fmt.Errorf("configmap 'nsname' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "configmap 'nsname' cannot be empty")
 	}
 
-	klog.V(100).Infof(
-		"Pulling configmap object name:%s in namespace: %s", name, nsname)
+	logger.V(logging.LevelDebug).Info("Pulling configmap")
 
-	if !builder.Exists() {
-		return nil, fmt.Errorf("configmap object %s does not exist in namespace %s", name, nsname)
+	if !builder.ExistsWithContext(ctx) {
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -67,11 +87,10 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
-	klog.V(100).Infof(
-		"Initializing new configmap structure with the following params: %s, %s", name, nsname)
-
 	builder := &Builder{
-		apiClient: apiClient.CoreV1Interface,
+		apiClient:    apiClient.CoreV1Interface,
+		clientLogger: logging.FromClient(apiClient),
+		metrics:      apiClient.GetMetrics(),
 		Definition: &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -80,8 +99,12 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 		},
 	}
 
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Initializing new configmap structure")
+
 	if name == "" {
-		klog.V(100).Info("The name of the configmap is empty")
+		logger.V(logging.LevelDebug).Info("The name of the configmap is empty")
 
 		builder.errorMsg = "configmap 'name' cannot be empty"
 
@@ -89,7 +112,59 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the configmap is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the configmap is empty")
+
+		builder.errorMsg = "configmap 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// NewBuilderFromManifest creates a new instance of Builder whose definition is decoded from a single YAML or JSON
+// configmap manifest. Unknown fields and kinds other than ConfigMap are rejected.
+func NewBuilderFromManifest(apiClient *clients.Settings, manifest []byte) *Builder {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
+
+	logger.V(logging.LevelDebug).Info("Initializing new configmap structure from manifest")
+
+	if apiClient == nil {
+		logger.V(logging.LevelDebug).Info("The apiClient of the configmap is nil")
+
+		return nil
+	}
+
+	definition, err := common.DecodeManifest[corev1.ConfigMap](apiClient.Scheme(), manifest)
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to decode configmap manifest", "error", err)
+
+		return &Builder{
+			apiClient:    apiClient.CoreV1Interface,
+			clientLogger: logging.FromClient(apiClient),
+			metrics:      apiClient.GetMetrics(),
+			Definition:   &corev1.ConfigMap{},
+			errorMsg:     fmt.Sprintf("failed to decode configmap manifest: %v", err),
+		}
+	}
+
+	builder := &Builder{
+		apiClient:    apiClient.CoreV1Interface,
+		clientLogger: logging.FromClient(apiClient),
+		metrics:      apiClient.GetMetrics(),
+		Definition:   definition,
+	}
+
+	if builder.Definition.Name == "" {
+		logger.V(logging.LevelDebug).Info("The name of the configmap is empty")
+
+		builder.errorMsg = "configmap 'name' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Namespace == "" {
+		logger.V(logging.LevelDebug).Info("The namespace of the configmap is empty")
 
 		builder.errorMsg = "configmap 'nsname' cannot be empty"
 
@@ -101,16 +176,23 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Create makes a configmap in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the same as Create but uses the provided context for all API calls.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Creating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info("Creating the configmap")
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
+		start := time.Now()
 		builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Create(
-			logging.DiscardContext(), builder.Definition, metav1.CreateOptions{})
+			logging.DiscardContextFrom(ctx), builder.Definition, metav1.CreateOptions{})
+		builder.observe("create", start, err)
 	}
 
 	return builder, err
@@ -118,24 +200,32 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Delete removes a configmap.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the same as Delete but uses the provided context for all API calls.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Deleting the configmap %s from namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info("Deleting the configmap")
 
-	if !builder.Exists() {
-		klog.V(100).Infof("configmap %s in namespace %s does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("The configmap does not exist")
 
 		builder.Object = nil
 
 		return nil
 	}
 
+	start := time.Now()
 	err := builder.apiClient.ConfigMaps(builder.Definition.Namespace).Delete(
-		logging.DiscardContext(), builder.Object.Name, metav1.DeleteOptions{})
+		logging.DiscardContextFrom(ctx), builder.Object.Name, metav1.DeleteOptions{})
+	builder.observe("delete", start, err)
+
 	if err != nil {
 		return err
 	}
@@ -147,37 +237,51 @@ func (builder *Builder) Delete() error {
 
 // Exists checks whether the given configmap exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the same as Exists but uses the provided context for the API call.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	klog.V(100).Infof(
-		"Checking if configmap %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info("Checking if configmap exists")
 
-	var err error
+	start := time.Now()
+	object, err := builder.apiClient.ConfigMaps(builder.Definition.Namespace).Get(
+		logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
+	builder.observe("get", start, err)
 
-	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Get(
-		logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+	builder.Object = object
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Update renovates the existing configmap object with configmap definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the same as Update but uses the provided context for the API call.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Updating configmap %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info("Updating configmap")
 
 	var err error
 
+	start := time.Now()
 	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).
-		Update(logging.DiscardContext(), builder.Definition, metav1.UpdateOptions{})
+		Update(logging.DiscardContextFrom(ctx), builder.Definition, metav1.UpdateOptions{})
+	builder.observe("update", start, err)
+
 	if err != nil {
-		klog.V(100).Infof("%v", msg.FailToUpdateError("configmap", builder.Definition.Name, builder.Definition.Namespace))
+		logger.V(logging.LevelDebug).Info("Failed to update configmap", "error", err)
 
 		return nil, err
 	}
@@ -193,9 +297,7 @@ func (builder *Builder) WithData(data map[string]string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof(
-		"Creating configmap %s in namespace %s with this data: %s",
-		builder.Definition.Name, builder.Definition.Namespace, data)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Setting configmap data", "data", data)
 
 	if len(data) == 0 {
 		builder.errorMsg = "'data' cannot be empty"
@@ -214,13 +316,15 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	klog.V(100).Info("Setting configmap additional options")
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Setting configmap additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 			if err != nil {
-				klog.V(100).Info("Error occurred in mutation function")
+				logger.V(logging.LevelDebug).Info("Error occurred in mutation function", "error", err)
 
 				builder.errorMsg = err.Error()
 
@@ -239,34 +343,82 @@ func GetGVR() schema.GroupVersionResource {
 	}
 }
 
+// ToJSON returns the configmap definition as a JSON manifest with the apiVersion and kind set and the status and
+// server-populated metadata removed.
+func (builder *Builder) ToJSON() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Exporting configmap as JSON")
+
+	return common.ExportObjectJSON(builder.Definition, corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+}
+
+// ToYAML is the same as ToJSON but returns a YAML manifest.
+func (builder *Builder) ToYAML() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Exporting configmap as YAML")
+
+	return common.ExportObjectYAML(builder.Definition, corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "ConfigMap"
+	logger := builder.getLogger(context.TODO())
 
 	if builder == nil {
-		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder is uninitialized")
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		klog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder definition is undefined")
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder apiClient is nil")
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelDebug).Info("The builder has an error message", "errorMsg", builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
 }
+
+// getLogger returns the logger for the builder's operations, with the kind, name, and namespace of the configmap as
+// key/values. The logger from the context takes precedence over the logger of the client the builder was created
+// with, see logging.FromContextOr. It is safe to call on a nil or undefined builder.
+func (builder *Builder) getLogger(ctx context.Context) logr.Logger {
+	if builder == nil {
+		return logging.FromContextOr(ctx, logr.Logger{}).WithValues("kind", resourceCRD)
+	}
+
+	logger := logging.FromContextOr(ctx, builder.clientLogger).WithValues("kind", resourceCRD)
+
+	if builder.Definition != nil {
+		logger = logger.WithValues("name", builder.Definition.Name, "namespace", builder.Definition.Namespace)
+	}
+
+	return logger
+}
+
+// observe records an operation on the configmap in the metrics of the client the builder was created with. A builder
+// without metrics records nothing. The builder makes each API call once without a clients.RetryPolicy, so no retries
+// are ever recorded for it.
+func (builder *Builder) observe(operation string, start time.Time, err error) {
+	builder.metrics.ObserveOperation(
+		corev1.SchemeGroupVersion.WithKind(resourceCRD), operation, tracing.Outcome(err), time.Since(start))
+}
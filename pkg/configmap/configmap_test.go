@@ -1,10 +1,15 @@
 package configmap
 
 import (
+	"context"
 	"errors"
 	"testing"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -165,6 +170,107 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateLogging(t *testing.T) {
+	var entries []string
+
+	fakeClient := k8sfake.NewSimpleClientset()
+	testSettings := &clients.Settings{
+		CoreV1Interface: fakeClient.CoreV1(),
+		K8sClient:       fakeClient,
+		Logger: funcr.New(func(_, args string) {
+			entries = append(entries, args)
+		}, funcr.Options{Verbosity: logging.LevelOperation}),
+	}
+
+	_, err := NewBuilder(testSettings, "test-name", "test-namespace").Create()
+	assert.Nil(t, err)
+
+	// Only the create is logged at LevelOperation, the not found error from Exists is only logged at LevelDebug.
+	assert.Len(t, entries, 1)
+	assert.Contains(t, entries[0], `"msg"="Creating the configmap"`)
+	assert.Contains(t, entries[0], `"kind"="ConfigMap" "name"="test-name" "namespace"="test-namespace"`)
+}
+
+func TestWithContextLogging(t *testing.T) {
+	var contextEntries, settingsEntries []string
+
+	fakeClient := k8sfake.NewSimpleClientset()
+	testSettings := &clients.Settings{
+		CoreV1Interface: fakeClient.CoreV1(),
+		K8sClient:       fakeClient,
+		Logger: funcr.New(func(_, args string) {
+			settingsEntries = append(settingsEntries, args)
+		}, funcr.Options{Verbosity: logging.LevelOperation}),
+	}
+
+	ctx := logr.NewContext(context.TODO(), funcr.New(func(_, args string) {
+		contextEntries = append(contextEntries, args)
+	}, funcr.Options{Verbosity: logging.LevelOperation}))
+
+	testBuilder, err := NewBuilder(testSettings, "test-name", "test-namespace").CreateWithContext(ctx)
+	assert.Nil(t, err)
+
+	testBuilder, err = PullWithContext(ctx, testSettings, "test-name", "test-namespace")
+	assert.Nil(t, err)
+
+	_, err = testBuilder.WithData(map[string]string{"key": "value"}).UpdateWithContext(ctx)
+	assert.Nil(t, err)
+
+	err = testBuilder.DeleteWithContext(ctx)
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.ExistsWithContext(ctx))
+
+	// Every operation logs to the logger of the context rather than that of the settings.
+	assert.Empty(t, settingsEntries)
+	assert.Len(t, contextEntries, 3)
+	assert.Contains(t, contextEntries[0], `"msg"="Creating the configmap"`)
+	assert.Contains(t, contextEntries[1], `"msg"="Updating configmap"`)
+	assert.Contains(t, contextEntries[2], `"msg"="Deleting the configmap"`)
+}
+
+func TestMetrics(t *testing.T) {
+	fakeClient := k8sfake.NewSimpleClientset()
+	testSettings := &clients.Settings{
+		CoreV1Interface: fakeClient.CoreV1(),
+		K8sClient:       fakeClient,
+		Metrics:         clients.NewMetrics(),
+	}
+
+	testBuilder, err := NewBuilder(testSettings, "test-name", "test-namespace").Create()
+	assert.Nil(t, err)
+
+	testBuilder, err = Pull(testSettings, "test-name", "test-namespace")
+	assert.Nil(t, err)
+
+	_, err = testBuilder.Update()
+	assert.Nil(t, err)
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+
+	operations := make(map[string]clients.OperationSummary)
+	for _, summary := range testSettings.Metrics.Summary() {
+		operations[summary.Operation] = summary
+	}
+
+	// The get made by Create fails with not found, while those made by Pull and Delete succeed.
+	expectedOperations := map[string]struct{ count, failures int }{
+		"create": {count: 1},
+		"get":    {count: 3, failures: 1},
+		"update": {count: 1},
+		"delete": {count: 1},
+	}
+
+	assert.Len(t, operations, len(expectedOperations))
+
+	for operation, expected := range expectedOperations {
+		assert.Equal(t, corev1.SchemeGroupVersion.WithKind("ConfigMap"), operations[operation].GVK, operation)
+		assert.Equal(t, expected.count, operations[operation].Count, operation)
+		assert.Equal(t, expected.failures, operations[operation].Failures, operation)
+		assert.Equal(t, 0, operations[operation].Retries, operation)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	testCases := []struct {
 		addToRuntimeObjects bool
@@ -365,6 +471,35 @@ func TestWithData(t *testing.T) {
 	}
 }
 
+func TestToYAML(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedYAML  string
+		expectedError error
+	}{
+		{
+			testBuilder: buildTestBuilderWithFakeObjects(nil).WithData(map[string]string{"key": "value"}),
+			expectedYAML: "apiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n" +
+				"  name: test-name\n  namespace: test-namespace\n",
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildTestBuilderWithFakeObjects(nil).WithData(map[string]string{}),
+			expectedYAML:  "",
+			expectedError: goinfraerrors.NewInvalidBuilder("ConfigMap", "'data' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase.testBuilder.Definition.ResourceVersion = "1"
+		testCase.testBuilder.Definition.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "test"}}
+
+		manifest, err := testCase.testBuilder.ToYAML()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedYAML, string(manifest))
+	}
+}
+
 func buildTestBuilderWithFakeObjects(objects []runtime.Object) *Builder {
 	fakeClient := k8sfake.NewSimpleClientset(objects...)
 
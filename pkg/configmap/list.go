@@ -5,44 +5,44 @@ import (
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
-	"k8s.io/klog/v2"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // List returns configmap inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "namespace", nsname)
+
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient cannot be nil")
+		logger.V(logging.LevelDebug).Info("The apiClient cannot be nil")
 
 		return nil, fmt.Errorf("the apiClient cannot be nil")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("configmap 'nsname' parameter can not be empty")
+		logger.V(logging.LevelDebug).Info("configmap 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list configmaps, 'nsname' parameter is empty")
 	}
 
 	passedOptions := metav1.ListOptions{}
-	logMessage := fmt.Sprintf("Listing configmaps in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Infof("%v", logMessage)
+	logger.V(logging.LevelDebug).Info("Listing configmaps in the namespace", "options", passedOptions)
 
-	configmapList, err := apiClient.ConfigMaps(nsname).List(logging.DiscardContext(), passedOptions)
+	configmapList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.ConfigMaps(nsname).List)
 	if err != nil {
-		klog.V(100).Infof("Failed to list configmaps in the namespace %s due to %s", nsname, err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list configmaps in the namespace", "error", err)
 
 		return nil, err
 	}
@@ -52,9 +52,11 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 	for _, runningConfigmap := range configmapList.Items {
 		copiedConfigmap := runningConfigmap
 		configmapBuilder := &Builder{
-			apiClient:  apiClient.CoreV1Interface,
-			Object:     &copiedConfigmap,
-			Definition: &copiedConfigmap,
+			apiClient:    apiClient.CoreV1Interface,
+			clientLogger: logging.FromClient(apiClient),
+			metrics:      apiClient.GetMetrics(),
+			Object:       &copiedConfigmap,
+			Definition:   &copiedConfigmap,
 		}
 
 		configmapObjects = append(configmapObjects, configmapBuilder)
@@ -65,31 +67,31 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 // ListInAllNamespaces returns configmap inventory in the all the namespaces.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
+
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient cannot be nil")
+		logger.V(logging.LevelDebug).Info("The apiClient cannot be nil")
 
 		return nil, fmt.Errorf("the apiClient cannot be nil")
 	}
 
 	passedOptions := metav1.ListOptions{}
-	logMessage := "Listing configmaps in all namespaces"
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be either empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be either empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Infof("%v", logMessage)
+	logger.V(logging.LevelDebug).Info("Listing configmaps in all namespaces", "options", passedOptions)
 
-	configmapList, err := apiClient.ConfigMaps("").List(logging.DiscardContext(), passedOptions)
+	configmapList, err := clients.CollectPages(logging.DiscardContext(), passedOptions, apiClient.ConfigMaps("").List)
 	if err != nil {
-		klog.V(100).Infof("Failed to list configmaps in all namespaces due to %s", err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list configmaps in all namespaces", "error", err)
 
 		return nil, err
 	}
@@ -99,9 +101,11 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 	for _, runningConfigmap := range configmapList.Items {
 		copiedConfigmap := runningConfigmap
 		configmapBuilder := &Builder{
-			apiClient:  apiClient.CoreV1Interface,
-			Object:     &copiedConfigmap,
-			Definition: &copiedConfigmap,
+			apiClient:    apiClient.CoreV1Interface,
+			clientLogger: logging.FromClient(apiClient),
+			metrics:      apiClient.GetMetrics(),
+			Object:       &copiedConfigmap,
+			Definition:   &copiedConfigmap,
 		}
 
 		configmapObjects = append(configmapObjects, configmapBuilder)
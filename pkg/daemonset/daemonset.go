@@ -2,10 +2,10 @@ package daemonset
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	appsv1 "k8s.io/api/apps/v1"
@@ -18,6 +18,9 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// resourceCRD is the kind of the resource, used in the errors returned by the builder.
+const resourceCRD = "DaemonSet"
+
 // Builder provides struct for daemonset object containing connection to the cluster and the daemonset definitions.
 type Builder struct {
 	// Daemonset definition. Used to create a daemonset object.
@@ -105,7 +108,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient is nil")
 
-		return nil, fmt.Errorf("apiClient cannot be nil")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "apiClient cannot be nil")
 	}
 
 	builder := &Builder{
@@ -121,17 +124,17 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		klog.V(100).Info("The name of the daemonset is empty")
 
-		return nil, fmt.Errorf("daemonset name cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "daemonset name cannot be empty")
 	}
 
 	if nsname == "" {
 		klog.V(100).Info("The namespace of the daemonset is empty")
 
-		return nil, fmt.Errorf("daemonset namespace cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "daemonset namespace cannot be empty")
 	}
 
 	if !builder.Exists() {
-		return nil, fmt.Errorf("daemonset object %s does not exist in namespace %s", name, nsname)
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -379,7 +382,8 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, goinfraerrors.WrapWaitError(
+		resourceCRD, builder.Definition.Name, builder.Definition.Namespace, builder.Object, err)
 }
 
 // DeleteAndWait deletes a daemonset and waits until it is removed from the cluster.
@@ -396,7 +400,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 	}
 
 	// Polls the daemonset every retryInterval until it is removed.
-	return wait.PollUntilContextTimeout(
+	err := wait.PollUntilContextTimeout(
 		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Get(
 				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
@@ -406,6 +410,8 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 
 			return false, nil
 		})
+
+	return goinfraerrors.WrapWaitError(resourceCRD, builder.Definition.Name, builder.Definition.Namespace, nil, err)
 }
 
 // Exists checks whether the given daemonset exists.
@@ -474,30 +480,28 @@ func GetGVR() schema.GroupVersionResource {
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "DaemonSet"
-
 	if builder == nil {
 		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
 		klog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
 		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
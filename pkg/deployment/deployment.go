@@ -8,8 +8,12 @@ import (
 
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -18,9 +22,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	appsv1Typed "k8s.io/client-go/kubernetes/typed/apps/v1"
-	"k8s.io/klog/v2"
 )
 
+// resourceCRD is the kind of the resource, used in the errors returned by the builder.
+const resourceCRD = "Deployment"
+
 // Builder provides struct for deployment object containing connection to the cluster and the deployment definitions.
 type Builder struct {
 	// Deployment definition. Used to create the deployment object.
@@ -31,6 +37,10 @@ type Builder struct {
 	// object is created.
 	errorMsg  string
 	apiClient appsv1Typed.AppsV1Interface
+	// clientLogger is the logger of the clients.Settings the builder was created with. See getLogger.
+	clientLogger logr.Logger
+	// metrics are the metrics of the clients.Settings the builder was created with. See observe.
+	metrics *clients.Metrics
 }
 
 // AdditionalOptions additional options for deployment object.
@@ -39,13 +49,15 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string, labels map[string]string, containerSpec corev1.Container) *Builder {
-	klog.V(100).Infof(
-		"Initializing new deployment structure with the following params: "+
-			"name: %s, namespace: %s, labels: %s, containerSpec %v",
-		name, nsname, labels, containerSpec)
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "name", name, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info(
+		"Initializing new deployment structure", "labels", labels, "containerSpec", containerSpec)
 
 	builder := &Builder{
-		apiClient: apiClient.AppsV1Interface,
+		apiClient:    apiClient.AppsV1Interface,
+		clientLogger: logging.FromClient(apiClient),
+		metrics:      apiClient.GetMetrics(),
 		Definition: &appsv1.Deployment{
 			Spec: appsv1.DeploymentSpec{
 				Selector: &metav1.LabelSelector{
@@ -67,7 +79,7 @@ func NewBuilder(
 	builder.WithAdditionalContainerSpecs([]corev1.Container{containerSpec})
 
 	if name == "" {
-		klog.V(100).Info("The name of the deployment is empty")
+		logger.V(logging.LevelDebug).Info("The name of the deployment is empty")
 
 		builder.errorMsg = "deployment 'name' cannot be empty"
 
@@ -75,7 +87,7 @@ func NewBuilder(
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the deployment is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the deployment is empty")
 
 		builder.errorMsg = "deployment 'namespace' cannot be empty"
 
@@ -83,7 +95,7 @@ func NewBuilder(
 	}
 
 	if len(labels) == 0 {
-		klog.V(100).Info("There are no labels for the deployment")
+		logger.V(logging.LevelDebug).Info("There are no labels for the deployment")
 
 		builder.errorMsg = "deployment 'labels' cannot be empty"
 
@@ -93,19 +105,80 @@ func NewBuilder(
 	return builder
 }
 
+// NewBuilderFromManifest creates a new instance of Builder whose definition is decoded from a single YAML or JSON
+// deployment manifest. Unknown fields and kinds other than Deployment are rejected.
+func NewBuilderFromManifest(apiClient *clients.Settings, manifest []byte) *Builder {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
+
+	logger.V(logging.LevelDebug).Info("Initializing new deployment structure from manifest")
+
+	if apiClient == nil {
+		logger.V(logging.LevelDebug).Info("The apiClient of the deployment is nil")
+
+		return nil
+	}
+
+	definition, err := common.DecodeManifest[appsv1.Deployment](apiClient.Scheme(), manifest)
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to decode deployment manifest", "error", err)
+
+		return &Builder{
+			apiClient:    apiClient.AppsV1Interface,
+			clientLogger: logging.FromClient(apiClient),
+			metrics:      apiClient.GetMetrics(),
+			Definition:   &appsv1.Deployment{},
+			errorMsg:     fmt.Sprintf("failed to decode deployment manifest: %v", err),
+		}
+	}
+
+	builder := &Builder{
+		apiClient:    apiClient.AppsV1Interface,
+		clientLogger: logging.FromClient(apiClient),
+		metrics:      apiClient.GetMetrics(),
+		Definition:   definition,
+	}
+
+	if builder.Definition.Name == "" {
+		logger.V(logging.LevelDebug).Info("The name of the deployment is empty")
+
+		builder.errorMsg = "deployment 'name' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Namespace == "" {
+		logger.V(logging.LevelDebug).Info("The namespace of the deployment is empty")
+
+		builder.errorMsg = "deployment 'namespace' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
 // Pull loads an existing deployment into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the same as Pull but uses the provided context for the API call.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logger := logging.FromContext(ctx, apiClient).WithValues("kind", resourceCRD, "name", name, "namespace", nsname)
+
 	// Safeguard against nil apiClient interfaces.
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is nil")
+		logger.V(logging.LevelDebug).Info("The apiClient is nil")
 
 		return nil, fmt.Errorf("apiClient cannot be nil")
 	}
 
-	klog.V(100).Infof("Pulling existing deployment name: %s under namespace: %s", name, nsname)
+	logger.V(logging.LevelDebug).Info("Pulling existing deployment")
 
 	builder := &Builder{
-		apiClient: apiClient.AppsV1Interface,
+		apiClient:    apiClient.AppsV1Interface,
+		clientLogger: logging.FromClient(apiClient),
+		metrics:      apiClient.GetMetrics(),
 		Definition: &appsv1.Deployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -115,19 +188,19 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		klog.V(100).Info("The name of the deployment is empty")
+		logger.V(logging.LevelDebug).Info("The name of the deployment is empty")
 
-		return nil, fmt.Errorf("deployment 'name' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "deployment 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the deployment is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the deployment is empty")
 
-		return nil, fmt.Errorf("deployment 'namespace' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "deployment 'namespace' cannot be empty")
 	}
 
-	if !builder.Exists() {
-		return nil, fmt.Errorf("deployment object %s does not exist in namespace %s", name, nsname)
+	if !builder.ExistsWithContext(ctx) {
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -141,8 +214,8 @@ func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Applying nodeSelector %s to deployment %s in namespace %s",
-		selector, builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying nodeSelector to deployment", "nodeSelector", selector)
 
 	builder.Definition.Spec.Template.Spec.NodeSelector = selector
 
@@ -155,8 +228,7 @@ func (builder *Builder) WithReplicas(replicas int32) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Setting %d replicas in deployment %s in namespace %s",
-		replicas, builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Setting replicas in deployment", "replicas", replicas)
 
 	builder.Definition.Spec.Replicas = &replicas
 
@@ -169,11 +241,12 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 		return builder
 	}
 
-	klog.V(100).Infof("Appending a list of container specs %v to deployment %s in namespace %s",
-		specs, builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Appending a list of container specs to deployment", "containerSpecs", specs)
 
 	if len(specs) == 0 {
-		klog.V(100).Info("The container specs are empty")
+		logger.V(logging.LevelDebug).Info("The container specs are empty")
 
 		builder.errorMsg = "cannot accept empty list as container specs"
 
@@ -191,7 +264,8 @@ func (builder *Builder) WithSecondaryNetwork(networks []*multus.NetworkSelection
 		return builder
 	}
 
-	klog.V(100).Infof("Applying secondary networks %v to deployment %s", networks, builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying secondary networks to deployment", "networks", networks)
 
 	if len(networks) == 0 {
 		builder.errorMsg = "can not apply empty networks list"
@@ -218,8 +292,8 @@ func (builder *Builder) WithHugePages() *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Applying hugePages configuration to all containers in deployment: %s",
-		builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying hugePages configuration to all containers in deployment")
 
 	// If volumes are not defined, create an empty list of volumes.
 	if builder.Definition.Spec.Template.Spec.Volumes == nil {
@@ -252,11 +326,12 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 		return builder
 	}
 
-	klog.V(100).Infof("Applying SecurityContext configuration on deployment %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Applying SecurityContext configuration on deployment")
 
 	if securityContext == nil {
-		klog.V(100).Info("The 'securityContext' of the deployment is empty")
+		logger.V(logging.LevelDebug).Info("The 'securityContext' of the deployment is empty")
 
 		builder.errorMsg = "'securityContext' parameter is empty"
 
@@ -274,10 +349,12 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("%v", fmt.Sprintf("Defining deployment's label to %s:%s", labelKey, labelValue))
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Defining deployment's label", "labelKey", labelKey, "labelValue", labelValue)
 
 	if labelKey == "" {
-		klog.V(100).Info("The 'labelKey' of the deployment is empty")
+		logger.V(logging.LevelDebug).Info("The 'labelKey' of the deployment is empty")
 
 		builder.errorMsg = "can not apply empty labelKey"
 
@@ -299,11 +376,12 @@ func (builder *Builder) WithServiceAccountName(serviceAccountName string) *Build
 		return builder
 	}
 
-	klog.V(100).Infof("Setting ServiceAccount %s on deployment %s in namespace %s",
-		serviceAccountName, builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Setting ServiceAccount on deployment", "serviceAccountName", serviceAccountName)
 
 	if serviceAccountName == "" {
-		klog.V(100).Info("The 'serviceAccount' of the deployment is empty")
+		logger.V(logging.LevelDebug).Info("The 'serviceAccount' of the deployment is empty")
 
 		builder.errorMsg = "can not apply empty serviceAccount"
 
@@ -321,16 +399,17 @@ func (builder *Builder) WithVolume(deployVolume corev1.Volume) *Builder {
 		return builder
 	}
 
+	logger := builder.getLogger(context.TODO())
+
 	if deployVolume.Name == "" {
-		klog.V(100).Info("The volume's name cannot be empty")
+		logger.V(logging.LevelDebug).Info("The volume's name cannot be empty")
 
 		builder.errorMsg = "The volume's name cannot be empty"
 
 		return builder
 	}
 
-	klog.V(100).Infof("Adding volume %s to deployment %s in namespace %s",
-		deployVolume.Name, builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelDebug).Info("Adding volume to deployment", "volume", deployVolume.Name)
 
 	builder.Definition.Spec.Template.Spec.Volumes = append(
 		builder.Definition.Spec.Template.Spec.Volumes,
@@ -345,16 +424,17 @@ func (builder *Builder) WithSchedulerName(schedulerName string) *Builder {
 		return builder
 	}
 
+	logger := builder.getLogger(context.TODO())
+
 	if schedulerName == "" {
-		klog.V(100).Info("Scheduler's name cannot be empty")
+		logger.V(logging.LevelDebug).Info("Scheduler's name cannot be empty")
 
 		builder.errorMsg = "Scheduler's name cannot be empty"
 
 		return builder
 	}
 
-	klog.V(100).Infof("Setting scheduler %s for deployment %s in namespace %s",
-		schedulerName, builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelDebug).Info("Setting scheduler for deployment", "schedulerName", schedulerName)
 
 	builder.Definition.Spec.Template.Spec.SchedulerName = schedulerName
 
@@ -367,16 +447,17 @@ func (builder *Builder) WithAffinity(affinity *corev1.Affinity) *Builder {
 		return builder
 	}
 
+	logger := builder.getLogger(context.TODO())
+
 	if affinity == nil {
-		klog.V(100).Info("The Affinity parameter is empty")
+		logger.V(logging.LevelDebug).Info("The Affinity parameter is empty")
 
 		builder.errorMsg = "affinity parameter is empty"
 
 		return builder
 	}
 
-	klog.V(100).Infof("Adding affinity to deployment %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelDebug).Info("Adding affinity to deployment")
 
 	builder.Definition.Spec.Template.Spec.Affinity = affinity
 
@@ -389,8 +470,8 @@ func (builder *Builder) WithHostNetwork(enableHostnetwork bool) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Setting hostnetwork %v to deployment %s in namespace %s",
-		enableHostnetwork, builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Setting hostnetwork on deployment", "hostNetwork", enableHostnetwork)
 
 	builder.Definition.Spec.Template.Spec.HostNetwork = enableHostnetwork
 
@@ -403,13 +484,15 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	klog.V(100).Info("Setting deployment additional options")
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Setting deployment additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 			if err != nil {
-				klog.V(100).Info("Error occurred in mutation function")
+				logger.V(logging.LevelDebug).Info("Error occurred in mutation function", "error", err)
 
 				builder.errorMsg = err.Error()
 
@@ -423,16 +506,23 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // Create generates a deployment in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the same as Create but uses the provided context for all API calls.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Creating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info("Creating deployment")
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
+		start := time.Now()
 		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Create(
-			logging.DiscardContext(), builder.Definition, metav1.CreateOptions{})
+			logging.DiscardContextFrom(ctx), builder.Definition, metav1.CreateOptions{})
+		builder.observe("create", start, err)
 	}
 
 	return builder, err
@@ -440,40 +530,55 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing deployment object with the deployment definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the same as Update but uses the provided context for the API call.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Updating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info("Updating deployment")
 
 	var err error
 
+	start := time.Now()
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
-		logging.DiscardContext(), builder.Definition, metav1.UpdateOptions{})
+		logging.DiscardContextFrom(ctx), builder.Definition, metav1.UpdateOptions{})
+	builder.observe("update", start, err)
 
 	return builder, err
 }
 
 // Delete removes a deployment.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the same as Delete but uses the provided context for all API calls.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Deleting deployment %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info("Deleting deployment")
 
-	if !builder.Exists() {
-		klog.V(100).Infof("Deployment %s in namespace %s does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("Deployment does not exist")
 
 		builder.Object = nil
 
 		return nil
 	}
 
+	start := time.Now()
 	err := builder.apiClient.Deployments(builder.Definition.Namespace).Delete(
-		logging.DiscardContext(), builder.Definition.Name, metav1.DeleteOptions{})
+		logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.DeleteOptions{})
+	builder.observe("delete", start, err)
+
 	if err != nil {
 		return err
 	}
@@ -486,35 +591,43 @@ func (builder *Builder) Delete() error {
 // DeleteGraceful removes a deployment while waiting for specified duration(in seconds)
 // the object should be deleted.
 func (builder *Builder) DeleteGraceful(gracePeriod *int64) error {
+	return builder.DeleteGracefulWithContext(context.TODO(), gracePeriod)
+}
+
+// DeleteGracefulWithContext is the same as DeleteGraceful but uses the provided context for all API calls.
+func (builder *Builder) DeleteGracefulWithContext(ctx context.Context, gracePeriod *int64) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
+	logger := builder.getLogger(ctx)
+
 	switch {
 	case gracePeriod == nil:
-		klog.V(100).Info("gracePeriod cannot be nil")
+		logger.V(logging.LevelDebug).Info("gracePeriod cannot be nil")
 
 		return fmt.Errorf("gracePeriod cannot be nil")
 	case *gracePeriod < int64(0):
-		klog.V(100).Infof("gracePeriod(%v) must be non-negative integer", gracePeriod)
+		logger.V(logging.LevelDebug).Info("gracePeriod must be non-negative integer", "gracePeriod", *gracePeriod)
 
 		return fmt.Errorf("gracePeriod must be non-negative integer")
 	}
 
-	klog.V(100).Infof("Deleting deployment %s in namespace %s with %v seconds grace period",
-		builder.Definition.Name, builder.Definition.Namespace, *gracePeriod)
+	logger.V(logging.LevelOperation).Info("Deleting deployment with grace period", "gracePeriod", *gracePeriod)
 
-	if !builder.Exists() {
-		klog.V(100).Infof("Deployment %s in namespace %s does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("Deployment does not exist")
 
 		builder.Object = nil
 
 		return nil
 	}
 
+	start := time.Now()
 	err := builder.apiClient.Deployments(builder.Definition.Namespace).Delete(
-		logging.DiscardContext(), builder.Definition.Name, metav1.DeleteOptions{GracePeriodSeconds: gracePeriod})
+		logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.DeleteOptions{GracePeriodSeconds: gracePeriod})
+	builder.observe("delete", start, err)
+
 	if err != nil {
 		return err
 	}
@@ -526,20 +639,28 @@ func (builder *Builder) DeleteGraceful(gracePeriod *int64) error {
 
 // CreateAndWaitUntilReady creates a deployment in the cluster and waits until the deployment is available.
 func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilReadyWithContext(context.TODO(), timeout)
+}
+
+// CreateAndWaitUntilReadyWithContext is the same as CreateAndWaitUntilReady but uses the provided context for all API
+// calls and for the wait.
+func (builder *Builder) CreateAndWaitUntilReadyWithContext(ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Creating deployment %s in namespace %s and waiting for the defined period until it is ready",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelOperation).Info(
+		"Creating deployment and waiting for the defined period until it is ready", "timeout", timeout)
 
-	if _, err := builder.Create(); err != nil {
-		klog.V(100).Infof("Failed to create deployment. Error is: '%s'", err.Error())
+	if _, err := builder.CreateWithContext(ctx); err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to create deployment", "error", err)
 
 		return nil, err
 	}
 
-	if builder.IsReady(timeout) {
+	if builder.IsReadyWithContext(ctx, timeout) {
 		return builder, nil
 	}
 
@@ -550,25 +671,32 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 // IsReady periodically checks if deployment is in ready status.
 func (builder *Builder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.TODO(), timeout)
+}
+
+// IsReadyWithContext is the same as IsReady but stops checking once the provided context is done.
+func (builder *Builder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	klog.V(100).Infof("Running periodic check until deployment %s in namespace %s is ready",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
+
+	logger.V(logging.LevelDebug).Info("Running periodic check until deployment is ready", "timeout", timeout)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return false
 	}
 
+	start := time.Now()
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
 
 			builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+				logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
 			if err != nil {
-				klog.V(100).Infof("Failed to get deployment from cluster. Error is: '%s'", err.Error())
+				logger.V(logging.LevelDebug).Info("Failed to get deployment from cluster", "error", err)
 
 				return false, err
 			}
@@ -580,48 +708,68 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 			return false, nil
 		})
 
+	builder.observe("wait", start, err)
+
 	return err == nil
 }
 
 // DeleteAndWait deletes a deployment and waits until it is removed from the cluster.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.TODO(), timeout)
+}
+
+// DeleteAndWaitWithContext is the same as DeleteAndWait but uses the provided context for all API calls and for the
+// wait.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Deleting deployment %s in namespace %s and waiting for the defined period until it is removed",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info(
+		"Deleting deployment and waiting for the defined period until it is removed", "timeout", timeout)
 
-	if err := builder.Delete(); err != nil {
+	if err := builder.DeleteWithContext(ctx); err != nil {
 		return err
 	}
 
 	// Polls the deployment every second until it is removed.
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+	start := time.Now()
+	err := wait.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+				logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
 			}
 
 			return false, nil
 		})
+
+	err = goinfraerrors.WrapWaitError(resourceCRD, builder.Definition.Name, builder.Definition.Namespace, nil, err)
+	builder.observe("wait", start, err)
+
+	return err
 }
 
 // Exists checks whether the given deployment exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the same as Exists but uses the provided context for the API call.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	klog.V(100).Infof("Checking if deployment %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info("Checking if deployment exists")
 
-	var err error
+	start := time.Now()
+	object, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
+		logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
+	builder.observe("get", start, err)
 
-	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-		logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+	builder.Object = object
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -629,25 +777,36 @@ func (builder *Builder) Exists() bool {
 // WaitUntilCondition waits for the duration of the defined timeout or until the
 // deployment gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionWithContext(context.TODO(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext is the same as WaitUntilCondition but stops waiting once the provided context is done.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition appsv1.DeploymentConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s has condition %v",
-		builder.Definition.Name, builder.Definition.Namespace, condition)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info(
+		"Waiting for the defined period until deployment has condition", "condition", condition, "timeout", timeout)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("cannot wait for deployment condition because it does not exist")
 	}
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+	lastObserved := builder.Object
+
+	start := time.Now()
+	err := wait.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			updateDeployment, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+				logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
 			if err != nil {
 				return false, nil
 			}
 
+			lastObserved = updateDeployment
+
 			for _, cond := range updateDeployment.Status.Conditions {
 				if cond.Type == condition && cond.Status == corev1.ConditionTrue {
 					return true, nil
@@ -656,21 +815,33 @@ func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionT
 
 			return false, nil
 		})
+
+	err = goinfraerrors.WrapWaitError(
+		resourceCRD, builder.Definition.Name, builder.Definition.Namespace, lastObserved, err)
+	builder.observe("wait", start, err)
+
+	return err
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the deployment is deleted.
 func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilDeletedWithContext is the same as WaitUntilDeleted but stops waiting once the provided context is done.
+func (builder *Builder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s is deleted",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info(
+		"Waiting for the defined period until deployment is deleted", "timeout", timeout)
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+	start := time.Now()
+	err := wait.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+				logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
 
 			if k8serrors.IsNotFound(err) {
 				return true, nil
@@ -678,6 +849,11 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 
 			return false, nil
 		})
+
+	err = goinfraerrors.WrapWaitError(resourceCRD, builder.Definition.Name, builder.Definition.Namespace, nil, err)
+	builder.observe("wait", start, err)
+
+	return err
 }
 
 // GetGVR returns deployment's GroupVersionResource which could be used for Clean function.
@@ -685,33 +861,56 @@ func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
 }
 
+// ToJSON returns the deployment definition as a JSON manifest with the apiVersion and kind set and the status and
+// server-populated metadata removed.
+func (builder *Builder) ToJSON() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Exporting deployment as JSON")
+
+	return common.ExportObjectJSON(builder.Definition, appsv1.SchemeGroupVersion.WithKind("Deployment"))
+}
+
+// ToYAML is the same as ToJSON but returns a YAML manifest.
+func (builder *Builder) ToYAML() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Exporting deployment as YAML")
+
+	return common.ExportObjectYAML(builder.Definition, appsv1.SchemeGroupVersion.WithKind("Deployment"))
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "ClusterDeployment"
+	logger := builder.getLogger(context.TODO())
 
 	if builder == nil {
-		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder is uninitialized")
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		klog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder definition is undefined")
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder apiClient is nil")
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelDebug).Info("The builder has an error message", "errorMsg", builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
@@ -723,16 +922,17 @@ func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
 		return builder
 	}
 
+	logger := builder.getLogger(context.TODO())
+
 	if toleration == (corev1.Toleration{}) {
-		klog.V(100).Info("The toleration cannot be empty")
+		logger.V(logging.LevelDebug).Info("The toleration cannot be empty")
 
 		builder.errorMsg = "The toleration cannot be empty"
 
 		return builder
 	}
 
-	klog.V(100).Infof("Adding TaintToleration %v to deployment %s in namespace %s",
-		toleration, builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelDebug).Info("Adding TaintToleration to deployment", "toleration", toleration)
 
 	builder.Definition.Spec.Template.Spec.Tolerations = append(
 		builder.Definition.Spec.Template.Spec.Tolerations,
@@ -747,11 +947,36 @@ func (builder *Builder) WithTerminationGracePeriodSeconds(terminationGracePeriod
 		return builder
 	}
 
-	klog.V(100).Infof(
-		"Applying terminationGracePeriodSeconds flag to the configuration of pod template of deployment: %s in namespace: %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying terminationGracePeriodSeconds to the pod template of deployment",
+		"terminationGracePeriodSeconds", terminationGracePeriodSeconds)
 
 	builder.Definition.Spec.Template.Spec.TerminationGracePeriodSeconds = &terminationGracePeriodSeconds
 
 	return builder
 }
+
+// getLogger returns the logger for the builder's operations, with the kind, name, and namespace of the deployment as
+// key/values. The logger from the context takes precedence over the logger of the client the builder was created
+// with, see logging.FromContextOr. It is safe to call on a nil or undefined builder.
+func (builder *Builder) getLogger(ctx context.Context) logr.Logger {
+	if builder == nil {
+		return logging.FromContextOr(ctx, logr.Logger{}).WithValues("kind", resourceCRD)
+	}
+
+	logger := logging.FromContextOr(ctx, builder.clientLogger).WithValues("kind", resourceCRD)
+
+	if builder.Definition != nil {
+		logger = logger.WithValues("name", builder.Definition.Name, "namespace", builder.Definition.Namespace)
+	}
+
+	return logger
+}
+
+// observe records an operation on the deployment in the metrics of the client the builder was created with. A builder
+// without metrics records nothing. The builder makes each API call once without a clients.RetryPolicy, so no retries
+// are ever recorded for it.
+func (builder *Builder) observe(operation string, start time.Time, err error) {
+	builder.metrics.ObserveOperation(
+		appsv1.SchemeGroupVersion.WithKind(resourceCRD), operation, tracing.Outcome(err), time.Since(start))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 	appsv1 "k8s.io/api/apps/v1"
@@ -760,6 +761,52 @@ func TestDeleteAndWait(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestDeploymentMetrics(t *testing.T) {
+	fakeClient := k8sfake.NewSimpleClientset()
+	testSettings := &clients.Settings{
+		K8sClient:       fakeClient,
+		CoreV1Interface: fakeClient.CoreV1(),
+		AppsV1Interface: fakeClient.AppsV1(),
+		Metrics:         clients.NewMetrics(),
+	}
+
+	testBuilder, err := NewBuilder(testSettings, "test-name", "test-namespace", map[string]string{
+		"test-key": "test-value",
+	}, corev1.Container{
+		Name: "test-container",
+	}).Create()
+	assert.Nil(t, err)
+
+	_, err = testBuilder.Update()
+	assert.Nil(t, err)
+
+	err = testBuilder.DeleteAndWait(time.Second * 5)
+	assert.Nil(t, err)
+
+	operations := make(map[string]clients.OperationSummary)
+	for _, summary := range testSettings.Metrics.Summary() {
+		operations[summary.Operation] = summary
+	}
+
+	// The get made by Create fails with not found, while the one made by DeleteAndWait succeeds.
+	expectedOperations := map[string]struct{ count, failures int }{
+		"create": {count: 1},
+		"get":    {count: 2, failures: 1},
+		"update": {count: 1},
+		"delete": {count: 1},
+		"wait":   {count: 1},
+	}
+
+	assert.Len(t, operations, len(expectedOperations))
+
+	for operation, expected := range expectedOperations {
+		assert.Equal(t, appsv1.SchemeGroupVersion.WithKind("Deployment"), operations[operation].GVK, operation)
+		assert.Equal(t, expected.count, operations[operation].Count, operation)
+		assert.Equal(t, expected.failures, operations[operation].Failures, operation)
+		assert.Equal(t, 0, operations[operation].Retries, operation)
+	}
+}
+
 func TestWaitUntilCondition(t *testing.T) {
 	generateTestDeployment := func() *appsv1.Deployment {
 		return &appsv1.Deployment{
@@ -791,6 +838,53 @@ func TestWaitUntilCondition(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestIsReady(t *testing.T) {
+	// IsReady reads the deployment once to check it exists, then polls it every second starting immediately. The
+	// ready case becomes ready on the first poll and the not ready case cannot reach 100 reads before the timeout, so
+	// neither depends on how many polls fit in the timeout.
+	testCases := []struct {
+		readyAfterReads int
+		timeout         time.Duration
+		expectedReady   bool
+	}{
+		{
+			readyAfterReads: 1,
+			timeout:         5 * time.Second,
+			expectedReady:   true,
+		},
+		{
+			readyAfterReads: 100,
+			timeout:         time.Second,
+			expectedReady:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings, err := clients.NewTestClientBuilder().
+			WithObjects(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-name",
+					Namespace: "test-namespace",
+				},
+			}).
+			WithStatusTransitions(&appsv1.Deployment{},
+				clients.AfterReads(testCase.readyAfterReads, func(deployment *appsv1.Deployment) {
+					deployment.Status.Replicas = 1
+					deployment.Status.ReadyReplicas = 1
+				})).
+			Build()
+		assert.Nil(t, err)
+
+		testBuilder := NewBuilder(testSettings, "test-name", "test-namespace", map[string]string{
+			"test-key": "test-value",
+		}, corev1.Container{
+			Name: "test-container",
+		})
+
+		assert.Equal(t, testCase.expectedReady, testBuilder.IsReady(testCase.timeout))
+	}
+}
+
 func TestValidate(t *testing.T) {
 	testCases := []struct {
 		builderNil    bool
@@ -802,19 +896,19 @@ func TestValidate(t *testing.T) {
 			builderNil:    true,
 			definitionNil: false,
 			apiClientNil:  false,
-			expectedError: "error: received nil ClusterDeployment builder",
+			expectedError: "error: received nil Deployment builder",
 		},
 		{
 			builderNil:    false,
 			definitionNil: true,
 			apiClientNil:  false,
-			expectedError: "can not redefine the undefined ClusterDeployment",
+			expectedError: "can not redefine the undefined Deployment",
 		},
 		{
 			builderNil:    false,
 			definitionNil: false,
 			apiClientNil:  true,
-			expectedError: "ClusterDeployment builder cannot have nil apiClient",
+			expectedError: "Deployment builder cannot have nil apiClient",
 		},
 		{
 			builderNil:    false,
@@ -875,7 +969,11 @@ func TestDeploymentWaitUntilDeleted(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testDeployment.WaitUntilDeleted(time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+		assert.ErrorIs(t, err, testCase.expectedError)
+
+		if testCase.expectedError != nil {
+			assert.True(t, goinfraerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+		}
 
 		if testCase.expectedError == nil {
 			assert.Nil(t, testCase.testDeployment.Object)
@@ -883,6 +981,24 @@ func TestDeploymentWaitUntilDeleted(t *testing.T) {
 	}
 }
 
+func TestDeploymentWaitUntilDeletedWithContext(t *testing.T) {
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-name",
+				Namespace: "test-namespace",
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	err := testBuilder.WaitUntilDeletedWithContext(ctx, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, goinfraerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+}
+
 func TestWithTerminationGracePeriodSeconds(t *testing.T) {
 	testCases := []struct {
 		terminationGracePeriodSeconds int64
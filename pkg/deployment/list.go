@@ -6,36 +6,36 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/klog/v2"
 )
 
 // List returns deployment inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "namespace", nsname)
+
 	if nsname == "" {
-		klog.V(100).Info("deployment 'nsname' parameter can not be empty")
+		logger.V(logging.LevelDebug).Info("deployment 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list deployments, 'nsname' parameter is empty")
 	}
 
 	passedOptions := metav1.ListOptions{}
-	logMessage := fmt.Sprintf("Listing deployments in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Infof("%v", logMessage)
+	logger.V(logging.LevelDebug).Info("Listing deployments in the namespace", "options", passedOptions)
 
-	deploymentList, err := apiClient.Deployments(nsname).List(logging.DiscardContext(), passedOptions)
+	deploymentList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.Deployments(nsname).List)
 	if err != nil {
-		klog.V(100).Infof("Failed to list deployments in the namespace %s due to %s", nsname, err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list deployments in the namespace", "error", err)
 
 		return nil, err
 	}
@@ -45,9 +45,11 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 	for _, runningDeployment := range deploymentList.Items {
 		copiedDeployment := runningDeployment
 		deploymentBuilder := &Builder{
-			apiClient:  apiClient.AppsV1Interface,
-			Object:     &copiedDeployment,
-			Definition: &copiedDeployment,
+			apiClient:    apiClient.AppsV1Interface,
+			clientLogger: logging.FromClient(apiClient),
+			metrics:      apiClient.GetMetrics(),
+			Object:       &copiedDeployment,
+			Definition:   &copiedDeployment,
 		}
 
 		deploymentObjects = append(deploymentObjects, deploymentBuilder)
@@ -58,25 +60,24 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 // ListInAllNamespaces returns deployment inventory in the all the namespaces.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
 	passedOptions := metav1.ListOptions{}
-	logMessage := "Listing deployments in all namespaces"
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be either empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be either empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Infof("%v", logMessage)
+	logger.V(logging.LevelDebug).Info("Listing deployments in all namespaces", "options", passedOptions)
 
-	deploymentList, err := apiClient.Deployments("").List(logging.DiscardContext(), passedOptions)
+	deploymentList, err := clients.CollectPages(logging.DiscardContext(), passedOptions, apiClient.Deployments("").List)
 	if err != nil {
-		klog.V(100).Infof("Failed to list deployments in all namespaces due to %s", err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list deployments in all namespaces", "error", err)
 
 		return nil, err
 	}
@@ -86,9 +87,11 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 	for _, runningDeployment := range deploymentList.Items {
 		copiedDeployment := runningDeployment
 		deploymentBuilder := &Builder{
-			apiClient:  apiClient.AppsV1Interface,
-			Object:     &copiedDeployment,
-			Definition: &copiedDeployment,
+			apiClient:    apiClient.AppsV1Interface,
+			clientLogger: logging.FromClient(apiClient),
+			metrics:      apiClient.GetMetrics(),
+			Object:       &copiedDeployment,
+			Definition:   &copiedDeployment,
 		}
 
 		deploymentObjects = append(deploymentObjects, deploymentBuilder)
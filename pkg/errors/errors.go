@@ -0,0 +1,247 @@
+// Package errors provides the exported errors returned by builders so callers can check for failures using errors.Is
+// and errors.As rather than matching error strings. Each category of failure has a sentinel error, such as
+// [ErrNotFound], and a typed error carrying the details of the resource, such as [NotFoundError]. The typed errors
+// satisfy errors.Is for their sentinel.
+//
+// These errors are returned by the common builders and by the builders in the pod, deployment, configmap, service,
+// serviceaccount, daemonset, statefulset and cgu packages, as well as by the waits in the bmh and mco packages. Builders
+// in all other packages return plain errors, so only the IsNotFound, IsAlreadyExists and IsConflict helpers, which also
+// match the status errors from the API server, may be used with them.
+//
+// Since this package shares its name with the standard library, it is conventionally imported as goinfraerrors.
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var (
+	// ErrNotFound indicates that the resource does not exist on the cluster.
+	ErrNotFound = errors.New("resource not found")
+	// ErrAlreadyExists indicates that the resource could not be created because it already exists.
+	ErrAlreadyExists = errors.New("resource already exists")
+	// ErrInvalidBuilder indicates that the builder cannot be used, for example because it is nil, has no client, is
+	// missing a required field, or recorded an error while it was being configured.
+	ErrInvalidBuilder = errors.New("invalid builder")
+	// ErrWaitTimeout indicates that waiting for a resource to reach some state ended before the state was reached.
+	ErrWaitTimeout = errors.New("timed out waiting for resource")
+	// ErrConflict indicates that a change to the resource conflicts with another change, either because the resource
+	// was modified since it was read or because another field manager owns the applied fields.
+	ErrConflict = errors.New("resource conflict")
+)
+
+// NotFoundError is returned when a resource does not exist on the cluster. It satisfies errors.Is for ErrNotFound.
+type NotFoundError struct {
+	Kind      string
+	Name      string
+	Namespace string
+}
+
+var _ error = (*NotFoundError)(nil)
+
+// NewNotFound creates a new NotFoundError for the resource. The namespace is empty for cluster-scoped resources.
+func NewNotFound(kind, name, namespace string) *NotFoundError {
+	return &NotFoundError{Kind: kind, Name: name, Namespace: namespace}
+}
+
+// Error returns the same message the legacy builders have always returned, which uses the lowercase kind.
+func (e *NotFoundError) Error() string {
+	if e.Namespace == "" {
+		return fmt.Sprintf("%s object %s does not exist", strings.ToLower(e.Kind), e.Name)
+	}
+
+	return fmt.Sprintf("%s object %s does not exist in namespace %s", strings.ToLower(e.Kind), e.Name, e.Namespace)
+}
+
+// Is returns true if the target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// AlreadyExistsError is returned when a resource could not be created because it already exists. It satisfies
+// errors.Is for ErrAlreadyExists.
+type AlreadyExistsError struct {
+	Kind      string
+	Name      string
+	Namespace string
+}
+
+var _ error = (*AlreadyExistsError)(nil)
+
+// NewAlreadyExists creates a new AlreadyExistsError for the resource. The namespace is empty for cluster-scoped
+// resources.
+func NewAlreadyExists(kind, name, namespace string) *AlreadyExistsError {
+	return &AlreadyExistsError{Kind: kind, Name: name, Namespace: namespace}
+}
+
+// Error returns a message in the same form as NotFoundError, using the lowercase kind.
+func (e *AlreadyExistsError) Error() string {
+	if e.Namespace == "" {
+		return fmt.Sprintf("%s object %s already exists", strings.ToLower(e.Kind), e.Name)
+	}
+
+	return fmt.Sprintf("%s object %s already exists in namespace %s", strings.ToLower(e.Kind), e.Name, e.Namespace)
+}
+
+// Is returns true if the target is ErrAlreadyExists.
+func (e *AlreadyExistsError) Is(target error) bool {
+	return target == ErrAlreadyExists
+}
+
+// InvalidBuilderError is returned when a builder cannot be used. The Message is the same message builders have always
+// returned, so switching to this error does not change the error string. It satisfies errors.Is for
+// ErrInvalidBuilder.
+type InvalidBuilderError struct {
+	Kind    string
+	Message string
+}
+
+var _ error = (*InvalidBuilderError)(nil)
+
+// NewInvalidBuilder creates a new InvalidBuilderError for a builder of the provided kind. The message is formatted
+// using fmt.Sprintf.
+func NewInvalidBuilder(kind, format string, args ...any) *InvalidBuilderError {
+	return &InvalidBuilderError{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *InvalidBuilderError) Error() string {
+	return e.Message
+}
+
+// Is returns true if the target is ErrInvalidBuilder.
+func (e *InvalidBuilderError) Is(target error) bool {
+	return target == ErrInvalidBuilder
+}
+
+// WaitTimeoutError is returned when waiting for a resource ends before it reaches the desired state. LastObserved is
+// the last form of the resource that was seen, which is nil if the resource did not exist, and Reason describes why it
+// did not match. It wraps the error that ended the wait, usually from the context, and satisfies errors.Is for
+// ErrWaitTimeout.
+type WaitTimeoutError struct {
+	Kind         string
+	Name         string
+	Namespace    string
+	LastObserved any
+	Reason       string
+	Err          error
+}
+
+var _ error = (*WaitTimeoutError)(nil)
+
+// NewWaitTimeout creates a new WaitTimeoutError for the resource. The err is the error that ended the wait.
+func NewWaitTimeout(kind, name, namespace string, lastObserved any, reason string, err error) *WaitTimeoutError {
+	return &WaitTimeoutError{
+		Kind:         kind,
+		Name:         name,
+		Namespace:    namespace,
+		LastObserved: lastObserved,
+		Reason:       reason,
+		Err:          err,
+	}
+}
+
+func (e *WaitTimeoutError) Error() string {
+	resource := resourceString(e.Kind, e.Name, e.Namespace)
+
+	if e.Reason == "" {
+		return fmt.Sprintf("failed waiting for %s: %v", resource, e.Err)
+	}
+
+	return fmt.Sprintf("failed waiting for %s: %v: last observed state: %s", resource, e.Err, e.Reason)
+}
+
+// Is returns true if the target is ErrWaitTimeout.
+func (e *WaitTimeoutError) Is(target error) bool {
+	return target == ErrWaitTimeout
+}
+
+func (e *WaitTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// WrapWaitError returns a WaitTimeoutError for the resource if err was caused by the context of a wait ending, such
+// as when its deadline is exceeded or it is cancelled. Otherwise, including when err is nil, err is returned unchanged.
+// This allows builders that wait by polling to return the same errors as the watch-based waits.
+func WrapWaitError(kind, name, namespace string, lastObserved any, err error) error {
+	if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return NewWaitTimeout(kind, name, namespace, lastObserved, "", err)
+}
+
+// ConflictError is returned when a change to a resource conflicts with another change. It wraps the conflict error
+// returned by the API server and satisfies errors.Is for ErrConflict.
+type ConflictError struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Err       error
+}
+
+var _ error = (*ConflictError)(nil)
+
+// NewConflict creates a new ConflictError for the resource. The err is the error returned by the API server.
+func NewConflict(kind, name, namespace string, err error) *ConflictError {
+	return &ConflictError{Kind: kind, Name: name, Namespace: namespace, Err: err}
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("conflict changing %s: %v", resourceString(e.Kind, e.Name, e.Namespace), e.Err)
+}
+
+// Is returns true if the target is ErrConflict.
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound returns true if an error, or any error in the error's tree, is ErrNotFound or a NotFound status error from
+// the API server.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || k8serrors.IsNotFound(err)
+}
+
+// IsAlreadyExists returns true if an error, or any error in the error's tree, is ErrAlreadyExists or an AlreadyExists
+// status error from the API server.
+func IsAlreadyExists(err error) bool {
+	return errors.Is(err, ErrAlreadyExists) || k8serrors.IsAlreadyExists(err)
+}
+
+// IsConflict returns true if an error, or any error in the error's tree, is ErrConflict or a Conflict status error from
+// the API server.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict) || k8serrors.IsConflict(err)
+}
+
+// IsInvalidBuilder returns true if an error, or any error in the error's tree, is ErrInvalidBuilder.
+func IsInvalidBuilder(err error) bool {
+	return errors.Is(err, ErrInvalidBuilder)
+}
+
+// IsWaitTimeout returns true if an error, or any error in the error's tree, is ErrWaitTimeout.
+func IsWaitTimeout(err error) bool {
+	return errors.Is(err, ErrWaitTimeout)
+}
+
+// resourceString formats the resource the same way as the errors from the common builders, for example
+// "ConfigMap namespace/name".
+func resourceString(kind, name, namespace string) string {
+	if name == "" {
+		return kind
+	}
+
+	if namespace == "" {
+		return fmt.Sprintf("%s %s", kind, name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", kind, namespace, name)
+}
@@ -0,0 +1,121 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var testGroupResource = schema.GroupResource{Resource: "configmaps"}
+
+func TestErrorsMatchSentinels(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		err           error
+		sentinel      error
+		expectedError string
+	}{
+		{
+			name:          "namespaced not found",
+			err:           NewNotFound("ConfigMap", "test", "test-ns"),
+			sentinel:      ErrNotFound,
+			expectedError: "configmap object test does not exist in namespace test-ns",
+		},
+		{
+			name:          "cluster-scoped not found",
+			err:           NewNotFound("namespace", "test", ""),
+			sentinel:      ErrNotFound,
+			expectedError: "namespace object test does not exist",
+		},
+		{
+			name:          "already exists",
+			err:           NewAlreadyExists("configmap", "test", "test-ns"),
+			sentinel:      ErrAlreadyExists,
+			expectedError: "configmap object test already exists in namespace test-ns",
+		},
+		{
+			name:          "invalid builder",
+			err:           NewInvalidBuilder("ConfigMap", "error: received nil %s builder", "ConfigMap"),
+			sentinel:      ErrInvalidBuilder,
+			expectedError: "error: received nil ConfigMap builder",
+		},
+		{
+			name:          "wait timeout",
+			err:           NewWaitTimeout("ConfigMap", "test", "test-ns", nil, "not ready", context.DeadlineExceeded),
+			sentinel:      ErrWaitTimeout,
+			expectedError: "failed waiting for ConfigMap test-ns/test: context deadline exceeded: last observed state: not ready",
+		},
+		{
+			name:          "conflict",
+			err:           NewConflict("ConfigMap", "test", "", errors.New("object was modified")),
+			sentinel:      ErrConflict,
+			expectedError: "conflict changing ConfigMap test: object was modified",
+		},
+	}
+
+	sentinels := []error{ErrNotFound, ErrAlreadyExists, ErrInvalidBuilder, ErrWaitTimeout, ErrConflict}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expectedError, testCase.err.Error())
+
+			wrapped := fmt.Errorf("wrapped: %w", testCase.err)
+
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == testCase.sentinel, errors.Is(wrapped, sentinel), "sentinel: %v", sentinel)
+			}
+		})
+	}
+}
+
+func TestWaitTimeoutErrorDetails(t *testing.T) {
+	t.Parallel()
+
+	lastObserved := struct{}{}
+	err := fmt.Errorf("wrapped: %w",
+		NewWaitTimeout("ConfigMap", "test", "test-ns", lastObserved, "not ready", context.DeadlineExceeded))
+
+	var waitTimeout *WaitTimeoutError
+
+	assert.True(t, errors.As(err, &waitTimeout))
+	assert.Equal(t, lastObserved, waitTimeout.LastObserved)
+	assert.Equal(t, "not ready", waitTimeout.Reason)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, IsWaitTimeout(err))
+}
+
+func TestWrapWaitError(t *testing.T) {
+	t.Parallel()
+
+	otherErr := errors.New("condition failed")
+
+	assert.NoError(t, WrapWaitError("Pod", "test", "test-ns", nil, nil))
+	assert.Equal(t, otherErr, WrapWaitError("Pod", "test", "test-ns", nil, otherErr))
+
+	for _, contextErr := range []error{context.DeadlineExceeded, context.Canceled} {
+		err := WrapWaitError("Pod", "test", "test-ns", nil, contextErr)
+		assert.True(t, IsWaitTimeout(err))
+		assert.True(t, errors.Is(err, contextErr))
+		assert.Equal(t, "failed waiting for Pod test-ns/test: "+contextErr.Error(), err.Error())
+	}
+}
+
+func TestIsHelpersMatchAPIErrors(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsNotFound(k8serrors.NewNotFound(testGroupResource, "test")))
+	assert.True(t, IsAlreadyExists(k8serrors.NewAlreadyExists(testGroupResource, "test")))
+	assert.True(t, IsConflict(k8serrors.NewConflict(testGroupResource, "test", errors.New("modified"))))
+	assert.False(t, IsNotFound(k8serrors.NewAlreadyExists(testGroupResource, "test")))
+	assert.False(t, IsInvalidBuilder(errors.New("invalid builder")))
+	assert.False(t, IsNotFound(nil))
+}
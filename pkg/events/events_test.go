@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestPull(t *testing.T) {
@@ -136,6 +137,64 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestListSeq(t *testing.T) {
+	testCases := []struct {
+		options       []runtimeclient.ListOption
+		client        bool
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			options:       []runtimeclient.ListOption{runtimeclient.InNamespace("test-namespace")},
+			client:        true,
+			expectedNames: []string{"test-event"},
+		},
+		{
+			options:       nil,
+			client:        true,
+			expectedNames: []string{"test-event", "other-event"},
+		},
+		{
+			options:       nil,
+			client:        false,
+			expectedError: "failed to list Events, 'apiClient' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{
+					&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "test-event", Namespace: "test-namespace"}},
+					&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "other-event", Namespace: "other-namespace"}},
+				},
+			})
+		}
+
+		var names []string
+
+		for eventBuilder, err := range ListSeq(testSettings, testCase.options...) {
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				continue
+			}
+
+			assert.Nil(t, err)
+			// Builders for events in all namespaces use a client for the namespace of their event.
+			namespacedClient, ok := eventBuilder.apiClient.(interface{ Namespace() string })
+			assert.True(t, ok)
+			assert.Equal(t, eventBuilder.Object.Namespace, namespacedClient.Namespace())
+
+			names = append(names, eventBuilder.Object.Name)
+		}
+
+		assert.ElementsMatch(t, testCase.expectedNames, names)
+	}
+}
+
 func buildValidTestBuilder() *Builder {
 	return &Builder{
 		apiClient: k8sfake.NewSimpleClientset().CoreV1().Events("test-namespace"),
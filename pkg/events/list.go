@@ -2,11 +2,13 @@ package events
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // List returns Events inventory in the given namespace.
@@ -34,22 +36,76 @@ func List(
 
 	klog.V(100).Infof("%v", logMessage)
 
-	eventList, err := apiClient.Events(nsname).List(logging.DiscardContext(), passedOptions)
-	if err != nil {
-		klog.V(100).Infof("Failed to list Events in the namespace %s due to %s", nsname, err.Error())
+	var eventObjects []*Builder
 
-		return nil, err
-	}
+	for eventBuilder, err := range listEventPages(apiClient, nsname, passedOptions, 0) {
+		if err != nil {
+			klog.V(100).Infof("Failed to list Events in the namespace %s due to %s", nsname, err.Error())
 
-	var eventObjects []*Builder
+			return nil, err
+		}
 
-	for _, event := range eventList.Items {
-		copiedEvent := event
-		stateBuilder := &Builder{
-			apiClient: apiClient.Events(nsname),
-			Object:    &copiedEvent}
-		eventObjects = append(eventObjects, stateBuilder)
+		eventObjects = append(eventObjects, eventBuilder)
 	}
 
 	return eventObjects, nil
 }
+
+// ListSeq returns an iterator over the Events matching the options, in all namespaces unless the options include
+// runtimeclient.InNamespace. Typed selectors, such as clients.MatchingInvolvedObject, may be used in the options.
+// Events are requested a page at a time, as described in clients.ListPages, so large numbers of Events can be
+// processed without holding them all in memory. The page size may be set using clients.WithPageSize, while a Limit
+// caps the total number of Events. If an error occurs, it is yielded with a nil builder and iteration stops.
+func ListSeq(apiClient *clients.Settings, options ...runtimeclient.ListOption) iter.Seq2[*Builder, error] {
+	return func(yield func(*Builder, error) bool) {
+		if apiClient == nil {
+			klog.V(100).Info("Events 'apiClient' can not be empty")
+
+			yield(nil, fmt.Errorf("failed to list Events, 'apiClient' parameter is empty"))
+
+			return
+		}
+
+		listOptions := (&runtimeclient.ListOptions{}).ApplyOptions(options)
+
+		klog.V(100).Infof("Iterating over Events in the namespace %q with the options %v",
+			listOptions.Namespace, *listOptions.AsListOptions())
+
+		pages := listEventPages(
+			apiClient, listOptions.Namespace, *listOptions.AsListOptions(), clients.PageSizeFrom(options...))
+
+		for eventBuilder, err := range pages {
+			if !yield(eventBuilder, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// listEventPages returns an iterator over builders for the Events in nsname, or in all namespaces if nsname is empty,
+// requesting pageSize Events at a time. It does not perform validation.
+func listEventPages(
+	apiClient *clients.Settings, nsname string, options metaV1.ListOptions, pageSize int64) iter.Seq2[*Builder, error] {
+	return func(yield func(*Builder, error) bool) {
+		pages := clients.ListPages(logging.DiscardContext(), options, pageSize, apiClient.Events(nsname).List)
+
+		for eventList, err := range pages {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			for index := range eventList.Items {
+				eventBuilder := &Builder{
+					apiClient: apiClient.Events(eventList.Items[index].Namespace),
+					Object:    &eventList.Items[index],
+				}
+
+				if !yield(eventBuilder, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -45,7 +45,7 @@ func ListClusterDeploymentsInAllNamespaces(
 
 	clusterDeployments := new(hiveV1.ClusterDeploymentList)
 
-	err = apiClient.List(logging.DiscardContext(), clusterDeployments, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, clusterDeployments, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all clusterDeployments due to %s", err.Error())
 
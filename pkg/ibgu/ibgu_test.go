@@ -646,6 +646,34 @@ func TestIbguWaitUntilComplete(t *testing.T) {
 	}
 }
 
+func TestIbguWaitStatusTransitions(t *testing.T) {
+	conditionProgressing := metav1.Condition{Type: "Progressing", Status: metav1.ConditionTrue, Reason: "InProgress"}
+
+	// Each wait checks that the IBGU exists and then reads it once right away, so every transition happens on the
+	// first poll of the wait that expects it.
+	testSettings, err := clients.NewTestClientBuilder().
+		WithObjects(generateIbgu()).
+		WithSchemeAttachers(testSchemes...).
+		WithStatusTransitions(&v1alpha1.ImageBasedGroupUpgrade{},
+			clients.AfterReads(1, func(ibgu *v1alpha1.ImageBasedGroupUpgrade) {
+				ibgu.Status.Conditions = []metav1.Condition{conditionProgressing}
+			}),
+			clients.AfterReads(3, func(ibgu *v1alpha1.ImageBasedGroupUpgrade) {
+				ibgu.Status.Conditions = []metav1.Condition{conditionComplete}
+			})).
+		Build()
+	assert.Nil(t, err)
+
+	ibguBuilder := generateValidIbguBuilder(testSettings)
+
+	_, err = ibguBuilder.WaitForCondition(conditionProgressing, 30*time.Second)
+	assert.Nil(t, err)
+
+	_, err = ibguBuilder.WaitUntilComplete(30 * time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, []metav1.Condition{conditionComplete}, ibguBuilder.Object.Status.Conditions)
+}
+
 func TestIbguWithAutoRollbackOnFailure(t *testing.T) {
 	testCases := []struct {
 		initMonitorTimeout int
@@ -46,7 +46,7 @@ func ListImageDigestMirrorSets(
 
 	imageDigestMirrorSets := new(configv1.ImageDigestMirrorSetList)
 
-	err := apiClient.List(logging.DiscardContext(), imageDigestMirrorSets, &passedOptions)
+	err := clients.ListInto(logging.DiscardContext(), apiClient, imageDigestMirrorSets, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all imageDigestMirrorSets due to %s", err.Error())
 
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultBulkConcurrency is the number of builders operated on at once by the bulk functions, such as [CreateAll], when
+// the concurrency provided is not positive.
+const DefaultBulkConcurrency = 10
+
+// BulkResult is the result of a bulk operation for a single builder. The bulk functions return a result for each
+// builder, in the same order as the builders, along with an error that joins the errors from all of the results, so it
+// is nil only if the operation succeeded for every builder. Since the errors from the common functions include the
+// kind, name, and namespace of the resource, the joined error identifies which ones failed.
+//
+// The operation is performed on every builder even if it fails for some of them. Builders whose operation has not
+// started when the context is done are skipped and have the context's error as their result.
+//
+// The bulk functions in this package require builders which implement [Builder]. The public bulk package provides the
+// same functions for the builders in the other packages, using their Create, Delete, and Exists methods.
+type BulkResult[SB any] struct {
+	// Builder is the builder the operation was performed on.
+	Builder SB
+	// Err is the error from the operation, or nil if it succeeded for this builder.
+	Err error
+}
+
+// CreateAll creates the resources for all of the builders, with at most concurrency creates in progress at once. See
+// [BulkResult] for how the results and errors are returned. As with [Create], resources which already exist are not
+// treated as errors.
+func CreateAll[O any, SO ObjectPointer[O], SB Builder[O, SO]](
+	ctx context.Context, builders []SB, concurrency int) ([]BulkResult[SB], error) {
+	return RunBulk(ctx, builders, concurrency, func(ctx context.Context, builder SB) error {
+		return Create(ctx, builder)
+	})
+}
+
+// DeleteAllAndWait deletes the resources for all of the builders and waits up to timeout for each of them to be removed
+// from the cluster, with at most concurrency builders being deleted or waited on at once. See [BulkResult] for how the
+// results and errors are returned. Resources which do not exist are not treated as errors.
+func DeleteAllAndWait[O any, SO ObjectPointer[O], SB Builder[O, SO]](
+	ctx context.Context, builders []SB, concurrency int, timeout time.Duration) ([]BulkResult[SB], error) {
+	return RunBulk(ctx, builders, concurrency, func(ctx context.Context, builder SB) error {
+		err := Delete(ctx, builder)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return WaitFor(ctx, builder, IsDeleted[O, SO]())
+	})
+}
+
+// WaitAllReady waits up to timeout for the ready predicate to match the resource of each of the builders, with at most
+// concurrency builders being waited on at once. Since what it means to be ready differs between resources, the
+// predicate is provided by the caller, for example using [HasCondition]. See [BulkResult] for how the results and
+// errors are returned. Errors for resources which did not become ready are wait timeouts, as described in [WaitFor].
+func WaitAllReady[O any, SO ObjectPointer[O], SB Builder[O, SO]](
+	ctx context.Context,
+	builders []SB,
+	concurrency int,
+	timeout time.Duration,
+	ready Predicate[O, SO]) ([]BulkResult[SB], error) {
+	return RunBulk(ctx, builders, concurrency, func(ctx context.Context, builder SB) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return WaitFor(ctx, builder, ready)
+	})
+}
+
+// RunBulk runs the operation for each of the builders, with at most concurrency operations running at once, or
+// [DefaultBulkConcurrency] if concurrency is not positive, and returns the results as described in [BulkResult]. It
+// does not require the builders to implement [Builder], so it may also be used for the builders in other packages.
+func RunBulk[SB any](
+	ctx context.Context,
+	builders []SB,
+	concurrency int,
+	operation func(ctx context.Context, builder SB) error) ([]BulkResult[SB], error) {
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	var (
+		results   = make([]BulkResult[SB], len(builders))
+		semaphore = make(chan struct{}, concurrency)
+		waitGroup sync.WaitGroup
+	)
+
+	for index, builder := range builders {
+		results[index].Builder = builder
+
+		if !acquire(ctx, semaphore) {
+			results[index].Err = ctx.Err()
+
+			continue
+		}
+
+		waitGroup.Go(func() {
+			defer func() { <-semaphore }()
+
+			results[index].Err = operation(ctx, builder)
+		})
+	}
+
+	waitGroup.Wait()
+
+	var errs []error
+
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// acquire blocks until there is room in the semaphore or the context is done. It returns false if the context is done.
+func acquire(ctx context.Context, semaphore chan struct{}) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,214 @@
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	bulkTestNamespace = "bulk-test-namespace"
+	bulkTestFailName  = "bulk-test-fail"
+)
+
+func TestCreateAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		concurrency      int
+		failName         string
+		expectedInFlight int
+	}{
+		{
+			name:             "bounded concurrency",
+			concurrency:      2,
+			expectedInFlight: 2,
+		},
+		{
+			name:             "default concurrency",
+			concurrency:      0,
+			expectedInFlight: 6,
+		},
+		{
+			name:             "failures are aggregated",
+			concurrency:      2,
+			failName:         bulkTestFailName,
+			expectedInFlight: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mutex       sync.Mutex
+				inFlight    int
+				maxInFlight int
+			)
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+				InterceptorFuncs: interceptor.Funcs{Create: func(
+					ctx context.Context, client runtimeclient.WithWatch, object runtimeclient.Object,
+					opts ...runtimeclient.CreateOption) error {
+					mutex.Lock()
+					inFlight++
+					maxInFlight = max(maxInFlight, inFlight)
+					mutex.Unlock()
+
+					// Hold each create long enough for the others to start if the concurrency allows it.
+					time.Sleep(100 * time.Millisecond)
+
+					mutex.Lock()
+					inFlight--
+					mutex.Unlock()
+
+					if object.GetName() == testCase.failName {
+						return fmt.Errorf("test create failure")
+					}
+
+					return client.Create(ctx, object, opts...)
+				}},
+			})
+
+			names := []string{"bulk-test-0", "bulk-test-1", "bulk-test-2", "bulk-test-3", "bulk-test-4", "bulk-test-5"}
+			if testCase.failName != "" {
+				names[3] = testCase.failName
+			}
+
+			builders := buildBulkTestBuilders(testSettings, names)
+
+			results, err := common.CreateAll(t.Context(), builders, testCase.concurrency)
+			require.Len(t, results, len(builders))
+			assert.Equal(t, testCase.expectedInFlight, maxInFlight)
+
+			for index, result := range results {
+				assert.Same(t, builders[index], result.Builder)
+
+				if names[index] == testCase.failName {
+					assert.True(t, commonerrors.IsAPICallFailedWithVerb(result.Err, "create"))
+
+					continue
+				}
+
+				assert.NoError(t, result.Err)
+				assert.True(t, common.Exists(t.Context(), result.Builder))
+			}
+
+			if testCase.failName == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.True(t, commonerrors.IsAPICallFailed(err))
+			assert.ErrorContains(t, err, testCase.failName)
+		})
+	}
+}
+
+func TestDeleteAllAndWait(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"bulk-test-0", "bulk-test-1", "bulk-test-2"}
+
+	var objects []runtime.Object
+
+	// The last builder has no resource on the cluster, which should not be treated as an error.
+	for _, name := range names[:2] {
+		objects = append(objects, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bulkTestNamespace}})
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builders := buildBulkTestBuilders(testSettings, names)
+
+	results, err := common.DeleteAllAndWait(t.Context(), builders, 2, time.Second)
+	assert.NoError(t, err)
+	require.Len(t, results, len(builders))
+
+	for _, result := range results {
+		assert.NoError(t, result.Err)
+		assert.False(t, common.Exists(t.Context(), result.Builder))
+		assert.Nil(t, result.Builder.GetObject())
+	}
+}
+
+func TestWaitAllReady(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "bulk-test-ready", Namespace: bulkTestNamespace},
+				Data:       map[string]string{"ready": "true"},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "bulk-test-not-ready", Namespace: bulkTestNamespace},
+			},
+		},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builders := buildBulkTestBuilders(testSettings, []string{"bulk-test-ready", "bulk-test-not-ready"})
+
+	results, err := common.WaitAllReady(t.Context(), builders, 0, 300*time.Millisecond,
+		common.JSONPathEquals[corev1.ConfigMap]("{.data.ready}", "true"))
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.True(t, commonerrors.IsWaitTimeout(results[1].Err))
+	assert.True(t, commonerrors.IsWaitTimeout(err))
+	assert.ErrorContains(t, err, "bulk-test-not-ready")
+}
+
+func TestBulkCancelledContext(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builders := buildBulkTestBuilders(testSettings, []string{"bulk-test-0", "bulk-test-1"})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	results, err := common.CreateAll(ctx, builders, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, results, 2)
+
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+		assert.False(t, common.Exists(t.Context(), result.Builder))
+	}
+}
+
+// buildBulkTestBuilders returns a ConfigMap builder in the bulk test namespace for each of the names.
+func buildBulkTestBuilders(apiClient *clients.Settings, names []string) []*mockNamespacedBuilder {
+	builders := make([]*mockNamespacedBuilder, 0, len(names))
+
+	for _, name := range names {
+		builders = append(builders, common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+			apiClient, testSchemeAttacher, name, bulkTestNamespace))
+	}
+
+	return builders
+}
@@ -3,15 +3,20 @@ package common
 import (
 	"context"
 	"fmt"
+	"iter"
 	"reflect"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -118,11 +123,12 @@ func NewClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B,
 	builder.GetDefinition().SetName(name)
 
 	resourceKey := NewResourceKeyFromBuilder(builder)
+	logger := newResourceLogger(logging.FromClient(apiClient), resourceKey)
 
-	klog.V(100).Infof("Initializing new builder for %s", resourceKey.String())
+	logger.V(logging.LevelDebug).Info("Initializing new builder")
 
 	if isInterfaceNil(apiClient) {
-		klog.V(100).Infof("The apiClient provided for %s is nil", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The apiClient provided is nil")
 
 		builder.SetError(errors.NewAPIClientNil(resourceKey))
 
@@ -131,7 +137,7 @@ func NewClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B,
 
 	err := schemeAttacher(apiClient.Scheme())
 	if err != nil {
-		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to attach scheme", "error", err)
 
 		builder.SetError(errors.NewSchemeAttacherFailed(resourceKey, err))
 
@@ -139,7 +145,7 @@ func NewClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B,
 	}
 
 	if name == "" {
-		klog.V(100).Infof("The name of the builder for %s is empty", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The name of the builder is empty")
 
 		builder.SetError(errors.NewBuilderFieldEmpty(resourceKey, errors.BuilderFieldName))
 
@@ -167,11 +173,12 @@ func NewNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O,
 	builder.GetDefinition().SetNamespace(nsname)
 
 	resourceKey := NewResourceKeyFromBuilder(builder)
+	logger := newResourceLogger(logging.FromClient(apiClient), resourceKey)
 
-	klog.V(100).Infof("Initializing new builder for %s", resourceKey.String())
+	logger.V(logging.LevelDebug).Info("Initializing new builder")
 
 	if isInterfaceNil(apiClient) {
-		klog.V(100).Infof("The apiClient provided for %s is nil", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The apiClient provided is nil")
 
 		builder.SetError(errors.NewAPIClientNil(resourceKey))
 
@@ -180,7 +187,7 @@ func NewNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O,
 
 	err := schemeAttacher(apiClient.Scheme())
 	if err != nil {
-		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to attach scheme", "error", err)
 
 		builder.SetError(errors.NewSchemeAttacherFailed(resourceKey, err))
 
@@ -188,7 +195,7 @@ func NewNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O,
 	}
 
 	if name == "" {
-		klog.V(100).Infof("The name of the builder for %s is empty", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The name of the builder is empty")
 
 		builder.SetError(errors.NewBuilderFieldEmpty(resourceKey, errors.BuilderFieldName))
 
@@ -196,7 +203,7 @@ func NewNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O,
 	}
 
 	if nsname == "" {
-		klog.V(100).Infof("The namespace of the builder for %s is empty", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The namespace of the builder is empty")
 
 		builder.SetError(errors.NewBuilderFieldEmpty(resourceKey, errors.BuilderFieldNamespace))
 
@@ -223,37 +230,38 @@ func PullClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B
 	builder.GetDefinition().SetName(name)
 
 	resourceKey := NewResourceKeyFromBuilder(builder)
+	logger := newResourceLogger(logging.FromContext(ctx, apiClient), resourceKey)
 
-	klog.V(100).Infof("Pulling builder for %s", resourceKey.String())
+	logger.V(logging.LevelDebug).Info("Pulling builder")
 
 	if isInterfaceNil(apiClient) {
-		klog.V(100).Infof("The apiClient provided for %s is nil", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The apiClient provided is nil")
 
 		return nil, errors.NewAPIClientNil(resourceKey)
 	}
 
 	err := schemeAttacher(apiClient.Scheme())
 	if err != nil {
-		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to attach scheme", "error", err)
 
 		return nil, errors.NewSchemeAttacherFailed(resourceKey, err)
 	}
 
 	if name == "" {
-		klog.V(100).Infof("The name of the builder for %s is empty", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The name of the builder is empty")
 
 		return nil, errors.NewBuilderFieldEmpty(resourceKey, errors.BuilderFieldName)
 	}
 
 	object, err := Get(ctx, builder)
 	if err != nil {
-		klog.V(100).Infof("Failed to pull the builder for %s: %v", resourceKey.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to pull the builder", "error", err)
 
 		return nil, fmt.Errorf("failed to pull builder: %w", err)
 	}
 
 	builder.SetObject(object)
-	builder.SetDefinition(object)
+	builder.SetDefinition(deepCopy(object))
 
 	return builder, nil
 }
@@ -276,43 +284,44 @@ func PullNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O
 	builder.GetDefinition().SetNamespace(nsname)
 
 	resourceKey := NewResourceKeyFromBuilder(builder)
+	logger := newResourceLogger(logging.FromContext(ctx, apiClient), resourceKey)
 
-	klog.V(100).Infof("Pulling builder for %s", resourceKey.String())
+	logger.V(logging.LevelDebug).Info("Pulling builder")
 
 	if isInterfaceNil(apiClient) {
-		klog.V(100).Infof("The apiClient provided for %s is nil", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The apiClient provided is nil")
 
 		return nil, errors.NewAPIClientNil(resourceKey)
 	}
 
 	err := schemeAttacher(apiClient.Scheme())
 	if err != nil {
-		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to attach scheme", "error", err)
 
 		return nil, errors.NewSchemeAttacherFailed(resourceKey, err)
 	}
 
 	if name == "" {
-		klog.V(100).Infof("The name of the builder for %s is empty", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The name of the builder is empty")
 
 		return nil, errors.NewBuilderFieldEmpty(resourceKey, errors.BuilderFieldName)
 	}
 
 	if nsname == "" {
-		klog.V(100).Infof("The namespace of the builder for %s is empty", resourceKey.String())
+		logger.V(logging.LevelDebug).Info("The namespace of the builder is empty")
 
 		return nil, errors.NewBuilderFieldEmpty(resourceKey, errors.BuilderFieldNamespace)
 	}
 
 	object, err := Get(ctx, builder)
 	if err != nil {
-		klog.V(100).Infof("Failed to pull the builder for %s: %v", resourceKey.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to pull the builder", "error", err)
 
 		return nil, fmt.Errorf("failed to pull builder: %w", err)
 	}
 
 	builder.SetObject(object)
-	builder.SetDefinition(object)
+	builder.SetDefinition(deepCopy(object))
 
 	return builder, nil
 }
@@ -323,15 +332,33 @@ func Get[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO]
 		return nil, err
 	}
 
-	key := NewResourceKeyFromBuilder(builder)
+	logger := newOperationLogger(ctx, builder, "get")
+
+	logger.V(logging.LevelDebug).Info("Getting resource")
+
+	var object SO
+
+	err := retryAPICall(ctx, builder, "get", logger, func(ctx context.Context) error {
+		var err error
+
+		object, err = getObject(ctx, builder)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	klog.V(100).Infof("Getting %s", key.String())
+	return object, nil
+}
 
+// getObject gets the resource described by the builder in a single API call, wrapping any error.
+func getObject[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO]) (SO, error) {
 	var object SO = new(O)
 
 	err := builder.GetClient().Get(ctx, runtimeclient.ObjectKeyFromObject(builder.GetDefinition()), object)
 	if err != nil {
-		return nil, errors.NewAPICallFailed("get", key, err)
+		return nil, errors.NewAPICallFailed("get", NewResourceKeyFromBuilder(builder), err)
 	}
 
 	return object, nil
@@ -345,13 +372,13 @@ func Exists[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 		return false
 	}
 
-	key := NewResourceKeyFromBuilder(builder)
+	logger := newOperationLogger(ctx, builder, "exists")
 
-	klog.V(100).Infof("Checking if %s exists", key.String())
+	logger.V(logging.LevelDebug).Info("Checking if resource exists")
 
 	object, err := Get(ctx, builder)
 	if err != nil {
-		klog.V(100).Infof("Failed to get %s: %v", key.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to get resource", "error", err)
 
 		return false
 	}
@@ -371,16 +398,20 @@ func Delete[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 
 	key := NewResourceKeyFromBuilder(builder)
 
-	klog.V(100).Infof("Deleting %s", key.String())
+	logger := newOperationLogger(ctx, builder, "delete")
+
+	logger.V(logging.LevelDebug).Info("Deleting resource")
 
-	err := builder.GetClient().Delete(ctx, builder.GetDefinition())
+	err := retryAPICall(ctx, builder, "delete", logger, func(ctx context.Context) error {
+		return builder.GetClient().Delete(ctx, builder.GetDefinition())
+	})
 	if err == nil || k8serrors.IsNotFound(err) {
 		builder.SetObject(nil)
 
 		return nil
 	}
 
-	klog.V(100).Infof("Failed to delete %s: %v", key.String(), err)
+	logger.V(logging.LevelDebug).Info("Failed to delete resource", "error", err)
 
 	return errors.NewAPICallFailed("delete", key, err)
 }
@@ -400,40 +431,53 @@ func Update[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 
 	key := NewResourceKeyFromBuilder(builder)
 
-	klog.V(100).Infof("Updating %s with force %t", key.String(), force)
+	logger := newOperationLogger(ctx, builder, "update")
 
-	latestObject, err := Get(ctx, builder)
-	if err != nil {
-		klog.V(100).Infof("Failed to get latest object for %s: %v", key.String(), err)
+	logger.V(logging.LevelDebug).Info("Updating resource", "force", force)
 
-		return fmt.Errorf("failed get latest object for update: %w", err)
-	}
+	// The latest resource version is fetched before every attempt so conflicts can be retried.
+	var getErr error
 
-	builder.GetDefinition().SetResourceVersion(latestObject.GetResourceVersion())
+	err := retryAPICallRetryingConflicts(ctx, builder, "update", logger, func(ctx context.Context) error {
+		var latestObject SO
+
+		latestObject, getErr = getObject(ctx, builder)
+		if getErr != nil {
+			return getErr
+		}
+
+		builder.GetDefinition().SetResourceVersion(latestObject.GetResourceVersion())
+
+		return builder.GetClient().Update(ctx, builder.GetDefinition())
+	})
+	if getErr != nil {
+		logger.V(logging.LevelDebug).Info("Failed to get latest object", "error", getErr)
+
+		return fmt.Errorf("failed get latest object for update: %w", getErr)
+	}
 
-	err = builder.GetClient().Update(ctx, builder.GetDefinition())
 	if err == nil {
-		builder.SetObject(builder.GetDefinition())
+		builder.SetObject(deepCopy(builder.GetDefinition()))
 
 		return nil
 	}
 
 	if !force {
-		klog.V(100).Infof("Failed to update %s without force: %v", key.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to update resource without force", "error", err)
 
 		return errors.NewAPICallFailed("update", key, err)
 	}
 
 	err = Delete(ctx, builder)
 	if err != nil {
-		klog.V(100).Infof("Failed to delete %s during force update: %v", key.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to delete resource during force update", "error", err)
 
 		return fmt.Errorf("failed to force update: %w", err)
 	}
 
 	err = Create(ctx, builder)
 	if err != nil {
-		klog.V(100).Infof("Failed to create %s during force update: %v", key.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to create resource during force update", "error", err)
 
 		return fmt.Errorf("failed to force update: %w", err)
 	}
@@ -441,6 +485,64 @@ func Update[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 	return nil
 }
 
+// DefaultFieldManager is the field manager used for server-side apply when no field manager is provided.
+const DefaultFieldManager = "eco-goinfra"
+
+// Apply reconciles the resource on the cluster with the builder's definition using server-side apply. The resource is
+// created if it does not exist. Only the fields set in the definition are sent, and ownership of those fields is
+// recorded under fieldManager, defaulting to [DefaultFieldManager] when empty. If successful, the builder's definition
+// and object are updated with the result returned from the cluster.
+//
+// If another field manager owns any of the applied fields, the API server rejects the request and this returns an
+// error satisfying [errors.IsApplyConflict]. Setting force to true instead takes ownership of the conflicting fields.
+// Unlike the force flag for [Update], this never deletes the resource.
+func Apply[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO], fieldManager string, force bool) error {
+	if err := Validate(builder); err != nil {
+		return err
+	}
+
+	if fieldManager == "" {
+		fieldManager = DefaultFieldManager
+	}
+
+	key := NewResourceKeyFromBuilder(builder)
+
+	logger := newOperationLogger(ctx, builder, "apply")
+
+	logger.V(logging.LevelDebug).Info("Applying resource", "fieldManager", fieldManager, "force", force)
+
+	// Apply requests must include the apiVersion and kind, and must not include managedFields. The resource version
+	// is also cleared so the apply is not rejected for being stale.
+	definition := builder.GetDefinition()
+	definition.GetObjectKind().SetGroupVersionKind(builder.GetGVK())
+	definition.SetManagedFields(nil)
+	definition.SetResourceVersion("")
+
+	options := []runtimeclient.PatchOption{runtimeclient.FieldOwner(fieldManager)}
+	if force {
+		options = append(options, runtimeclient.ForceOwnership)
+	}
+
+	err := retryAPICall(ctx, builder, "apply", logger, func(ctx context.Context) error {
+		return builder.GetClient().Patch(ctx, definition, runtimeclient.Apply, options...)
+	})
+	if err == nil {
+		builder.SetObject(deepCopy(definition))
+
+		return nil
+	}
+
+	if k8serrors.IsConflict(err) {
+		logger.V(logging.LevelDebug).Info("Field manager conflicts applying resource", "error", err)
+
+		return errors.NewApplyConflict(key, fieldManager, err)
+	}
+
+	logger.V(logging.LevelDebug).Info("Failed to apply resource", "error", err)
+
+	return errors.NewAPICallFailed("apply", key, err)
+}
+
 // Create creates the definition on the cluster. If the resource already exists, this is a no-op.
 func Create[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO]) error {
 	if err := Validate(builder); err != nil {
@@ -449,25 +551,29 @@ func Create[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 
 	key := NewResourceKeyFromBuilder(builder)
 
-	klog.V(100).Infof("Creating %s", key.String())
+	logger := newOperationLogger(ctx, builder, "create")
+
+	logger.V(logging.LevelDebug).Info("Creating resource")
 
 	// Create requests will be rejected if the resource version is set, so we clear it.
 	builder.GetDefinition().SetResourceVersion("")
 
-	err := builder.GetClient().Create(ctx, builder.GetDefinition())
+	err := retryAPICall(ctx, builder, "create", logger, func(ctx context.Context) error {
+		return builder.GetClient().Create(ctx, builder.GetDefinition())
+	})
 	if err == nil {
-		builder.SetObject(builder.GetDefinition())
+		builder.SetObject(deepCopy(builder.GetDefinition()))
 
 		return nil
 	}
 
 	if k8serrors.IsAlreadyExists(err) {
-		klog.V(100).Infof("The resource %s already exists and cannot be created", key.String())
+		logger.V(logging.LevelDebug).Info("The resource already exists and cannot be created")
 
 		return nil
 	}
 
-	klog.V(100).Infof("Failed to create %s: %v", key.String(), err)
+	logger.V(logging.LevelDebug).Info("Failed to create resource", "error", err)
 
 	return errors.NewAPICallFailed("create", key, err)
 }
@@ -477,28 +583,31 @@ func Create[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 // concrete type is not nil.
 func Validate[O any, SO ObjectPointer[O]](builder Builder[O, SO]) error {
 	if isInterfaceNil(builder) {
-		klog.V(100).Infof("The builder is nil")
+		logging.FromClient(nil).V(logging.LevelDebug).Info("The builder is nil")
 
 		return errors.NewBuilderNil()
 	}
 
+	logger := logging.FromClient(builder.GetClient())
+
 	if builder.GetDefinition() == nil {
-		klog.V(100).Infof("The %s builder definition is nil", builder.GetGVK().Kind)
+		logger.V(logging.LevelDebug).Info("The builder definition is nil", "kind", builder.GetGVK().Kind)
 
 		return errors.NewBuilderDefinitionNil(builder.GetGVK().Kind)
 	}
 
 	key := NewResourceKeyFromBuilder(builder)
+	logger = newResourceLogger(logger, key)
 
 	if isInterfaceNil(builder.GetClient()) {
-		klog.V(100).Infof("The apiClient provided for %s is nil", key.String())
+		logger.V(logging.LevelDebug).Info("The apiClient provided is nil")
 
 		return errors.NewAPIClientNil(key)
 	}
 
 	err := builder.GetError()
 	if err != nil {
-		klog.V(100).Infof("The builder for %s has an error: %v", key.String(), err)
+		logger.V(logging.LevelDebug).Info("The builder has an error", "error", err)
 
 		return fmt.Errorf("failed to validate: %w", err)
 	}
@@ -516,51 +625,147 @@ type ListPointer[L any] interface {
 	runtimeclient.ObjectList
 }
 
-// List lists the resources in the cluster and returns a list of builders for each resource.
+// List lists the resources in the cluster and returns a list of builders for each resource. The resources are listed a
+// page at a time, as described in [ListSeq], and the builders for every page are returned together.
 func List[O, L, B any, SO ObjectPointer[O], SL ListPointer[L], SB BuilderPointer[B, O, SO]](
 	ctx context.Context,
 	apiClient runtimeclient.Client,
 	schemeAttacher clients.SchemeAttacher,
 	options ...runtimeclient.ListOption) ([]SB, error) {
-	var dummyBuilder SB = new(B)
-
-	resourceKey := key.NewResourceKey(dummyBuilder.GetGVK().Kind, "", "")
+	var builders []SB
 
-	if isInterfaceNil(apiClient) {
-		klog.V(100).Infof("The apiClient provided for listing %s is nil", resourceKey.String())
+	for builder, err := range ListSeq[O, L, B, SO, SL, SB](ctx, apiClient, schemeAttacher, options...) {
+		if err != nil {
+			return nil, err
+		}
 
-		return nil, errors.NewAPIClientNil(resourceKey)
+		builders = append(builders, builder)
 	}
 
-	err := schemeAttacher(apiClient.Scheme())
-	if err != nil {
-		klog.V(100).Infof("Failed to attach scheme for listing %s: %v", resourceKey.String(), err)
+	return builders, nil
+}
 
-		return nil, errors.NewSchemeAttacherFailed(resourceKey, err)
-	}
+// ListSeq returns an iterator over builders for the resources in the cluster. The resources are requested a page at a
+// time, using the [clients.PageSize] from the options or [clients.DefaultListPageSize] if there is none, and the next
+// page is only requested once every builder for the current page has been yielded. A Limit in the options caps the
+// total number of builders across all pages. This lets callers process very large lists
+// without holding them in memory or stop early without requesting the remaining pages. If an error occurs, it is
+// yielded with a nil builder and iteration stops.
+func ListSeq[O, L, B any, SO ObjectPointer[O], SL ListPointer[L], SB BuilderPointer[B, O, SO]](
+	ctx context.Context,
+	apiClient runtimeclient.Client,
+	schemeAttacher clients.SchemeAttacher,
+	options ...runtimeclient.ListOption) iter.Seq2[SB, error] {
+	return func(yield func(SB, error) bool) {
+		var dummyBuilder SB = new(B)
 
-	var list SL = new(L)
+		resourceKey := key.NewResourceKey(dummyBuilder.GetGVK().Kind, "", "")
+		logger := newResourceLogger(logging.FromContext(ctx, apiClient), resourceKey).WithValues("operation", "list")
 
-	err = apiClient.List(ctx, list, options...)
-	if err != nil {
-		klog.V(100).Infof("Failed to list %s: %v", resourceKey.String(), err)
+		if isInterfaceNil(apiClient) {
+			logger.V(logging.LevelDebug).Info("The apiClient provided is nil")
+
+			yield(nil, errors.NewAPIClientNil(resourceKey))
+
+			return
+		}
+
+		err := schemeAttacher(apiClient.Scheme())
+		if err != nil {
+			logger.V(logging.LevelDebug).Info("Failed to attach scheme", "error", err)
 
-		return nil, errors.NewAPICallFailed("list", resourceKey, err)
+			yield(nil, errors.NewSchemeAttacherFailed(resourceKey, err))
+
+			return
+		}
+
+		listOptions := (&runtimeclient.ListOptions{}).ApplyOptions(options)
+		pageSize := clients.PageSizeFrom(options...)
+		limit := listOptions.Limit
+		listed := int64(0)
+
+		for {
+			var list SL = new(L)
+
+			listOptions.Limit = clients.PageLimit(limit, pageSize, listed)
+
+			err = listPage(ctx, apiClient, dummyBuilder.GetGVK(), logger, list, listOptions)
+			if err != nil {
+				yield(nil, errors.NewAPICallFailed("list", resourceKey, err))
+
+				return
+			}
+
+			count, err := clients.TruncateList(list, limit, listed)
+			if err != nil {
+				logger.V(logging.LevelDebug).Info("Failed to truncate list", "error", err)
+
+				yield(nil, err)
+
+				return
+			}
+
+			builders, err := buildersFromList[O, B, SO, SB](apiClient, resourceKey, logger, list)
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			for _, builder := range builders {
+				if !yield(builder, nil) {
+					return
+				}
+			}
+
+			listed += count
+			listOptions.Continue = list.GetContinue()
+
+			if listOptions.Continue == "" || (limit > 0 && listed >= limit) {
+				return
+			}
+		}
 	}
+}
 
+// listPage lists a single page of resources into list, logging, tracing, and recording metrics for the call.
+func listPage(
+	ctx context.Context,
+	apiClient runtimeclient.Client,
+	gvk schema.GroupVersionKind,
+	logger logr.Logger,
+	list runtimeclient.ObjectList,
+	listOptions *runtimeclient.ListOptions) error {
+	ctx, span := startListSpan(ctx, apiClient, gvk, []runtimeclient.ListOption{listOptions})
+	start := time.Now()
+	err := apiClient.List(ctx, list, listOptions)
+
+	logAPICallResult(logger, start, err)
+	tracing.EndSpan(span, err)
+	clients.MetricsFrom(apiClient).ObserveOperation(gvk, "list", tracing.Outcome(err), time.Since(start))
+
+	return err
+}
+
+// buildersFromList returns a builder for each item in the list.
+func buildersFromList[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]](
+	apiClient runtimeclient.Client,
+	resourceKey key.ResourceKey,
+	logger logr.Logger,
+	list runtimeclient.ObjectList) ([]SB, error) {
 	items, err := meta.ExtractList(list)
 	if err != nil {
-		klog.V(100).Infof("Failed to extract list for %s: %v", resourceKey.String(), err)
+		logger.V(logging.LevelDebug).Info("Failed to extract list", "error", err)
 
 		return nil, fmt.Errorf("failed to extract list: %w", err)
 	}
 
-	var builders []SB
+	builders := make([]SB, 0, len(items))
 
 	for _, item := range items {
 		typedItem, ok := item.(SO)
 		if !ok {
-			klog.V(100).Infof("Item with type %T does not match expected type %s", item, resourceKey.String())
+			logger.V(logging.LevelDebug).Info("Item type does not match expected type", "type", reflect.TypeOf(item))
 
 			return nil, errors.NewItemTypeMismatch(resourceKey.Kind, reflect.TypeOf(item))
 		}
@@ -572,7 +777,7 @@ func List[O, L, B any, SO ObjectPointer[O], SL ListPointer[L], SB BuilderPointer
 		}
 
 		builder.SetDefinition(typedItem)
-		builder.SetObject(typedItem)
+		builder.SetObject(deepCopy(typedItem))
 		builder.SetClient(apiClient)
 		builder.SetGVK(builder.GetGVK())
 
@@ -582,6 +787,21 @@ func List[O, L, B any, SO ObjectPointer[O], SL ListPointer[L], SB BuilderPointer
 	return builders, nil
 }
 
+// startListSpan starts a span for listing resources of the provided GVK, with the GVK and the namespace from the options
+// as attributes. See [tracing.ProviderFromContext] for how the TracerProvider is chosen.
+func startListSpan(
+	ctx context.Context,
+	apiClient runtimeclient.Client,
+	gvk schema.GroupVersionKind,
+	options []runtimeclient.ListOption) (context.Context, trace.Span) {
+	listOptions := (&runtimeclient.ListOptions{}).ApplyOptions(options)
+	attributes := append(
+		tracing.ResourceAttributes(gvk, "", listOptions.Namespace), tracing.OperationKey.String("list"))
+
+	return tracing.ProviderFromContext(ctx, apiClient).Tracer(tracing.TracerName).Start(
+		ctx, "list "+gvk.Kind, trace.WithAttributes(attributes...))
+}
+
 // ConvertListOptionsToOptions converts a slice of runtimeclient.ListOptions to a slice of runtimeclient.ListOption.
 // This is to allow for compatibility with existing functions which used ListOptions instead of ListOption.
 func ConvertListOptionsToOptions(options []runtimeclient.ListOptions) []runtimeclient.ListOption {
@@ -594,8 +814,147 @@ func ConvertListOptionsToOptions(options []runtimeclient.ListOptions) []runtimec
 	return listOptions
 }
 
-// isInterfaceNil checks if the interface is nil. It checks both equality against nil and the reflect.Value.IsNil
-// method. This ensures that neither the interface nor its concrete value are nil.
+// isInterfaceNil checks if the interface is nil. It checks both equality against nil and, for kinds that can be nil,
+// the reflect.Value.IsNil method. This ensures that neither the interface nor its concrete value are nil.
 func isInterfaceNil(v any) bool {
-	return v == nil || reflect.ValueOf(v).IsNil()
+	if v == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(v)
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
+
+// deepCopy returns a deep copy of the object so that the builder's definition and object never share memory. Otherwise,
+// changes made to the definition through the builder modifiers would also change the object.
+func deepCopy[O any, SO ObjectPointer[O]](object SO) SO {
+	if object == nil {
+		return nil
+	}
+
+	copied, ok := object.DeepCopyObject().(SO)
+	if !ok {
+		return object
+	}
+
+	return copied
+}
+
+// retryAPICall calls apiCall according to the retry policy from the context or the builder's client. See
+// [clients.RetryPolicyFrom] for how the policy is chosen. The outcome and duration of the call, including any retries,
+// are logged using the logger, which is also added to the context so retries are logged with the same key/values. The
+// call is traced in a span for the operation, which is passed to apiCall in the context, and recorded in the metrics of
+// the builder's client.
+func retryAPICall[O any, SO ObjectPointer[O]](
+	ctx context.Context,
+	builder Builder[O, SO],
+	operation string,
+	logger logr.Logger,
+	apiCall func(ctx context.Context) error) error {
+	return observeAPICall(ctx, builder, operation, logger, apiCall, (*clients.RetryPolicy).Do)
+}
+
+// retryAPICallRetryingConflicts is the same as retryAPICall but also retries conflicts. The apiCall must fetch the
+// latest resource version before each attempt.
+func retryAPICallRetryingConflicts[O any, SO ObjectPointer[O]](
+	ctx context.Context,
+	builder Builder[O, SO],
+	operation string,
+	logger logr.Logger,
+	apiCall func(ctx context.Context) error) error {
+	return observeAPICall(ctx, builder, operation, logger, apiCall, (*clients.RetryPolicy).DoRetryingConflicts)
+}
+
+// observeAPICall implements retryAPICall and retryAPICallRetryingConflicts, calling apiCall using the provided method
+// of the retry policy.
+func observeAPICall[O any, SO ObjectPointer[O]](
+	ctx context.Context,
+	builder Builder[O, SO],
+	operation string,
+	logger logr.Logger,
+	apiCall func(ctx context.Context) error,
+	retry func(*clients.RetryPolicy, context.Context, func(ctx context.Context) error) error) error {
+	ctx, span := startOperationSpan(ctx, builder, operation)
+	start := time.Now()
+	attempts := 0
+
+	err := retry(clients.RetryPolicyFrom(ctx, builder.GetClient()), logr.NewContext(ctx, logger),
+		func(ctx context.Context) error {
+			attempts++
+
+			return apiCall(ctx)
+		})
+
+	logAPICallResult(logger, start, err)
+	tracing.EndSpan(span, err)
+
+	metrics := clients.MetricsFrom(builder.GetClient())
+	metrics.ObserveOperation(builder.GetGVK(), operation, tracing.Outcome(err), time.Since(start))
+	metrics.ObserveRetries(builder.GetGVK(), operation, attempts-1)
+
+	return err
+}
+
+// startOperationSpan starts a span for an operation on the builder's resource, named after the operation and kind, with
+// the GVK, name, and namespace of the resource and the operation as attributes. See [tracing.ProviderFromContext] for
+// how the TracerProvider is chosen. The builder must have already been validated.
+func startOperationSpan[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], operation string) (context.Context, trace.Span) {
+	gvk := builder.GetGVK()
+	attributes := append(
+		tracing.ResourceAttributes(gvk, builder.GetDefinition().GetName(), builder.GetDefinition().GetNamespace()),
+		tracing.OperationKey.String(operation))
+
+	return tracing.ProviderFromContext(ctx, builder.GetClient()).Tracer(tracing.TracerName).Start(
+		ctx, operation+" "+gvk.Kind, trace.WithAttributes(attributes...))
+}
+
+// newOperationLogger returns the logger for an operation on the builder's resource, with the kind, name, and namespace
+// of the resource and the operation as key/values. See [logging.FromContext] for how the logger is chosen. The builder
+// must have already been validated.
+func newOperationLogger[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], operation string) logr.Logger {
+	logger := logging.FromContext(ctx, builder.GetClient())
+
+	return newResourceLogger(logger, NewResourceKeyFromBuilder(builder)).WithValues("operation", operation)
+}
+
+// newResourceLogger returns a copy of the logger with the kind, name, and namespace of the resource as key/values. The
+// name and namespace are omitted when empty.
+func newResourceLogger(logger logr.Logger, resourceKey key.ResourceKey) logr.Logger {
+	keysAndValues := []any{"kind", resourceKey.Kind}
+
+	if resourceKey.Name != "" {
+		keysAndValues = append(keysAndValues, "name", resourceKey.Name)
+	}
+
+	if resourceKey.Namespace != "" {
+		keysAndValues = append(keysAndValues, "namespace", resourceKey.Namespace)
+	}
+
+	return logger.WithValues(keysAndValues...)
+}
+
+// logAPICallResult logs whether an API call succeeded and how long it took, including any retries. Since checking for a
+// resource which does not exist is routine, not found errors are only logged at LevelDebug.
+func logAPICallResult(logger logr.Logger, start time.Time, err error) {
+	if k8serrors.IsNotFound(err) {
+		logger.V(logging.LevelDebug).Info("Resource not found", "duration", time.Since(start))
+
+		return
+	}
+
+	if err != nil {
+		logger.V(logging.LevelOperation).Info("API call failed", "duration", time.Since(start), "error", err)
+
+		return
+	}
+
+	logger.V(logging.LevelOperation).Info("API call succeeded", "duration", time.Since(start))
 }
@@ -1,6 +1,9 @@
 package common_test
 
 import (
+	"context"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
@@ -8,8 +11,12 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 var (
@@ -94,6 +101,15 @@ func TestUpdate(t *testing.T) {
 	testhelper.NewGenericUpdateTestConfig(commonConfig, common.Update, true).ExecuteTests(t)
 }
 
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[corev1.ConfigMap, mockNamespacedBuilder](
+		testSchemeAttacher, namespacedGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewGenericApplyTestConfig(commonConfig, common.Apply).ExecuteTests(t)
+}
+
 func TestDelete(t *testing.T) {
 	t.Parallel()
 
@@ -112,6 +128,151 @@ func TestList(t *testing.T) {
 	testhelper.NewGenericListTestConfig(commonConfig, common.List[corev1.ConfigMap, corev1.ConfigMapList]).ExecuteTests(t)
 }
 
+func TestListSeq(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		options           []runtimeclient.ListOption
+		stopAfter         int
+		ignoreLimit       bool
+		listError         error
+		expectedLimit     int64
+		expectedListCalls int
+		expectedNames     []string
+		expectedError     string
+	}{
+		{
+			name:              "default page size",
+			expectedLimit:     clients.DefaultListPageSize,
+			expectedListCalls: 1,
+			expectedNames:     []string{"test-0", "test-1", "test-2", "test-3", "test-4"},
+		},
+		{
+			name:              "follows continue token",
+			options:           []runtimeclient.ListOption{clients.WithPageSize(2)},
+			expectedLimit:     2,
+			expectedListCalls: 3,
+			expectedNames:     []string{"test-0", "test-1", "test-2", "test-3", "test-4"},
+		},
+		{
+			name:              "stops early",
+			options:           []runtimeclient.ListOption{clients.WithPageSize(2)},
+			stopAfter:         2,
+			expectedLimit:     2,
+			expectedListCalls: 1,
+			expectedNames:     []string{"test-0", "test-1"},
+		},
+		{
+			name:              "limit caps total",
+			options:           []runtimeclient.ListOption{clients.WithPageSize(2), runtimeclient.Limit(3)},
+			expectedLimit:     1,
+			expectedListCalls: 2,
+			expectedNames:     []string{"test-0", "test-1", "test-2"},
+		},
+		{
+			name:              "server ignores limit",
+			options:           []runtimeclient.ListOption{clients.WithPageSize(2)},
+			ignoreLimit:       true,
+			expectedLimit:     2,
+			expectedListCalls: 1,
+			expectedNames:     []string{"test-0", "test-1", "test-2", "test-3", "test-4"},
+		},
+		{
+			name:              "server ignores limit with limit",
+			options:           []runtimeclient.ListOption{clients.WithPageSize(2), runtimeclient.Limit(3)},
+			ignoreLimit:       true,
+			expectedLimit:     2,
+			expectedListCalls: 1,
+			expectedNames:     []string{"test-0", "test-1", "test-2"},
+		},
+		{
+			name:              "limit without page size",
+			options:           []runtimeclient.ListOption{runtimeclient.Limit(3)},
+			expectedLimit:     3,
+			expectedListCalls: 1,
+			expectedNames:     []string{"test-0", "test-1", "test-2"},
+		},
+		{
+			name:              "list error",
+			listError:         fmt.Errorf("test error"),
+			expectedLimit:     clients.DefaultListPageSize,
+			expectedListCalls: 1,
+			expectedError:     "test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var objects []runtime.Object
+
+			for index := range 5 {
+				objects = append(objects, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("test-%d", index), Namespace: "test-namespace"}})
+			}
+
+			var (
+				listCalls int
+				limit     int64
+			)
+
+			testClient := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  objects,
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+				InterceptorFuncs: interceptor.Funcs{List: func(
+					ctx context.Context, client runtimeclient.WithWatch, list runtimeclient.ObjectList,
+					opts ...runtimeclient.ListOption) error {
+					listCalls++
+					limit = (&runtimeclient.ListOptions{}).ApplyOptions(opts).Limit
+
+					if testCase.listError != nil {
+						return testCase.listError
+					}
+
+					// The fake client ignores the Limit, so this returns every object with no continue token.
+					if testCase.ignoreLimit {
+						return client.List(ctx, list, opts...)
+					}
+
+					return listPage(ctx, client, list, opts...)
+				}},
+			})
+
+			var (
+				names []string
+				err   error
+			)
+
+			for builder, builderErr := range common.ListSeq[corev1.ConfigMap, corev1.ConfigMapList, mockNamespacedBuilder](
+				t.Context(), testClient, testSchemeAttacher, testCase.options...) {
+				if builderErr != nil {
+					err = builderErr
+
+					continue
+				}
+
+				names = append(names, builder.GetDefinition().Name)
+
+				if len(names) == testCase.stopAfter {
+					break
+				}
+			}
+
+			if testCase.expectedError != "" {
+				assert.ErrorContains(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.expectedNames, names)
+			assert.Equal(t, testCase.expectedListCalls, listCalls)
+			assert.Equal(t, testCase.expectedLimit, limit)
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 	t.Parallel()
 
@@ -186,3 +347,36 @@ var _ common.Builder[corev1.ConfigMap, *corev1.ConfigMap] = (*mockNamespacedBuil
 func (builder *mockNamespacedBuilder) GetGVK() schema.GroupVersionKind {
 	return namespacedGVK
 }
+
+// listPage lists a single page of the objects into list, since the fake client ignores the Limit and Continue options.
+// The continue token is the index of the first object on the next page.
+func listPage(
+	ctx context.Context, client runtimeclient.WithWatch, list runtimeclient.ObjectList, opts ...runtimeclient.ListOption) error {
+	listOptions := (&runtimeclient.ListOptions{}).ApplyOptions(opts)
+
+	err := client.List(ctx, list, &runtimeclient.ListOptions{Namespace: listOptions.Namespace})
+	if err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	start := 0
+
+	if listOptions.Continue != "" {
+		start, err = strconv.Atoi(listOptions.Continue)
+		if err != nil {
+			return err
+		}
+	}
+
+	end := min(start+int(listOptions.Limit), len(items))
+	if end < len(items) {
+		list.SetContinue(strconv.Itoa(end))
+	}
+
+	return meta.SetList(list, items[start:end])
+}
@@ -0,0 +1,130 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// FieldChange is a single difference between the builder's definition and the object on the cluster.
+type FieldChange struct {
+	// Path is the location of the field, such as .spec.replicas or .spec.containers[0].image.
+	Path string
+	// Desired is the value of the field in the definition.
+	Desired any
+	// Actual is the value of the field in the object, or nil if the field is not set in the object.
+	Actual any
+}
+
+// String returns a short, human-readable description of the change.
+func (change FieldChange) String() string {
+	return fmt.Sprintf("%s: desired %v, actual %v", change.Path, change.Desired, change.Actual)
+}
+
+// Diff gets the resource from the cluster, updates the builder's object with it, and returns the fields in which the
+// builder's definition differs from the object, sorted by path. Only fields set in the definition are compared, so
+// fields defaulted by the API server or set by controllers are ignored, as are the status and server-managed metadata
+// such as managedFields and resourceVersion. Lists are compared element by element when they have the same length and
+// as a whole otherwise.
+//
+// Since the object is always refreshed, changes made on the cluster after the builder was created, pulled, or updated
+// are reported. An empty list means there is no drift. An error is returned if the resource does not exist.
+func Diff[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO]) ([]FieldChange, error) {
+	object, err := Get(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.SetObject(object)
+
+	desired, err := toComparableContent(builder.GetDefinition())
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := toComparableContent(object)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diffValues("", desired, actual, nil)
+
+	newOperationLogger(ctx, builder, "diff").V(logging.LevelDebug).Info("Found changed fields", "count", len(changes))
+
+	return changes, nil
+}
+
+// Diff gets the resource and compares it with the definition. See [Diff] for details.
+func (b *EmbeddableBuilder[O, SO]) Diff() ([]FieldChange, error) {
+	return b.DiffWithContext(context.TODO())
+}
+
+// DiffWithContext is the same as [EmbeddableBuilder.Diff] but uses the provided context for the API call.
+func (b *EmbeddableBuilder[O, SO]) DiffWithContext(ctx context.Context) ([]FieldChange, error) {
+	return Diff(ctx, b)
+}
+
+// toComparableContent converts the object to unstructured content without the status and server-managed metadata.
+func toComparableContent(object runtime.Object) (map[string]any, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T to unstructured: %w", object, err)
+	}
+
+	for _, field := range exportStrippedMetadataFields {
+		unstructured.RemoveNestedField(content, "metadata", field)
+	}
+
+	unstructured.RemoveNestedField(content, "status")
+	unstructured.RemoveNestedField(content, "apiVersion")
+	unstructured.RemoveNestedField(content, "kind")
+
+	return content, nil
+}
+
+// diffValues appends the changes between the desired and actual values at the path to changes. Maps are compared by
+// the keys in desired only, while lists are compared element by element if their lengths match.
+func diffValues(path string, desired, actual any, changes []FieldChange) []FieldChange {
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		actualValue, ok := actual.(map[string]any)
+		if !ok {
+			return append(changes, FieldChange{Path: path, Desired: desired, Actual: actual})
+		}
+
+		keys := make([]string, 0, len(desiredValue))
+		for key := range desiredValue {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			changes = diffValues(path+"."+key, desiredValue[key], actualValue[key], changes)
+		}
+
+		return changes
+	case []any:
+		actualValue, ok := actual.([]any)
+		if !ok || len(actualValue) != len(desiredValue) {
+			return append(changes, FieldChange{Path: path, Desired: desired, Actual: actual})
+		}
+
+		for index := range desiredValue {
+			changes = diffValues(fmt.Sprintf("%s[%d]", path, index), desiredValue[index], actualValue[index], changes)
+		}
+
+		return changes
+	default:
+		if !reflect.DeepEqual(desired, actual) {
+			return append(changes, FieldChange{Path: path, Desired: desired, Actual: actual})
+		}
+
+		return changes
+	}
+}
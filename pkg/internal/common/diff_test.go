@@ -0,0 +1,156 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		object          *corev1.ConfigMap
+		expectedChanges []common.FieldChange
+		assertError     func(error) bool
+	}{
+		{
+			name:            "no drift ignores server and extra fields",
+			object:          buildDiffTestConfigMap(map[string]string{"first": "1", "second": "2"}),
+			expectedChanges: []common.FieldChange{},
+			assertError:     isNilError,
+		},
+		{
+			name:   "changed and missing fields",
+			object: buildDiffTestConfigMap(map[string]string{"first": "changed"}),
+			expectedChanges: []common.FieldChange{
+				{Path: ".data.first", Desired: "1", Actual: "changed"},
+				{Path: ".data.second", Desired: "2", Actual: nil},
+			},
+			assertError: isNilError,
+		},
+		{
+			name:        "resource does not exist",
+			object:      nil,
+			assertError: isDiffNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var objects []runtime.Object
+
+			if testCase.object != nil {
+				objects = append(objects, testCase.object)
+			}
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  objects,
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+			})
+
+			builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+				testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+			builder.GetDefinition().Data = map[string]string{"first": "1", "second": "2"}
+
+			changes, err := builder.Diff()
+			assert.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
+
+			if err == nil {
+				assert.ElementsMatch(t, testCase.expectedChanges, changes)
+				assert.NotNil(t, builder.GetObject())
+			}
+		})
+	}
+}
+
+func TestDiffAfterCreate(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+	builder.GetDefinition().Data = map[string]string{"first": "1"}
+
+	require.NoError(t, common.Create(t.Context(), builder))
+
+	changes, err := builder.DiffWithContext(t.Context())
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// Changes made on the cluster after the builder created the resource must be reported.
+	external := &corev1.ConfigMap{}
+	require.NoError(t, testSettings.Get(t.Context(), runtimeclient.ObjectKey{Name: waitTestName, Namespace: waitTestNamespace}, external))
+
+	external.Data["first"] = "changed"
+	require.NoError(t, testSettings.Update(t.Context(), external))
+
+	changes, err = builder.DiffWithContext(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, []common.FieldChange{{Path: ".data.first", Desired: "1", Actual: "changed"}}, changes)
+	assert.Equal(t, "changed", builder.GetObject().Data["first"])
+}
+
+func TestDiffLists(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builder := common.NewClusterScopedBuilder[corev1.Namespace, mockClusterScopedBuilder](
+		testSettings, testSchemeAttacher, waitTestName)
+	builder.GetDefinition().Spec.Finalizers = []corev1.FinalizerName{"first", "second"}
+
+	require.NoError(t, common.Create(t.Context(), builder))
+
+	object := builder.GetObject().DeepCopy()
+	object.Spec.Finalizers = []corev1.FinalizerName{"first", "changed"}
+	require.NoError(t, testSettings.Update(t.Context(), object))
+
+	changes, err := common.Diff(t.Context(), builder)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.FieldChange{{Path: ".spec.finalizers[1]", Desired: "second", Actual: "changed"}}, changes)
+
+	object = builder.GetObject().DeepCopy()
+	object.Spec.Finalizers = []corev1.FinalizerName{"first"}
+	require.NoError(t, testSettings.Update(t.Context(), object))
+
+	changes, err = common.Diff(t.Context(), builder)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.FieldChange{{
+		Path: ".spec.finalizers", Desired: []any{"first", "second"}, Actual: []any{"first"}}}, changes)
+}
+
+func buildDiffTestConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            waitTestName,
+			Namespace:       waitTestNamespace,
+			ResourceVersion: "5",
+			UID:             "diff-test-uid",
+			Annotations:     map[string]string{"defaulted": "true"},
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "test"}},
+		},
+		Data: data,
+	}
+}
+
+func isDiffNotFound(err error) bool {
+	return commonerrors.IsAPICallFailed(err) && k8serrors.IsNotFound(err)
+}
@@ -0,0 +1,29 @@
+package common
+
+import "context"
+
+// EmbeddableApplier is a mixin which provides the Apply method to the embedding builder. The Apply method reconciles
+// the resource with the builder's definition using server-side apply rather than the Get-then-Update approach of the
+// updater mixins.
+type EmbeddableApplier[O any, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]] struct {
+	base SB
+}
+
+// SetBase sets the base builder for the mixin. When the Apply method is called, the common Apply method will be called
+// on the base builder. This base is also what gets returned by the Apply method.
+func (applier *EmbeddableApplier[O, B, SO, SB]) SetBase(base SB) {
+	applier.base = base
+}
+
+// Apply creates or updates the resource in the cluster using server-side apply with the provided field manager. When
+// another field manager owns an applied field, an apply conflict error is returned unless force is true, in which case
+// ownership of the conflicting fields is taken. The resource is never deleted and recreated.
+func (applier *EmbeddableApplier[O, B, SO, SB]) Apply(fieldManager string, force bool) (SB, error) {
+	return applier.ApplyWithContext(context.TODO(), fieldManager, force)
+}
+
+// ApplyWithContext is the same as [EmbeddableApplier.Apply] but uses the provided context for the API call.
+func (applier *EmbeddableApplier[O, B, SO, SB]) ApplyWithContext(
+	ctx context.Context, fieldManager string, force bool) (SB, error) {
+	return applier.base, Apply(ctx, applier.base, fieldManager, force)
+}
@@ -86,12 +86,23 @@ func (b *EmbeddableBuilder[O, SO]) SetGVK(gvk schema.GroupVersionKind) {
 
 // Get pulls the resource from the cluster and returns it. It does not modify the builder.
 func (b *EmbeddableBuilder[O, SO]) Get() (SO, error) {
-	return Get(context.TODO(), b)
+	return b.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the same as [EmbeddableBuilder.Get] but uses the provided context for the API call. Cancelling the
+// context aborts the request.
+func (b *EmbeddableBuilder[O, SO]) GetWithContext(ctx context.Context) (SO, error) {
+	return Get(ctx, b)
 }
 
 // Exists checks whether the resource exists on the cluster. If the resource does exist, the builder's object is updated
 // with the resource and this returns true. If the builder is invalid, or the resource cannot be retrieved, this returns
 // false without modifying the builder.
 func (b *EmbeddableBuilder[O, SO]) Exists() bool {
-	return Exists(context.TODO(), b)
+	return b.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the same as [EmbeddableBuilder.Exists] but uses the provided context for the API call.
+func (b *EmbeddableBuilder[O, SO]) ExistsWithContext(ctx context.Context) bool {
+	return Exists(ctx, b)
 }
@@ -17,5 +17,10 @@ func (creator *EmbeddableCreator[O, B, SO, SB]) SetBase(base SB) {
 // Create creates the resource in the cluster. It first checks if the resource already exists and if so, does nothing.
 // Otherwise, it tries to create the resource and returns the builder and the error from the Create method.
 func (creator *EmbeddableCreator[O, B, SO, SB]) Create() (SB, error) {
-	return creator.base, Create(context.TODO(), creator.base)
+	return creator.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the same as [EmbeddableCreator.Create] but uses the provided context for all API calls.
+func (creator *EmbeddableCreator[O, B, SO, SB]) CreateWithContext(ctx context.Context) (SB, error) {
+	return creator.base, Create(ctx, creator.base)
 }
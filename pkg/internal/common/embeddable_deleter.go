@@ -17,7 +17,12 @@ func (deleter *EmbeddableDeleter[O, SO]) SetBase(base Builder[O, SO]) {
 // resource did not exist, the builder's object is set to nil. Otherwise, the error is wrapped and returned without
 // modifying the builder.
 func (deleter *EmbeddableDeleter[O, SO]) Delete() error {
-	return Delete(context.TODO(), deleter.base)
+	return deleter.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the same as [EmbeddableDeleter.Delete] but uses the provided context for the API call.
+func (deleter *EmbeddableDeleter[O, SO]) DeleteWithContext(ctx context.Context) error {
+	return Delete(ctx, deleter.base)
 }
 
 // EmbeddableDeleteReturner is a mixin which provides the Delete method to the embedding builder. The Delete method
@@ -41,5 +46,10 @@ func (deleter *EmbeddableDeleteReturner[O, B, SO, SB]) SetBase(base SB) {
 // resource did not exist, the builder's object is set to nil. Otherwise, the error is wrapped and returned without
 // modifying the builder. Regardless of the error, the builder is returned.
 func (deleter *EmbeddableDeleteReturner[O, B, SO, SB]) Delete() (SB, error) {
-	return deleter.base, Delete(context.TODO(), deleter.base)
+	return deleter.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the same as [EmbeddableDeleteReturner.Delete] but uses the provided context for the API call.
+func (deleter *EmbeddableDeleteReturner[O, B, SO, SB]) DeleteWithContext(ctx context.Context) (SB, error) {
+	return deleter.base, Delete(ctx, deleter.base)
 }
@@ -0,0 +1,268 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// EmbeddableMetadata is a mixin which provides modifiers for the labels, annotations, owner references, and finalizers
+// of the embedding builder's definition, as well as a method to strip the finalizers from the resource on the cluster.
+// Like other builder modifiers, the methods do nothing if the builder is invalid and store an error in the builder if
+// their arguments are invalid. Changes to the definition are sent to the cluster by Create, Update, or PatchChanges.
+//
+// The embedding builder must implement [Builder]. Of the legacy builders, only those in the namespace, nodes, and
+// secret packages do so and embed the mixin; the others keep their own label and annotation modifiers, if any.
+type EmbeddableMetadata[O any, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]] struct {
+	base SB
+}
+
+// SetBase sets the base builder for the mixin. The modifiers change the definition of the base builder and return it,
+// so the base should be the resource-specific builder rather than the EmbeddableBuilder.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) SetBase(base SB) {
+	metadata.base = base
+}
+
+// WithLabel sets the label with the provided key to value. The key cannot be empty.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) WithLabel(key, value string) SB {
+	return metadata.WithLabels(map[string]string{key: value})
+}
+
+// WithLabels sets all of the provided labels, keeping existing labels with other keys. No keys can be empty.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) WithLabels(labels map[string]string) SB {
+	if !metadata.validateKeys("label", errors.BuilderFieldLabelKey, slices.Collect(maps.Keys(labels))) {
+		return metadata.base
+	}
+
+	definition := metadata.base.GetDefinition()
+	definition.SetLabels(mergeMetadataMap(definition.GetLabels(), labels))
+
+	return metadata.base
+}
+
+// RemoveLabels removes the labels with the provided keys. Keys which are not present are ignored.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) RemoveLabels(keys ...string) SB {
+	if !metadata.validateKeys("label", errors.BuilderFieldLabelKey, keys) {
+		return metadata.base
+	}
+
+	definition := metadata.base.GetDefinition()
+	definition.SetLabels(removeMetadataKeys(definition.GetLabels(), keys))
+
+	return metadata.base
+}
+
+// WithAnnotation sets the annotation with the provided key to value. The key cannot be empty.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) WithAnnotation(key, value string) SB {
+	return metadata.WithAnnotations(map[string]string{key: value})
+}
+
+// WithAnnotations sets all of the provided annotations, keeping existing annotations with other keys. No keys can be
+// empty.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) WithAnnotations(annotations map[string]string) SB {
+	if !metadata.validateKeys("annotation", errors.BuilderFieldAnnotationKey, slices.Collect(maps.Keys(annotations))) {
+		return metadata.base
+	}
+
+	definition := metadata.base.GetDefinition()
+	definition.SetAnnotations(mergeMetadataMap(definition.GetAnnotations(), annotations))
+
+	return metadata.base
+}
+
+// RemoveAnnotations removes the annotations with the provided keys. Keys which are not present are ignored.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) RemoveAnnotations(keys ...string) SB {
+	if !metadata.validateKeys("annotation", errors.BuilderFieldAnnotationKey, keys) {
+		return metadata.base
+	}
+
+	definition := metadata.base.GetDefinition()
+	definition.SetAnnotations(removeMetadataKeys(definition.GetAnnotations(), keys))
+
+	return metadata.base
+}
+
+// WithOwnerReference adds a reference to the owner to the owner references, replacing any existing reference with the
+// same UID. The owner is usually the Object of another builder, from any package, and must have been created or pulled
+// so its UID is known. Its kind is looked up in the scheme of the builder's client. Since owner references cannot cross
+// namespaces, the owner must either be cluster-scoped or in the same namespace as this resource.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) WithOwnerReference(owner runtimeclient.Object) SB {
+	if !metadata.isValid() {
+		return metadata.base
+	}
+
+	if isInterfaceNil(owner) {
+		metadata.base.SetError(fmt.Errorf("owner of the builder for %s cannot be nil", metadata.resourceKey()))
+
+		return metadata.base
+	}
+
+	if owner.GetUID() == "" {
+		metadata.base.SetError(fmt.Errorf(
+			"owner %s of %s has no UID, it must be created or pulled first", owner.GetName(), metadata.resourceKey()))
+
+		return metadata.base
+	}
+
+	gvk, err := apiutil.GVKForObject(owner, metadata.base.GetClient().Scheme())
+	if err != nil {
+		metadata.base.SetError(fmt.Errorf("failed to get kind of owner %s of %s: %w",
+			owner.GetName(), metadata.resourceKey(), err))
+
+		return metadata.base
+	}
+
+	definition := metadata.base.GetDefinition()
+
+	if owner.GetNamespace() != "" && owner.GetNamespace() != definition.GetNamespace() {
+		metadata.base.SetError(fmt.Errorf(
+			"owner %s %s of %s is in namespace %s, but owner references cannot cross namespaces",
+			gvk.Kind, owner.GetName(), metadata.resourceKey(), owner.GetNamespace()))
+
+		return metadata.base
+	}
+
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	ownerReference := metav1.OwnerReference{
+		APIVersion:         apiVersion,
+		Kind:               kind,
+		Name:               owner.GetName(),
+		UID:                owner.GetUID(),
+		BlockOwnerDeletion: ptr.To(true),
+	}
+
+	ownerReferences := slices.DeleteFunc(slices.Clone(definition.GetOwnerReferences()),
+		func(existing metav1.OwnerReference) bool {
+			return existing.UID == ownerReference.UID
+		})
+
+	definition.SetOwnerReferences(append(ownerReferences, ownerReference))
+
+	return metadata.base
+}
+
+// WithFinalizer adds the finalizer if it is not already present. The finalizer cannot be empty.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) WithFinalizer(finalizer string) SB {
+	if !metadata.validateKeys("finalizer", errors.BuilderFieldFinalizer, []string{finalizer}) {
+		return metadata.base
+	}
+
+	definition := metadata.base.GetDefinition()
+	if !slices.Contains(definition.GetFinalizers(), finalizer) {
+		definition.SetFinalizers(append(slices.Clone(definition.GetFinalizers()), finalizer))
+	}
+
+	return metadata.base
+}
+
+// RemoveFinalizer removes the finalizer if it is present. The finalizer cannot be empty.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) RemoveFinalizer(finalizer string) SB {
+	if !metadata.validateKeys("finalizer", errors.BuilderFieldFinalizer, []string{finalizer}) {
+		return metadata.base
+	}
+
+	definition := metadata.base.GetDefinition()
+	definition.SetFinalizers(slices.DeleteFunc(slices.Clone(definition.GetFinalizers()), func(existing string) bool {
+		return existing == finalizer
+	}))
+
+	return metadata.base
+}
+
+// StripFinalizers removes all finalizers from the resource on the cluster, which allows a resource stuck terminating to
+// be deleted when the controller responsible for its finalizers is gone. Unlike the other methods of the mixin, this
+// immediately patches the resource rather than changing the definition. See [Patch] for how the builder is updated.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) StripFinalizers() (SB, error) {
+	return metadata.StripFinalizersWithContext(context.TODO())
+}
+
+// StripFinalizersWithContext is the same as [EmbeddableMetadata.StripFinalizers] but uses the provided context for the
+// API call.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) StripFinalizersWithContext(ctx context.Context) (SB, error) {
+	if metadata.base == nil {
+		return metadata.base, errors.NewBuilderNil()
+	}
+
+	return metadata.base, Patch(ctx, metadata.base, types.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`))
+}
+
+// isValid returns whether the base builder is valid, logging the reason if it is not.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) isValid() bool {
+	if metadata.base == nil {
+		return false
+	}
+
+	if err := Validate(metadata.base); err != nil {
+		logging.FromClient(metadata.base.GetClient()).V(logging.LevelDebug).Info(
+			"Not modifying metadata of invalid builder", "error", err)
+
+		return false
+	}
+
+	return true
+}
+
+// validateKeys returns whether the base builder is valid and none of the keys are empty. If any are empty, an error is
+// stored in the builder for the field.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) validateKeys(
+	description string, field errors.BuilderField, keys []string) bool {
+	if !metadata.isValid() {
+		return false
+	}
+
+	if slices.Contains(keys, "") {
+		newResourceLogger(logging.FromClient(metadata.base.GetClient()), metadata.resourceKey()).
+			V(logging.LevelDebug).Info("The " + description + " cannot be empty")
+
+		metadata.base.SetError(errors.NewBuilderFieldEmpty(metadata.resourceKey(), field))
+
+		return false
+	}
+
+	return true
+}
+
+// resourceKey returns the resource key of the base builder.
+func (metadata *EmbeddableMetadata[O, B, SO, SB]) resourceKey() key.ResourceKey {
+	return NewResourceKeyFromBuilder(metadata.base)
+}
+
+// mergeMetadataMap returns a copy of existing with the values from updates set. If there are no updates, existing is
+// returned unchanged.
+func mergeMetadataMap(existing, updates map[string]string) map[string]string {
+	if len(updates) == 0 {
+		return existing
+	}
+
+	merged := make(map[string]string, len(existing)+len(updates))
+
+	maps.Copy(merged, existing)
+	maps.Copy(merged, updates)
+
+	return merged
+}
+
+// removeMetadataKeys returns a copy of existing without the keys.
+func removeMetadataKeys(existing map[string]string, keys []string) map[string]string {
+	if existing == nil {
+		return nil
+	}
+
+	removed := maps.Clone(existing)
+
+	for _, metadataKey := range keys {
+		delete(removed, metadataKey)
+	}
+
+	return removed
+}
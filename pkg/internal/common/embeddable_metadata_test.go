@@ -0,0 +1,288 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	metadataTestName      = "metadata-test"
+	metadataTestNamespace = "metadata-test-namespace"
+	metadataTestFinalizer = "example.com/test-finalizer"
+)
+
+func TestEmbeddableMetadataLabelsAndAnnotations(t *testing.T) {
+	t.Parallel()
+
+	builder := buildMetadataTestBuilder(t)
+
+	builder.WithLabel("first", "1").WithLabels(map[string]string{"second": "2", "third": "3"}).RemoveLabels("third")
+	builder.WithAnnotation("first", "1").WithAnnotations(map[string]string{"second": "2"}).RemoveAnnotations("first")
+
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, map[string]string{"first": "1", "second": "2"}, builder.Definition.Labels)
+	assert.Equal(t, map[string]string{"second": "2"}, builder.Definition.Annotations)
+
+	// Removing keys which are not present leaves the maps unchanged.
+	builder.RemoveLabels("missing").RemoveAnnotations("missing")
+	assert.Equal(t, map[string]string{"first": "1", "second": "2"}, builder.Definition.Labels)
+	assert.Equal(t, map[string]string{"second": "2"}, builder.Definition.Annotations)
+}
+
+func TestEmbeddableMetadataEmptyKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		modify        func(builder *mockMetadataBuilder) *mockMetadataBuilder
+		expectedError string
+	}{
+		{
+			name:          "empty label key",
+			modify:        func(builder *mockMetadataBuilder) *mockMetadataBuilder { return builder.WithLabel("", "value") },
+			expectedError: "label key of the builder for",
+		},
+		{
+			name:          "empty removed label key",
+			modify:        func(builder *mockMetadataBuilder) *mockMetadataBuilder { return builder.RemoveLabels("") },
+			expectedError: "label key of the builder for",
+		},
+		{
+			name: "empty annotation key",
+			modify: func(builder *mockMetadataBuilder) *mockMetadataBuilder {
+				return builder.WithAnnotations(map[string]string{"": "value"})
+			},
+			expectedError: "annotation key of the builder for",
+		},
+		{
+			name:          "empty finalizer",
+			modify:        func(builder *mockMetadataBuilder) *mockMetadataBuilder { return builder.WithFinalizer("") },
+			expectedError: "finalizer of the builder for",
+		},
+		{
+			name:          "nil owner",
+			modify:        func(builder *mockMetadataBuilder) *mockMetadataBuilder { return builder.WithOwnerReference(nil) },
+			expectedError: "cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := testCase.modify(buildMetadataTestBuilder(t))
+			assert.ErrorContains(t, builder.GetError(), testCase.expectedError)
+			assert.Empty(t, builder.Definition.Labels)
+			assert.Empty(t, builder.Definition.Annotations)
+			assert.Empty(t, builder.Definition.Finalizers)
+
+			if testCase.expectedError != "cannot be nil" {
+				assert.ErrorIs(t, builder.GetError(), goinfraerrors.ErrInvalidBuilder)
+			}
+		})
+	}
+}
+
+func TestEmbeddableMetadataInvalidBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder := buildMetadataTestBuilder(t)
+	builder.SetClient(nil)
+
+	builder.WithLabel("key", "value").WithAnnotation("key", "value").WithFinalizer(metadataTestFinalizer)
+
+	assert.Empty(t, builder.Definition.Labels)
+	assert.Empty(t, builder.Definition.Annotations)
+	assert.Empty(t, builder.Definition.Finalizers)
+	assert.NoError(t, builder.GetError())
+}
+
+func TestEmbeddableMetadataWithOwnerReference(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: metadataTestNamespace, UID: types.UID("owner-uid")}}},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	owner, err := common.PullClusterScopedBuilder[corev1.Namespace, mockClusterScopedBuilder](
+		t.Context(), testSettings, testSchemeAttacher, metadataTestNamespace)
+	require.NoError(t, err)
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockMetadataBuilder](
+		testSettings, testSchemeAttacher, metadataTestName, metadataTestNamespace)
+
+	// Setting the same owner twice replaces the first reference.
+	builder.WithOwnerReference(owner.Object).WithOwnerReference(owner.Object)
+
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, []metav1.OwnerReference{{
+		APIVersion:         "v1",
+		Kind:               "Namespace",
+		Name:               metadataTestNamespace,
+		UID:                types.UID("owner-uid"),
+		BlockOwnerDeletion: ptr.To(true),
+	}}, builder.Definition.OwnerReferences)
+
+	// The owner must exist on the cluster so its UID is known.
+	missingOwner := common.NewClusterScopedBuilder[corev1.Namespace, mockClusterScopedBuilder](
+		testSettings, testSchemeAttacher, "missing-owner")
+
+	builder.WithOwnerReference(missingOwner.Definition)
+	assert.ErrorContains(t, builder.GetError(), "has no UID")
+	assert.Len(t, builder.Definition.OwnerReferences, 1)
+
+	// The kind of the owner must be known to the scheme of the client.
+	builder.SetError(nil)
+	builder.WithOwnerReference(&unknownOwner{ObjectMeta: metav1.ObjectMeta{Name: "unknown", UID: types.UID("unknown-uid")}})
+	assert.ErrorContains(t, builder.GetError(), "failed to get kind of owner unknown")
+	assert.Len(t, builder.Definition.OwnerReferences, 1)
+}
+
+// unknownOwner is an object whose kind is not registered in any scheme.
+type unknownOwner struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+}
+
+func (owner *unknownOwner) DeepCopyObject() runtime.Object {
+	return &unknownOwner{TypeMeta: owner.TypeMeta, ObjectMeta: *owner.DeepCopy()}
+}
+
+func TestEmbeddableMetadataWithOwnerReferenceNamespace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		ownerNamespace string
+		expectedError  string
+	}{
+		{
+			name:           "same namespace",
+			ownerNamespace: metadataTestNamespace,
+		},
+		{
+			name:           "different namespace",
+			ownerNamespace: "other-namespace",
+			expectedError:  "owner references cannot cross namespaces",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+					Name: "owner", Namespace: testCase.ownerNamespace, UID: types.UID("owner-uid")}}},
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+			})
+
+			owner, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+				t.Context(), testSettings, testSchemeAttacher, "owner", testCase.ownerNamespace)
+			require.NoError(t, err)
+
+			builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockMetadataBuilder](
+				testSettings, testSchemeAttacher, metadataTestName, metadataTestNamespace)
+
+			builder.WithOwnerReference(owner.Object)
+
+			if testCase.expectedError == "" {
+				assert.NoError(t, builder.GetError())
+				assert.Len(t, builder.Definition.OwnerReferences, 1)
+
+				return
+			}
+
+			assert.ErrorContains(t, builder.GetError(), testCase.expectedError)
+			assert.Empty(t, builder.Definition.OwnerReferences)
+		})
+	}
+}
+
+func TestEmbeddableMetadataFinalizers(t *testing.T) {
+	t.Parallel()
+
+	builder := buildMetadataTestBuilder(t)
+
+	builder.WithFinalizer(metadataTestFinalizer).WithFinalizer(metadataTestFinalizer).WithFinalizer("example.com/other")
+	assert.Equal(t, []string{metadataTestFinalizer, "example.com/other"}, builder.Definition.Finalizers)
+
+	builder.RemoveFinalizer(metadataTestFinalizer).RemoveFinalizer("example.com/missing")
+	assert.Equal(t, []string{"example.com/other"}, builder.Definition.Finalizers)
+	assert.NoError(t, builder.GetError())
+}
+
+func TestEmbeddableMetadataStripFinalizers(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:       metadataTestName,
+			Namespace:  metadataTestNamespace,
+			Finalizers: []string{metadataTestFinalizer, "example.com/other"},
+		}}},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builder, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockMetadataBuilder](
+		t.Context(), testSettings, testSchemeAttacher, metadataTestName, metadataTestNamespace)
+	require.NoError(t, err)
+
+	result, err := builder.StripFinalizers()
+	require.NoError(t, err)
+	assert.Same(t, builder, result)
+	assert.Empty(t, builder.Object.Finalizers)
+
+	configMap, err := common.Get(t.Context(), builder)
+	require.NoError(t, err)
+	assert.Empty(t, configMap.Finalizers)
+
+	// A builder whose mixins were never attached has no base to patch.
+	_, err = (&mockMetadataBuilder{}).StripFinalizers()
+	assert.True(t, commonerrors.IsBuilderNil(err))
+}
+
+// mockMetadataBuilder is a namespaced builder with the EmbeddableMetadata mixin.
+type mockMetadataBuilder struct {
+	common.EmbeddableBuilder[corev1.ConfigMap, *corev1.ConfigMap]
+	common.EmbeddableMetadata[corev1.ConfigMap, mockMetadataBuilder, *corev1.ConfigMap, *mockMetadataBuilder]
+}
+
+// AttachMixins attaches the mixins to the mock metadata builder.
+func (builder *mockMetadataBuilder) AttachMixins() {
+	builder.EmbeddableMetadata.SetBase(builder)
+}
+
+// GetGVK returns the GVK for the mock metadata builder.
+func (builder *mockMetadataBuilder) GetGVK() schema.GroupVersionKind {
+	return namespacedGVK
+}
+
+// buildMetadataTestBuilder returns a valid mockMetadataBuilder with a fake client.
+func buildMetadataTestBuilder(t *testing.T) *mockMetadataBuilder {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockMetadataBuilder](
+		testSettings, testSchemeAttacher, metadataTestName, metadataTestNamespace)
+	require.NoError(t, builder.GetError())
+
+	return builder
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// EmbeddablePatcher is a mixin which provides the Patch and PatchChanges methods to the embedding builder.
+type EmbeddablePatcher[O any, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]] struct {
+	base SB
+}
+
+// SetBase sets the base builder for the mixin. When the Patch or PatchChanges methods are called, the common function
+// of the same name will be called on the base builder. This base is also what gets returned by both methods.
+func (patcher *EmbeddablePatcher[O, B, SO, SB]) SetBase(base SB) {
+	patcher.base = base
+}
+
+// Patch sends a patch of the provided type to the resource. See [Patch] for details.
+func (patcher *EmbeddablePatcher[O, B, SO, SB]) Patch(patchType types.PatchType, data []byte) (SB, error) {
+	return patcher.PatchWithContext(context.TODO(), patchType, data)
+}
+
+// PatchWithContext is the same as [EmbeddablePatcher.Patch] but uses the provided context for the API call.
+func (patcher *EmbeddablePatcher[O, B, SO, SB]) PatchWithContext(
+	ctx context.Context, patchType types.PatchType, data []byte) (SB, error) {
+	return patcher.base, Patch(ctx, patcher.base, patchType, data)
+}
+
+// PatchChanges patches only the changes made to the definition since the resource was pulled. See [PatchChanges] for
+// details.
+func (patcher *EmbeddablePatcher[O, B, SO, SB]) PatchChanges(patchType types.PatchType) (SB, error) {
+	return patcher.PatchChangesWithContext(context.TODO(), patchType)
+}
+
+// PatchChangesWithContext is the same as [EmbeddablePatcher.PatchChanges] but uses the provided context for the API
+// call.
+func (patcher *EmbeddablePatcher[O, B, SO, SB]) PatchChangesWithContext(
+	ctx context.Context, patchType types.PatchType) (SB, error) {
+	return patcher.base, PatchChanges(ctx, patcher.base, patchType)
+}
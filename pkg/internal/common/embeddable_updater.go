@@ -18,7 +18,12 @@ func (updater *EmbeddableUpdater[O, B, SO, SB]) SetBase(base SB) {
 // could not be updated. It checks for the resource's existence and attempts to align resource versions to avoid
 // conflict.
 func (updater *EmbeddableUpdater[O, B, SO, SB]) Update() (SB, error) {
-	return updater.base, Update(context.TODO(), updater.base, false)
+	return updater.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the same as [EmbeddableUpdater.Update] but uses the provided context for all API calls.
+func (updater *EmbeddableUpdater[O, B, SO, SB]) UpdateWithContext(ctx context.Context) (SB, error) {
+	return updater.base, Update(ctx, updater.base, false)
 }
 
 // EmbeddableForceUpdater is a mixin which provides the Update method to the embedding builder. The Update method
@@ -38,5 +43,11 @@ func (updater *EmbeddableForceUpdater[O, B, SO, SB]) SetBase(base SB) {
 // Regardless of the force flag, this function returns an error if the resource does not exist. When it exists, the
 // resource version just pulled from the cluster is used to avoid conflicts.
 func (updater *EmbeddableForceUpdater[O, B, SO, SB]) Update(force bool) (SB, error) {
-	return updater.base, Update(context.TODO(), updater.base, force)
+	return updater.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the same as [EmbeddableForceUpdater.Update] but uses the provided context for all API calls,
+// including the delete and create performed during a forced update.
+func (updater *EmbeddableForceUpdater[O, B, SO, SB]) UpdateWithContext(ctx context.Context, force bool) (SB, error) {
+	return updater.base, Update(ctx, updater.base, force)
 }
@@ -1,5 +1,7 @@
 // Package errors provides the error types for the common package. It is currently meant just for use in the common
-// package and therefore focuses on the errors encountered in the common package.
+// package and therefore focuses on the errors encountered in the common package. The errors satisfy errors.Is for the
+// matching sentinel errors from the exported errors package, so callers outside of eco-goinfra do not need to import
+// this package.
 package errors
 
 import (
@@ -7,7 +9,9 @@ import (
 	"fmt"
 	"reflect"
 
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 type apiClientNilError struct {
@@ -25,6 +29,11 @@ func (e *apiClientNilError) Error() string {
 	return fmt.Sprintf("apiClient for %s is nil", e.resourceKey.String())
 }
 
+// Is returns true if the target is [goinfraerrors.ErrInvalidBuilder].
+func (e *apiClientNilError) Is(target error) bool {
+	return target == goinfraerrors.ErrInvalidBuilder
+}
+
 // IsAPIClientNil returns true if an error, or any error in the error's tree, is due to the apiClient being nil.
 func IsAPIClientNil(err error) bool {
 	var apiClientNilError *apiClientNilError
@@ -49,6 +58,11 @@ func (e *schemeAttacherFailedError) Error() string {
 	return fmt.Sprintf("failed to attach scheme for %s: %v", e.resourceKey.String(), e.err)
 }
 
+// Is returns true if the target is [goinfraerrors.ErrInvalidBuilder].
+func (e *schemeAttacherFailedError) Is(target error) bool {
+	return target == goinfraerrors.ErrInvalidBuilder
+}
+
 func (e *schemeAttacherFailedError) Unwrap() error {
 	return e.err
 }
@@ -78,6 +92,12 @@ const (
 	// BuilderFieldNamespace is the namespace of the field for a builder's namespace. This corresponds to the
 	// Namespace field of the ObjectMeta.
 	BuilderFieldNamespace BuilderField = "namespace"
+	// BuilderFieldLabelKey is the key of a label being added to or removed from the builder's definition.
+	BuilderFieldLabelKey BuilderField = "label key"
+	// BuilderFieldAnnotationKey is the key of an annotation being added to or removed from the builder's definition.
+	BuilderFieldAnnotationKey BuilderField = "annotation key"
+	// BuilderFieldFinalizer is a finalizer being added to or removed from the builder's definition.
+	BuilderFieldFinalizer BuilderField = "finalizer"
 )
 
 // NewBuilderFieldEmpty creates a new error that indicates that a field for a builder is empty.
@@ -89,6 +109,11 @@ func (e *builderFieldEmptyError) Error() string {
 	return fmt.Sprintf("%s of the builder for %s is empty", e.field, e.resourceKey.String())
 }
 
+// Is returns true if the target is [goinfraerrors.ErrInvalidBuilder].
+func (e *builderFieldEmptyError) Is(target error) bool {
+	return target == goinfraerrors.ErrInvalidBuilder
+}
+
 // IsBuilderNameEmpty returns true if an error, or any error in the error's tree, is due to the builder's name being
 // empty.
 func IsBuilderNameEmpty(err error) bool {
@@ -127,6 +152,21 @@ func (e *apiCallFailedError) Unwrap() error {
 	return e.err
 }
 
+// Is returns true if the target is the sentinel error from [goinfraerrors] matching the status of the wrapped API
+// error. Only NotFound, AlreadyExists, and Conflict statuses have a matching sentinel.
+func (e *apiCallFailedError) Is(target error) bool {
+	switch target {
+	case goinfraerrors.ErrNotFound:
+		return k8serrors.IsNotFound(e.err)
+	case goinfraerrors.ErrAlreadyExists:
+		return k8serrors.IsAlreadyExists(e.err)
+	case goinfraerrors.ErrConflict:
+		return k8serrors.IsConflict(e.err)
+	default:
+		return false
+	}
+}
+
 // IsAPICallFailed returns true if an error, or any error in the error's tree, is due to an API call failing.
 func IsAPICallFailed(err error) bool {
 	var apiCallFailed *apiCallFailedError
@@ -155,6 +195,11 @@ func (e *builderNilError) Error() string {
 	return "builder is nil"
 }
 
+// Is returns true if the target is [goinfraerrors.ErrInvalidBuilder].
+func (e *builderNilError) Is(target error) bool {
+	return target == goinfraerrors.ErrInvalidBuilder
+}
+
 // IsBuilderNil returns true if an error, or any error in the error's tree, is due to the builder being nil.
 func IsBuilderNil(err error) bool {
 	var builderNil *builderNilError
@@ -177,6 +222,11 @@ func (e *builderDefinitionNilError) Error() string {
 	return fmt.Sprintf("%s builder definition is nil", e.kind)
 }
 
+// Is returns true if the target is [goinfraerrors.ErrInvalidBuilder].
+func (e *builderDefinitionNilError) Is(target error) bool {
+	return target == goinfraerrors.ErrInvalidBuilder
+}
+
 // IsBuilderDefinitionNil returns true if an error, or any error in the error's tree, is due to the builder's definition
 // being nil.
 func IsBuilderDefinitionNil(err error) bool {
@@ -185,6 +235,30 @@ func IsBuilderDefinitionNil(err error) bool {
 	return errors.As(err, &builderDefinitionNil)
 }
 
+type builderObjectNilError struct {
+	kind string
+}
+
+var _ error = (*builderObjectNilError)(nil)
+
+// NewBuilderObjectNil creates a new error that indicates that the builder's object is nil, meaning the resource has not
+// been pulled from the cluster.
+func NewBuilderObjectNil(kind string) *builderObjectNilError {
+	return &builderObjectNilError{kind: kind}
+}
+
+func (e *builderObjectNilError) Error() string {
+	return fmt.Sprintf("%s builder object is nil", e.kind)
+}
+
+// IsBuilderObjectNil returns true if an error, or any error in the error's tree, is due to the builder's object being
+// nil.
+func IsBuilderObjectNil(err error) bool {
+	var builderObjectNil *builderObjectNilError
+
+	return errors.As(err, &builderObjectNil)
+}
+
 type itemTypeMismatchError struct {
 	kind     string
 	itemType reflect.Type
@@ -207,3 +281,102 @@ func IsItemTypeMismatch(err error) bool {
 
 	return errors.As(err, &itemTypeMismatch)
 }
+
+type applyConflictError struct {
+	resourceKey  key.ResourceKey
+	fieldManager string
+	err          error
+}
+
+var _ error = (*applyConflictError)(nil)
+
+// NewApplyConflict creates a new error that indicates that a server-side apply request was rejected because another
+// field manager owns one or more of the applied fields. It wraps the conflict error returned by the API server, which
+// lists the conflicting fields and their managers.
+func NewApplyConflict(resourceKey key.ResourceKey, fieldManager string, err error) *applyConflictError {
+	return &applyConflictError{resourceKey: resourceKey, fieldManager: fieldManager, err: err}
+}
+
+func (e *applyConflictError) Error() string {
+	return fmt.Sprintf("field manager %s conflicts applying %s: %v", e.fieldManager, e.resourceKey.String(), e.err)
+}
+
+func (e *applyConflictError) Unwrap() error {
+	return e.err
+}
+
+// Is returns true if the target is [goinfraerrors.ErrConflict].
+func (e *applyConflictError) Is(target error) bool {
+	return target == goinfraerrors.ErrConflict
+}
+
+// IsApplyConflict returns true if an error, or any error in the error's tree, is due to a server-side apply field
+// ownership conflict.
+func IsApplyConflict(err error) bool {
+	var applyConflict *applyConflictError
+
+	return errors.As(err, &applyConflict)
+}
+
+// NewWaitTimeout creates a new error that indicates that waiting for a resource to reach some state ended before the
+// state was reached. The lastObserved value is the last form of the resource that was seen, which may be nil if the
+// resource did not exist, and reason describes why it did not match. It wraps the error from the context, so it will
+// also satisfy errors.Is for context.DeadlineExceeded or context.Canceled. The returned error is exported so callers
+// may retrieve the details using errors.As.
+func NewWaitTimeout(
+	resourceKey key.ResourceKey, lastObserved any, reason string, err error) *goinfraerrors.WaitTimeoutError {
+	return goinfraerrors.NewWaitTimeout(
+		resourceKey.Kind, resourceKey.Name, resourceKey.Namespace, lastObserved, reason, err)
+}
+
+// IsWaitTimeout returns true if an error, or any error in the error's tree, is due to a wait ending before the desired
+// state was reached.
+func IsWaitTimeout(err error) bool {
+	return goinfraerrors.IsWaitTimeout(err)
+}
+
+// GetWaitTimeoutDetails returns the last observed form of the resource and the reason it did not match from a wait
+// timeout error. If the error is not a wait timeout, ok is false.
+func GetWaitTimeoutDetails(err error) (lastObserved any, reason string, ok bool) {
+	var waitTimeout *goinfraerrors.WaitTimeoutError
+
+	if !errors.As(err, &waitTimeout) {
+		return nil, "", false
+	}
+
+	return waitTimeout.LastObserved, waitTimeout.Reason, true
+}
+
+type manifestInvalidError struct {
+	resourceKey key.ResourceKey
+	err         error
+}
+
+var _ error = (*manifestInvalidError)(nil)
+
+// NewManifestInvalid creates a new error that indicates that a manifest could not be decoded into the builder's
+// definition, either because it is malformed, has the wrong kind, or contains fields unknown to the scheme. It wraps
+// the error returned while decoding.
+func NewManifestInvalid(resourceKey key.ResourceKey, err error) *manifestInvalidError {
+	return &manifestInvalidError{resourceKey: resourceKey, err: err}
+}
+
+func (e *manifestInvalidError) Error() string {
+	return fmt.Sprintf("invalid manifest for %s: %v", e.resourceKey.String(), e.err)
+}
+
+// Is returns true if the target is [goinfraerrors.ErrInvalidBuilder].
+func (e *manifestInvalidError) Is(target error) bool {
+	return target == goinfraerrors.ErrInvalidBuilder
+}
+
+func (e *manifestInvalidError) Unwrap() error {
+	return e.err
+}
+
+// IsManifestInvalid returns true if an error, or any error in the error's tree, is due to an invalid manifest.
+func IsManifestInvalid(err error) bool {
+	var manifestInvalid *manifestInvalidError
+
+	return errors.As(err, &manifestInvalid)
+}
@@ -0,0 +1,53 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestErrorsMatchExportedSentinels(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildWaitTestConfigMap(false)},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	_, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		t.Context(), testSettings, testSchemeAttacher, "missing", waitTestNamespace)
+	assert.True(t, errors.Is(err, goinfraerrors.ErrNotFound), "unexpected error, got: %v", err)
+	assert.True(t, goinfraerrors.IsNotFound(err))
+
+	_, err = common.PullNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		t.Context(), nil, testSchemeAttacher, waitTestName, waitTestNamespace)
+	assert.True(t, errors.Is(err, goinfraerrors.ErrInvalidBuilder), "unexpected error, got: %v", err)
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, waitTestName, "")
+	assert.True(t, errors.Is(common.Validate(builder), goinfraerrors.ErrInvalidBuilder))
+
+	builder = common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	err = builder.WaitFor(ctx, common.IsDeleted[corev1.ConfigMap]())
+	require.True(t, errors.Is(err, goinfraerrors.ErrWaitTimeout), "unexpected error, got: %v", err)
+
+	var waitTimeout *goinfraerrors.WaitTimeoutError
+
+	require.True(t, errors.As(err, &waitTimeout))
+	assert.Equal(t, "resource still exists", waitTimeout.Reason)
+	assert.NotNil(t, waitTimeout.LastObserved)
+}
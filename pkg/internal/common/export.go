@@ -0,0 +1,96 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// exportStrippedMetadataFields are the metadata fields set by the API server which are removed when exporting a
+// manifest.
+var exportStrippedMetadataFields = []string{
+	"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "selfLink",
+}
+
+// ToJSON returns the builder's definition as a JSON manifest suitable for applying to a cluster. The apiVersion and
+// kind are set from the builder's GVK, while the status and server-populated metadata, such as managedFields and
+// resourceVersion, are removed. Unlike other functions, the builder does not need a client.
+func ToJSON[O any, SO ObjectPointer[O]](builder Builder[O, SO]) ([]byte, error) {
+	if isInterfaceNil(builder) {
+		return nil, errors.NewBuilderNil()
+	}
+
+	if builder.GetDefinition() == nil {
+		return nil, errors.NewBuilderDefinitionNil(builder.GetGVK().Kind)
+	}
+
+	if err := builder.GetError(); err != nil {
+		return nil, err
+	}
+
+	return ExportObjectJSON(builder.GetDefinition(), builder.GetGVK())
+}
+
+// ToYAML is the same as [ToJSON] but returns a YAML manifest.
+func ToYAML[O any, SO ObjectPointer[O]](builder Builder[O, SO]) ([]byte, error) {
+	jsonManifest, err := ToJSON(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.JSONToYAML(jsonManifest)
+}
+
+// ToJSON returns the definition as a JSON manifest. See [ToJSON] for details.
+func (b *EmbeddableBuilder[O, SO]) ToJSON() ([]byte, error) {
+	return ToJSON(b)
+}
+
+// ToYAML returns the definition as a YAML manifest. See [ToYAML] for details.
+func (b *EmbeddableBuilder[O, SO]) ToYAML() ([]byte, error) {
+	return ToYAML(b)
+}
+
+// ExportObjectJSON serializes an object as a JSON manifest with the provided apiVersion and kind, removing the status
+// and server-populated metadata. It is exported so builders which do not embed [EmbeddableBuilder] can produce the same
+// manifests as [ToJSON].
+func ExportObjectJSON(object runtime.Object, gvk schema.GroupVersionKind) ([]byte, error) {
+	if object == nil {
+		return nil, fmt.Errorf("cannot export nil object")
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to unstructured: %w", gvk.Kind, err)
+	}
+
+	manifest := &unstructured.Unstructured{Object: content}
+	manifest.SetGroupVersionKind(gvk)
+
+	for _, field := range exportStrippedMetadataFields {
+		unstructured.RemoveNestedField(manifest.Object, "metadata", field)
+	}
+
+	unstructured.RemoveNestedField(manifest.Object, "status")
+
+	logging.FromClient(nil).V(logging.LevelDebug).Info("Exporting manifest",
+		"kind", gvk.Kind, "name", manifest.GetName(), "namespace", manifest.GetNamespace())
+
+	return json.Marshal(manifest.Object)
+}
+
+// ExportObjectYAML is the same as [ExportObjectJSON] but returns a YAML manifest.
+func ExportObjectYAML(object runtime.Object, gvk schema.GroupVersionKind) ([]byte, error) {
+	jsonManifest, err := ExportObjectJSON(object, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.JSONToYAML(jsonManifest)
+}
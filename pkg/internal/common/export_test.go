@@ -0,0 +1,79 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToYAML(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		builder      *mockNamespacedBuilder
+		expectedYAML string
+		assertError  func(error) bool
+	}{
+		{
+			name:    "valid builder strips server fields",
+			builder: buildExportTestBuilder(),
+			expectedYAML: "apiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n" +
+				"  labels:\n    app: test\n  name: export-test-name\n  namespace: export-test-namespace\n",
+			assertError: isNilError,
+		},
+		{
+			name:        "nil builder",
+			builder:     nil,
+			assertError: commonerrors.IsBuilderNil,
+		},
+		{
+			name: "nil definition",
+			builder: func() *mockNamespacedBuilder {
+				builder := buildExportTestBuilder()
+				builder.SetDefinition(nil)
+
+				return builder
+			}(),
+			assertError: commonerrors.IsBuilderDefinitionNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifest, err := common.ToYAML(testCase.builder)
+			assert.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
+			assert.Equal(t, testCase.expectedYAML, string(manifest))
+		})
+	}
+}
+
+func TestEmbeddableBuilderToJSON(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := buildExportTestBuilder().ToJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"apiVersion": "v1", "kind": "ConfigMap", "data": {"key": "value"}, "metadata": {
+		"name": "export-test-name", "namespace": "export-test-namespace", "labels": {"app": "test"}}}`, string(manifest))
+}
+
+func buildExportTestBuilder() *mockNamespacedBuilder {
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		clients.GetTestClients(clients.TestClientParams{}), testSchemeAttacher, "export-test-name", "export-test-namespace")
+
+	builder.GetDefinition().Labels = map[string]string{"app": "test"}
+	builder.GetDefinition().Data = map[string]string{"key": "value"}
+	builder.GetDefinition().ResourceVersion = "12"
+	builder.GetDefinition().UID = "export-test-uid"
+	builder.GetDefinition().CreationTimestamp = metav1.Now()
+	builder.GetDefinition().ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "test"}}
+
+	return builder
+}
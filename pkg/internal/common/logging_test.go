@@ -0,0 +1,84 @@
+package common_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestOperationLogging(t *testing.T) {
+	t.Parallel()
+
+	settingsLogger, settingsEntries := newTestJSONLogger(logging.LevelOperation)
+	contextLogger, contextEntries := newTestJSONLogger(logging.LevelOperation)
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+	testSettings.Logger = settingsLogger
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+	err := common.Create(t.Context(), builder)
+	require.NoError(t, err)
+
+	entries := settingsEntries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "API call succeeded", entries[0]["msg"])
+	assert.Equal(t, "ConfigMap", entries[0]["kind"])
+	assert.Equal(t, waitTestName, entries[0]["name"])
+	assert.Equal(t, waitTestNamespace, entries[0]["namespace"])
+	assert.Equal(t, "create", entries[0]["operation"])
+	assert.Contains(t, entries[0], "duration")
+
+	err = common.Delete(logr.NewContext(t.Context(), contextLogger), builder)
+	require.NoError(t, err)
+
+	assert.Len(t, settingsEntries(), 1, "context logger should take precedence over the settings logger")
+
+	entries = contextEntries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "delete", entries[0]["operation"])
+
+	// Getting a resource which does not exist is routine, so it is only logged at LevelDebug.
+	_, err = common.Get(logr.NewContext(t.Context(), contextLogger), builder)
+	require.Error(t, err)
+
+	assert.Len(t, contextEntries(), 1, "not found errors should not be logged at LevelOperation")
+}
+
+// newTestJSONLogger returns a logger which records entries up to the provided verbosity and a function returning the
+// recorded entries decoded from JSON.
+func newTestJSONLogger(verbosity int) (logr.Logger, func() []map[string]any) {
+	var (
+		mutex   sync.Mutex
+		entries []map[string]any
+	)
+
+	logger := funcr.NewJSON(func(obj string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		entry := map[string]any{}
+		if err := json.Unmarshal([]byte(obj), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}, funcr.Options{Verbosity: verbosity})
+
+	return logger, func() []map[string]any {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return entries
+	}
+}
@@ -0,0 +1,181 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClusterScopedBuilderFromManifest creates a new builder for a cluster-scoped resource whose definition is decoded
+// from a single YAML or JSON document. The manifest is validated against the client's scheme after attaching the
+// scheme, so unknown fields or a kind other than the builder's cause an error to be set on the builder, which may be
+// checked using [errors.IsManifestInvalid]. As with [NewClusterScopedBuilder], the manifest must include a name.
+func NewClusterScopedBuilderFromManifest[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]](
+	apiClient runtimeclient.Client, schemeAttacher clients.SchemeAttacher, manifest []byte) SB {
+	builder := newBuilderFromManifest[O, B, SO, SB](apiClient, schemeAttacher, manifest)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if builder.GetDefinition().GetName() == "" {
+		resourceKey := NewResourceKeyFromBuilder(builder)
+
+		newResourceLogger(logging.FromClient(builder.GetClient()), resourceKey).
+			V(logging.LevelDebug).Info("The name of the manifest is empty")
+
+		builder.SetError(commonerrors.NewBuilderFieldEmpty(resourceKey, commonerrors.BuilderFieldName))
+	}
+
+	return builder
+}
+
+// NewNamespacedBuilderFromManifest is the same as [NewClusterScopedBuilderFromManifest] but for namespaced resources.
+// The manifest must include both a name and namespace.
+func NewNamespacedBuilderFromManifest[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]](
+	apiClient runtimeclient.Client, schemeAttacher clients.SchemeAttacher, manifest []byte) SB {
+	builder := NewClusterScopedBuilderFromManifest[O, B, SO, SB](apiClient, schemeAttacher, manifest)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if builder.GetDefinition().GetNamespace() == "" {
+		resourceKey := NewResourceKeyFromBuilder(builder)
+
+		newResourceLogger(logging.FromClient(builder.GetClient()), resourceKey).
+			V(logging.LevelDebug).Info("The namespace of the manifest is empty")
+
+		builder.SetError(commonerrors.NewBuilderFieldEmpty(resourceKey, commonerrors.BuilderFieldNamespace))
+	}
+
+	return builder
+}
+
+// newBuilderFromManifest creates a new builder and sets its definition by decoding the manifest. Any errors are set on
+// the returned builder.
+func newBuilderFromManifest[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]](
+	apiClient runtimeclient.Client, schemeAttacher clients.SchemeAttacher, manifest []byte) SB {
+	var builder SB = new(B)
+
+	if mixinAttacher, ok := any(builder).(MixinAttacher); ok {
+		mixinAttacher.AttachMixins()
+	}
+
+	builder.SetGVK(builder.GetGVK())
+	builder.SetClient(apiClient)
+	builder.SetDefinition(new(O))
+
+	resourceKey := NewResourceKeyFromBuilder(builder)
+	logger := newResourceLogger(logging.FromClient(apiClient), resourceKey)
+
+	logger.V(logging.LevelDebug).Info("Initializing new builder from manifest")
+
+	if isInterfaceNil(apiClient) {
+		logger.V(logging.LevelDebug).Info("The apiClient provided is nil")
+
+		builder.SetError(commonerrors.NewAPIClientNil(resourceKey))
+
+		return builder
+	}
+
+	err := schemeAttacher(apiClient.Scheme())
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to attach scheme", "error", err)
+
+		builder.SetError(commonerrors.NewSchemeAttacherFailed(resourceKey, err))
+
+		return builder
+	}
+
+	definition, err := DecodeManifest[O, SO](apiClient.Scheme(), manifest)
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to decode manifest", "error", err)
+
+		builder.SetError(commonerrors.NewManifestInvalid(resourceKey, err))
+
+		return builder
+	}
+
+	builder.SetDefinition(definition)
+
+	return builder
+}
+
+// DecodeManifest decodes a single YAML or JSON document into a new object of type O. Decoding is strict, so fields
+// unknown to the scheme and duplicate fields are errors, and the kind in the manifest must be one the scheme registers
+// for type O.
+func DecodeManifest[O any, SO ObjectPointer[O]](scheme *runtime.Scheme, manifest []byte) (SO, error) {
+	if scheme == nil {
+		return nil, fmt.Errorf("cannot decode manifest using nil scheme")
+	}
+
+	jsonManifest, err := yaml.ToJSON(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert manifest to JSON: %w", err)
+	}
+
+	var object SO = new(O)
+
+	expectedGVKs, _, err := scheme.ObjectKinds(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kind of %T from scheme: %w", object, err)
+	}
+
+	decoder := serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDeserializer()
+
+	_, gvk, err := decoder.Decode(jsonManifest, nil, object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	if !slices.Contains(expectedGVKs, *gvk) {
+		return nil, fmt.Errorf("manifest has kind %s but expected %s", gvk.String(), expectedGVKs[0].String())
+	}
+
+	return object, nil
+}
+
+// SplitManifestDocuments splits a stream of YAML documents separated by "---", or a single JSON document, into the
+// individual documents. Empty documents are skipped.
+func SplitManifestDocuments(manifests []byte) ([][]byte, error) {
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifests)))
+
+	var documents [][]byte
+
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest document: %w", err)
+		}
+
+		if len(bytes.TrimSpace(document)) > 0 && !isCommentOnlyDocument(document) {
+			documents = append(documents, document)
+		}
+	}
+}
+
+// isCommentOnlyDocument returns true if every non-empty line of the document is a YAML comment.
+func isCommentOnlyDocument(document []byte) bool {
+	for _, line := range bytes.Split(document, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && line[0] != '#' && !bytes.Equal(line, []byte("---")) {
+			return false
+		}
+	}
+
+	return true
+}
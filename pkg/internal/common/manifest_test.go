@@ -0,0 +1,126 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	manifestTestConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: manifest-test-name
+  namespace: manifest-test-namespace
+data:
+  key: value
+`
+	manifestTestNamespace = `{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "manifest-test-name"}}`
+)
+
+func TestNewNamespacedBuilderFromManifest(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		manifest    string
+		client      bool
+		assertError func(error) bool
+	}{
+		{
+			name:        "valid yaml manifest",
+			manifest:    manifestTestConfigMap,
+			client:      true,
+			assertError: isNilError,
+		},
+		{
+			name:        "nil client",
+			manifest:    manifestTestConfigMap,
+			client:      false,
+			assertError: commonerrors.IsAPIClientNil,
+		},
+		{
+			name:        "wrong kind",
+			manifest:    manifestTestNamespace,
+			client:      true,
+			assertError: commonerrors.IsManifestInvalid,
+		},
+		{
+			name:        "unknown field",
+			manifest:    manifestTestConfigMap + "unknownField: true\n",
+			client:      true,
+			assertError: commonerrors.IsManifestInvalid,
+		},
+		{
+			name:        "malformed yaml",
+			manifest:    "apiVersion: v1\nkind: [ConfigMap\n",
+			client:      true,
+			assertError: commonerrors.IsManifestInvalid,
+		},
+		{
+			name:        "missing namespace",
+			manifest:    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: manifest-test-name\n",
+			client:      true,
+			assertError: commonerrors.IsBuilderNamespaceEmpty,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var apiClient runtimeclient.Client
+
+			if testCase.client {
+				apiClient = clients.GetTestClients(clients.TestClientParams{
+					K8sMockObjects:  []runtime.Object{},
+					SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+				})
+			}
+
+			builder := common.NewNamespacedBuilderFromManifest[corev1.ConfigMap, mockNamespacedBuilder](
+				apiClient, testSchemeAttacher, []byte(testCase.manifest))
+			require.NotNil(t, builder)
+			assert.Truef(t, testCase.assertError(builder.GetError()), "unexpected error, got: %v", builder.GetError())
+
+			if builder.GetError() == nil {
+				assert.Equal(t, "manifest-test-name", builder.GetDefinition().Name)
+				assert.Equal(t, "manifest-test-namespace", builder.GetDefinition().Namespace)
+				assert.Equal(t, map[string]string{"key": "value"}, builder.GetDefinition().Data)
+			}
+		})
+	}
+}
+
+func TestNewClusterScopedBuilderFromManifest(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	builder := common.NewClusterScopedBuilderFromManifest[corev1.Namespace, mockClusterScopedBuilder](
+		testSettings, testSchemeAttacher, []byte(manifestTestNamespace))
+	require.NotNil(t, builder)
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, "manifest-test-name", builder.GetDefinition().Name)
+
+	builder = common.NewClusterScopedBuilderFromManifest[corev1.Namespace, mockClusterScopedBuilder](
+		testSettings, testSchemeAttacher, []byte(`{"apiVersion": "v1", "kind": "Namespace"}`))
+	assert.True(t, commonerrors.IsBuilderNameEmpty(builder.GetError()))
+}
+
+func TestSplitManifestDocuments(t *testing.T) {
+	t.Parallel()
+
+	documents, err := common.SplitManifestDocuments([]byte(
+		"# leading comment\n---\n" + manifestTestConfigMap + "---\n\n---\n" + manifestTestNamespace + "\n"))
+	assert.NoError(t, err)
+	assert.Len(t, documents, 2)
+}
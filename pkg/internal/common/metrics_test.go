@@ -0,0 +1,85 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestOperationMetrics(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+		InterceptorFuncs: interceptor.Funcs{Create: func(
+			ctx context.Context, client runtimeclient.WithWatch, object runtimeclient.Object,
+			options ...runtimeclient.CreateOption,
+		) error {
+			attempts++
+			if attempts == 1 {
+				return k8serrors.NewTooManyRequests("throttled", 0)
+			}
+
+			return client.Create(ctx, object, options...)
+		}},
+	})
+	testSettings.RetryPolicy = buildRetryTestPolicy(2)
+	testSettings.Metrics = clients.NewMetrics()
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+	_, err := common.Get(t.Context(), builder)
+	require.Error(t, err)
+
+	err = common.Create(t.Context(), builder)
+	require.NoError(t, err)
+
+	_, err = common.List[corev1.ConfigMap, corev1.ConfigMapList, mockNamespacedBuilder](
+		t.Context(), testSettings, testSchemeAttacher)
+	require.NoError(t, err)
+
+	err = common.WaitUntil(t.Context(), builder, time.Second, func(object *corev1.ConfigMap) (bool, error) {
+		return object != nil, nil
+	})
+	require.NoError(t, err)
+
+	summaries := map[string]clients.OperationSummary{}
+	for _, summary := range testSettings.Metrics.Summary() {
+		assert.Equal(t, "ConfigMap", summary.GVK.Kind)
+		summaries[summary.Operation] = summary
+	}
+
+	require.Len(t, summaries, 4)
+	assert.Equal(t, clients.OperationSummary{
+		GVK: summaries["get"].GVK, Operation: "get", Count: 1, Failures: 1,
+		TotalDuration: summaries["get"].TotalDuration, MaxDuration: summaries["get"].MaxDuration,
+	}, summaries["get"])
+	assert.Equal(t, 1, summaries["create"].Count)
+	assert.Equal(t, 0, summaries["create"].Failures)
+	assert.Equal(t, 1, summaries["create"].Retries)
+	assert.Equal(t, 1, summaries["list"].Count)
+	assert.Equal(t, 1, summaries["wait"].Count)
+
+	families, err := testSettings.Metrics.Registry().Gather()
+	require.NoError(t, err)
+
+	names := map[string]bool{}
+	for _, family := range families {
+		names[family.GetName()] = true
+	}
+
+	assert.True(t, names["eco_goinfra_operations_total"])
+	assert.True(t, names["eco_goinfra_operation_duration_seconds"])
+	assert.True(t, names["eco_goinfra_retries_total"])
+}
@@ -0,0 +1,234 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Patch sends a patch of the provided type to the resource described by the builder. The patchType may be
+// types.MergePatchType, types.StrategicMergePatchType, or types.JSONPatchType, with the data formatted to match.
+// Strategic merge patches are only supported for built-in types. Unlike [Update], this only changes the fields in the
+// patch, so it does not conflict with controllers writing other fields of the same resource.
+//
+// If successful, the builder's object is updated with the patched resource returned from the cluster. The builder's
+// definition is not changed.
+func Patch[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], patchType types.PatchType, data []byte) error {
+	if err := Validate(builder); err != nil {
+		return err
+	}
+
+	logger := newOperationLogger(ctx, builder, "patch")
+
+	logger.V(logging.LevelDebug).Info("Patching resource", "patchType", patchType)
+
+	object, err := patchResource(ctx, builder, logger, patchType, data)
+	if err != nil {
+		return err
+	}
+
+	builder.SetObject(object)
+
+	return nil
+}
+
+// PatchChanges sends a patch containing only the changes made to the builder's definition since its object was last
+// set, such as by Pull, Get, or a previous update. This allows the builder modifiers to be used without overwriting
+// changes other clients made to the resource after it was pulled. The patch is computed from the object to the
+// definition using the provided patchType, which may be types.MergePatchType, types.StrategicMergePatchType, or
+// types.JSONPatchType. No request is sent if there are no changes.
+//
+// The patch is scoped to the fields clients set, such as the spec, data, labels, and annotations. Differences in the
+// status, apiVersion, kind, and the metadata fields set by the server, such as the resourceVersion and managedFields,
+// are left out, so a stale status or resourceVersion in the definition is never sent. Since every other difference
+// between the object and definition is sent, the definition should start as a copy of the object, as it does after
+// Pull. If successful, both the definition and object are updated with the patched resource returned from the cluster.
+func PatchChanges[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO], patchType types.PatchType) error {
+	if err := Validate(builder); err != nil {
+		return err
+	}
+
+	resourceKey := NewResourceKeyFromBuilder(builder)
+	logger := newOperationLogger(ctx, builder, "patch")
+
+	if builder.GetObject() == nil {
+		logger.V(logging.LevelDebug).Info("Cannot patch changes since the object is nil")
+
+		return errors.NewBuilderObjectNil(resourceKey.Kind)
+	}
+
+	data, err := createPatch(builder.GetObject(), builder.GetDefinition(), patchType)
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to create patch", "error", err)
+
+		return fmt.Errorf("failed to create patch for %s: %w", resourceKey.String(), err)
+	}
+
+	if isEmptyPatch(data) {
+		logger.V(logging.LevelDebug).Info("No changes to patch")
+
+		return nil
+	}
+
+	logger.V(logging.LevelDebug).Info("Patching changes", "patchType", patchType, "patch", string(data))
+
+	object, err := patchResource(ctx, builder, logger, patchType, data)
+	if err != nil {
+		return err
+	}
+
+	builder.SetObject(object)
+	builder.SetDefinition(deepCopy(object))
+
+	return nil
+}
+
+// patchResource sends the patch and returns the resource as returned from the cluster.
+func patchResource[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], logger logr.Logger, patchType types.PatchType, data []byte) (SO, error) {
+	var object SO = new(O)
+
+	object.SetName(builder.GetDefinition().GetName())
+	object.SetNamespace(builder.GetDefinition().GetNamespace())
+
+	err := retryAPICall(ctx, builder, "patch", logger, func(ctx context.Context) error {
+		return builder.GetClient().Patch(ctx, object, runtimeclient.RawPatch(patchType, data))
+	})
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to patch resource", "error", err)
+
+		return nil, errors.NewAPICallFailed("patch", NewResourceKeyFromBuilder(builder), err)
+	}
+
+	return object, nil
+}
+
+// serverManagedMetadataFields are the fields of the object metadata which are set by the server and so are left out of
+// the patches created by PatchChanges.
+var serverManagedMetadataFields = []string{
+	"creationTimestamp",
+	"deletionGracePeriodSeconds",
+	"deletionTimestamp",
+	"generation",
+	"managedFields",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+// createPatch creates a patch of the provided type which changes original into modified. Only changes to the fields
+// clients set are included; see [isPatchableField].
+func createPatch[O any, SO ObjectPointer[O]](original, modified SO, patchType types.PatchType) ([]byte, error) {
+	switch patchType {
+	case types.MergePatchType:
+		data, err := runtimeclient.MergeFrom(original).Data(modified)
+		if err != nil {
+			return nil, err
+		}
+
+		return scopeMergePatch(data)
+	case types.StrategicMergePatchType:
+		data, err := runtimeclient.StrategicMergeFrom(original).Data(modified)
+		if err != nil {
+			return nil, err
+		}
+
+		return scopeMergePatch(data)
+	case types.JSONPatchType:
+		originalJSON, err := json.Marshal(original)
+		if err != nil {
+			return nil, err
+		}
+
+		modifiedJSON, err := json.Marshal(modified)
+		if err != nil {
+			return nil, err
+		}
+
+		operations, err := jsonpatch.CreatePatch(originalJSON, modifiedJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		scopedOperations := []jsonpatch.Operation{}
+
+		for _, operation := range operations {
+			if isPatchableField(strings.Split(strings.TrimPrefix(operation.Path, "/"), "/")) {
+				scopedOperations = append(scopedOperations, operation)
+			}
+		}
+
+		return json.Marshal(scopedOperations)
+	default:
+		return nil, fmt.Errorf("unsupported patch type %s", patchType)
+	}
+}
+
+// isEmptyPatch returns true if the patch makes no changes.
+func isEmptyPatch(data []byte) bool {
+	switch string(data) {
+	case "{}", "[]", "null":
+		return true
+	default:
+		return false
+	}
+}
+
+// scopeMergePatch removes the fields which are not patchable, according to [isPatchableField], from a merge or
+// strategic merge patch.
+func scopeMergePatch(data []byte) ([]byte, error) {
+	var patch map[string]any
+
+	err := json.Unmarshal(data, &patch)
+	if err != nil {
+		return nil, err
+	}
+
+	for field := range patch {
+		if field != "metadata" && !isPatchableField([]string{field}) {
+			delete(patch, field)
+		}
+	}
+
+	if metadata, ok := patch["metadata"].(map[string]any); ok {
+		for field := range metadata {
+			if !isPatchableField([]string{"metadata", field}) {
+				delete(metadata, field)
+			}
+		}
+
+		if len(metadata) == 0 {
+			delete(patch, "metadata")
+		}
+	}
+
+	return json.Marshal(patch)
+}
+
+// isPatchableField returns true if the field at the provided path, given as a list of JSON keys starting from the root
+// of the object, is one that clients set. The status, apiVersion, kind, and server-managed metadata fields are not
+// patchable.
+func isPatchableField(path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+
+	switch path[0] {
+	case "status", "apiVersion", "kind":
+		return false
+	case "metadata":
+		return len(path) > 1 && !slices.Contains(serverManagedMetadataFields, path[1])
+	default:
+		return true
+	}
+}
@@ -0,0 +1,218 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var errPatchTestFailure = errors.New("patch should not be sent")
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		objectExists bool
+		patchType    types.PatchType
+		data         string
+		assertError  func(error) bool
+	}{
+		{
+			name:         "merge patch",
+			objectExists: true,
+			patchType:    types.MergePatchType,
+			data:         `{"data":{"patched":"true"}}`,
+			assertError:  isNilError,
+		},
+		{
+			name:         "strategic merge patch",
+			objectExists: true,
+			patchType:    types.StrategicMergePatchType,
+			data:         `{"data":{"patched":"true"}}`,
+			assertError:  isNilError,
+		},
+		{
+			name:         "json patch",
+			objectExists: true,
+			patchType:    types.JSONPatchType,
+			data:         `[{"op":"add","path":"/data","value":{"patched":"true"}}]`,
+			assertError:  isNilError,
+		},
+		{
+			name:         "resource does not exist",
+			objectExists: false,
+			patchType:    types.MergePatchType,
+			data:         `{"data":{"patched":"true"}}`,
+			assertError:  isAPICallFailedWithPatch,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var objects []runtime.Object
+
+			if testCase.objectExists {
+				objects = append(objects, buildWaitTestConfigMap(false))
+			}
+
+			builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+				clients.GetTestClients(clients.TestClientParams{
+					K8sMockObjects:  objects,
+					SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+				}), testSchemeAttacher, waitTestName, waitTestNamespace)
+
+			err := common.Patch(t.Context(), builder, testCase.patchType, []byte(testCase.data))
+			assert.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
+
+			if err == nil {
+				assert.Equal(t, "true", builder.GetObject().Data["patched"])
+				assert.Empty(t, builder.GetDefinition().Data)
+			}
+		})
+	}
+}
+
+func TestPatchChanges(t *testing.T) {
+	t.Parallel()
+
+	for _, patchType := range []types.PatchType{
+		types.MergePatchType, types.StrategicMergePatchType, types.JSONPatchType} {
+		t.Run(string(patchType), func(t *testing.T) {
+			t.Parallel()
+
+			existing := buildWaitTestConfigMap(false)
+			existing.Data = map[string]string{"original": "true"}
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{existing},
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+			})
+
+			builder, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockPatcherBuilder](
+				t.Context(), testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+			require.NoError(t, err)
+
+			// Another client changes the resource after it was pulled, which must not be overwritten.
+			concurrent := existing.DeepCopy()
+			concurrent.Data["concurrent"] = "true"
+			require.NoError(t, testSettings.Update(t.Context(), concurrent))
+
+			builder.GetDefinition().Data["builder"] = "true"
+
+			_, err = builder.PatchChanges(patchType)
+			require.NoError(t, err)
+
+			expectedData := map[string]string{"original": "true", "concurrent": "true", "builder": "true"}
+			assert.Equal(t, expectedData, builder.GetObject().Data)
+			assert.Equal(t, expectedData, builder.GetDefinition().Data)
+		})
+	}
+}
+
+func TestPatchChangesScope(t *testing.T) {
+	t.Parallel()
+
+	for _, patchType := range []types.PatchType{
+		types.MergePatchType, types.StrategicMergePatchType, types.JSONPatchType} {
+		t.Run(string(patchType), func(t *testing.T) {
+			t.Parallel()
+
+			var patchData []byte
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildWaitTestConfigMap(false)},
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+				InterceptorFuncs: interceptor.Funcs{Patch: func(ctx context.Context, client runtimeclient.WithWatch,
+					object runtimeclient.Object, patch runtimeclient.Patch, opts ...runtimeclient.PatchOption) error {
+					var err error
+
+					patchData, err = patch.Data(object)
+					if err != nil {
+						return err
+					}
+
+					return client.Patch(ctx, object, patch, opts...)
+				}},
+			})
+
+			builder, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockPatcherBuilder](
+				t.Context(), testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+			require.NoError(t, err)
+
+			// Server-managed fields in the definition which differ from the object must not be sent.
+			builder.GetDefinition().ResourceVersion = "stale"
+			builder.GetDefinition().UID = "stale"
+			builder.GetDefinition().Kind = "Stale"
+			builder.GetDefinition().Labels = map[string]string{"builder": "true"}
+
+			_, err = builder.PatchChanges(patchType)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(patchData), "builder")
+			assert.NotContains(t, string(patchData), "stale")
+			assert.NotContains(t, string(patchData), "Stale")
+			assert.Equal(t, map[string]string{"builder": "true"}, builder.GetObject().Labels)
+		})
+	}
+}
+
+func TestPatchChangesWithoutChanges(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildWaitTestConfigMap(false)},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+		InterceptorFuncs: interceptor.Funcs{Patch: func(
+			context.Context, runtimeclient.WithWatch, runtimeclient.Object, runtimeclient.Patch, ...runtimeclient.PatchOption,
+		) error {
+			return errPatchTestFailure
+		}},
+	})
+
+	builder, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockPatcherBuilder](
+		t.Context(), testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+	require.NoError(t, err)
+
+	_, err = builder.PatchChanges(types.MergePatchType)
+	assert.NoError(t, err)
+
+	builder.SetObject(nil)
+
+	_, err = builder.PatchChanges(types.MergePatchType)
+	assert.True(t, commonerrors.IsBuilderObjectNil(err))
+}
+
+// mockPatcherBuilder is a namespaced builder with the EmbeddablePatcher mixin.
+type mockPatcherBuilder struct {
+	common.EmbeddableBuilder[corev1.ConfigMap, *corev1.ConfigMap]
+	common.EmbeddablePatcher[corev1.ConfigMap, mockPatcherBuilder, *corev1.ConfigMap, *mockPatcherBuilder]
+}
+
+// AttachMixins attaches the mixins to the mock patcher builder.
+func (builder *mockPatcherBuilder) AttachMixins() {
+	builder.EmbeddablePatcher.SetBase(builder)
+}
+
+// GetGVK returns the GVK for the mock patcher builder.
+func (builder *mockPatcherBuilder) GetGVK() schema.GroupVersionKind {
+	return namespacedGVK
+}
+
+func isAPICallFailedWithPatch(err error) bool {
+	return commonerrors.IsAPICallFailedWithVerb(err, "patch")
+}
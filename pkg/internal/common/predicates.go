@@ -0,0 +1,226 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Predicate is a function that checks whether an object is in some desired state. If it is not, the returned reason
+// should describe the mismatch so it can be reported when a wait times out. As with [ObjectCondition], the object is
+// nil when the resource does not exist.
+type Predicate[O any, SO ObjectPointer[O]] func(object SO) (matched bool, reason string)
+
+// WaitFor waits until the predicate matches the resource described by the builder or the context is done. It uses the
+// same watch-based waiting as [WaitUntil], but relies on the context for the deadline. If the context is done before
+// the predicate matches, the returned error satisfies [errors.IsWaitTimeout] and includes the last observed object and
+// the reason it did not match, which may be retrieved using [errors.GetWaitTimeoutDetails].
+func WaitFor[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO], predicate Predicate[O, SO]) error {
+	if err := Validate(builder); err != nil {
+		return err
+	}
+
+	resourceKey := NewResourceKeyFromBuilder(builder)
+	logger := newOperationLogger(ctx, builder, "wait")
+
+	logger.V(logging.LevelDebug).Info("Waiting for predicate")
+
+	var (
+		lastObserved SO
+		lastReason   string
+	)
+
+	err := waitForCondition(ctx, builder, func(object SO) (bool, error) {
+		matched, reason := predicate(object)
+		lastObserved, lastReason = object, reason
+
+		return matched, nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		logger.V(logging.LevelDebug).Info("Failed waiting for predicate", "lastObservedState", lastReason)
+
+		return errors.NewWaitTimeout(resourceKey, lastObserved, lastReason, err)
+	}
+
+	return err
+}
+
+// WaitFor waits until the predicate matches the resource or the context is done. See [WaitFor] for details.
+func (b *EmbeddableBuilder[O, SO]) WaitFor(ctx context.Context, predicate Predicate[O, SO]) error {
+	return WaitFor(ctx, b, predicate)
+}
+
+// AllOf returns a predicate which matches only when all of the provided predicates match. The reason is the reason of
+// the first predicate that does not match.
+func AllOf[O any, SO ObjectPointer[O]](predicates ...Predicate[O, SO]) Predicate[O, SO] {
+	return func(object SO) (bool, string) {
+		for _, predicate := range predicates {
+			if matched, reason := predicate(object); !matched {
+				return false, reason
+			}
+		}
+
+		return true, ""
+	}
+}
+
+// IsDeleted returns a predicate which matches when the resource does not exist.
+func IsDeleted[O any, SO ObjectPointer[O]]() Predicate[O, SO] {
+	return func(object SO) (bool, string) {
+		if object == nil {
+			return true, ""
+		}
+
+		return false, "resource still exists"
+	}
+}
+
+// HasCondition returns a predicate which matches when the resource has a condition of the provided type with the
+// provided status. Conditions are read from status.conditions and compared using their type and status fields, so this
+// works for metav1.Condition as well as resource-specific condition types such as corev1.PodCondition.
+func HasCondition[O any, SO ObjectPointer[O]](conditionType, status string) Predicate[O, SO] {
+	return HasConditionWithReason[O, SO](conditionType, status, "")
+}
+
+// HasConditionWithReason is the same as [HasCondition] but also requires the condition to have the provided reason.
+// An empty reason matches any reason.
+func HasConditionWithReason[O any, SO ObjectPointer[O]](conditionType, status, reason string) Predicate[O, SO] {
+	return func(object SO) (bool, string) {
+		if object == nil {
+			return false, "resource does not exist"
+		}
+
+		condition, found, err := findStatusCondition(object, conditionType)
+		if err != nil {
+			return false, err.Error()
+		}
+
+		if !found {
+			return false, fmt.Sprintf("condition %s not found", conditionType)
+		}
+
+		actualStatus, _, _ := unstructured.NestedString(condition, "status")
+		actualReason, _, _ := unstructured.NestedString(condition, "reason")
+
+		if actualStatus != status {
+			return false, fmt.Sprintf("condition %s has status %q, expected %q (reason %q)",
+				conditionType, actualStatus, status, actualReason)
+		}
+
+		if reason != "" && actualReason != reason {
+			return false, fmt.Sprintf("condition %s has reason %q, expected %q", conditionType, actualReason, reason)
+		}
+
+		return true, ""
+	}
+}
+
+// ObservedGenerationCurrent returns a predicate which matches when status.observedGeneration is at least the
+// resource's metadata.generation, indicating that the controller has seen the latest spec. Resources without an
+// observedGeneration never match.
+func ObservedGenerationCurrent[O any, SO ObjectPointer[O]]() Predicate[O, SO] {
+	return func(object SO) (bool, string) {
+		if object == nil {
+			return false, "resource does not exist"
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return false, fmt.Sprintf("failed to convert to unstructured: %v", err)
+		}
+
+		observedGeneration, found, err := unstructured.NestedInt64(content, "status", "observedGeneration")
+		if err != nil || !found {
+			return false, "status.observedGeneration not set"
+		}
+
+		if observedGeneration < object.GetGeneration() {
+			return false, fmt.Sprintf("observedGeneration %d is behind generation %d",
+				observedGeneration, object.GetGeneration())
+		}
+
+		return true, ""
+	}
+}
+
+// JSONPathEquals returns a predicate which matches when the result of evaluating the JSONPath template against the
+// resource equals the expected value. The template uses the same syntax as kubectl, for example
+// "{.status.phase}". If the template cannot be parsed, the predicate never matches and the parse error is reported as
+// the reason.
+func JSONPathEquals[O any, SO ObjectPointer[O]](template, expected string) Predicate[O, SO] {
+	// The template is parsed once up front to report errors, but a new parser is used for each evaluation since
+	// executing templates with range blocks modifies the parser's state.
+	parseErr := jsonpath.New("predicate").Parse(template)
+
+	return func(object SO) (bool, string) {
+		if parseErr != nil {
+			return false, fmt.Sprintf("failed to parse JSONPath %s: %v", template, parseErr)
+		}
+
+		if object == nil {
+			return false, "resource does not exist"
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return false, fmt.Sprintf("failed to convert to unstructured: %v", err)
+		}
+
+		parser := jsonpath.New("predicate").AllowMissingKeys(true)
+		if err := parser.Parse(template); err != nil {
+			return false, fmt.Sprintf("failed to parse JSONPath %s: %v", template, err)
+		}
+
+		var buffer bytes.Buffer
+
+		err = parser.Execute(&buffer, content)
+		if err != nil {
+			return false, fmt.Sprintf("failed to evaluate JSONPath %s: %v", template, err)
+		}
+
+		actual := strings.TrimSpace(buffer.String())
+		if actual != expected {
+			return false, fmt.Sprintf("%s is %q, expected %q", template, actual, expected)
+		}
+
+		return true, ""
+	}
+}
+
+// findStatusCondition returns the condition of the provided type from the object's status.conditions. Since objects
+// are converted to unstructured, all condition types that have type and status fields are supported.
+func findStatusCondition(object runtime.Object, conditionType string) (map[string]any, bool, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to convert to unstructured: %w", err)
+	}
+
+	conditions, _, err := unstructured.NestedSlice(content, "status", "conditions")
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read status.conditions: %w", err)
+	}
+
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if actualType, _, _ := unstructured.NestedString(conditionMap, "type"); actualType == conditionType {
+			return conditionMap, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
@@ -0,0 +1,258 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWaitFor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		objectExists   bool
+		predicate      common.Predicate[corev1.ConfigMap, *corev1.ConfigMap]
+		expectedReason string
+	}{
+		{
+			name:         "predicate matches",
+			objectExists: true,
+			predicate:    common.JSONPathEquals[corev1.ConfigMap]("{.metadata.name}", waitTestName),
+		},
+		{
+			name:           "predicate does not match includes reason",
+			objectExists:   true,
+			predicate:      common.JSONPathEquals[corev1.ConfigMap]("{.data.ready}", "true"),
+			expectedReason: `{.data.ready} is "", expected "true"`,
+		},
+		{
+			name:           "deleted predicate does not match existing resource",
+			objectExists:   true,
+			predicate:      common.IsDeleted[corev1.ConfigMap](),
+			expectedReason: "resource still exists",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var objects []runtime.Object
+
+			if testCase.objectExists {
+				objects = append(objects, buildWaitTestConfigMap(false))
+			}
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  objects,
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+			})
+
+			builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+				testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+			ctx, cancel := context.WithTimeout(t.Context(), 500*time.Millisecond)
+			defer cancel()
+
+			err := builder.WaitFor(ctx, testCase.predicate)
+
+			if testCase.expectedReason == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.True(t, commonerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+			lastObserved, reason, ok := commonerrors.GetWaitTimeoutDetails(err)
+			require.True(t, ok)
+			assert.Equal(t, testCase.expectedReason, reason)
+			assert.NotNil(t, lastObserved)
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		pod       *corev1.Pod
+		predicate common.Predicate[corev1.Pod, *corev1.Pod]
+		matched   bool
+	}{
+		{
+			name:      "has condition matches",
+			pod:       buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.HasCondition[corev1.Pod](string(corev1.PodReady), string(corev1.ConditionTrue)),
+			matched:   true,
+		},
+		{
+			name:      "has condition wrong status",
+			pod:       buildPredicateTestPod(corev1.ConditionFalse),
+			predicate: common.HasCondition[corev1.Pod](string(corev1.PodReady), string(corev1.ConditionTrue)),
+			matched:   false,
+		},
+		{
+			name:      "has condition missing",
+			pod:       buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.HasCondition[corev1.Pod](string(corev1.PodScheduled), string(corev1.ConditionTrue)),
+			matched:   false,
+		},
+		{
+			name: "has condition with reason matches",
+			pod:  buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.HasConditionWithReason[corev1.Pod](
+				string(corev1.PodReady), string(corev1.ConditionTrue), "TestReason"),
+			matched: true,
+		},
+		{
+			name: "has condition with wrong reason",
+			pod:  buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.HasConditionWithReason[corev1.Pod](
+				string(corev1.PodReady), string(corev1.ConditionTrue), "OtherReason"),
+			matched: false,
+		},
+		{
+			name:      "has condition nil object",
+			pod:       nil,
+			predicate: common.HasCondition[corev1.Pod](string(corev1.PodReady), string(corev1.ConditionTrue)),
+			matched:   false,
+		},
+		{
+			name:      "jsonpath equals matches",
+			pod:       buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.JSONPathEquals[corev1.Pod]("{.status.phase}", string(corev1.PodRunning)),
+			matched:   true,
+		},
+		{
+			name:      "jsonpath equals invalid template",
+			pod:       buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.JSONPathEquals[corev1.Pod]("{.status.phase", string(corev1.PodRunning)),
+			matched:   false,
+		},
+		{
+			name:      "is deleted nil object",
+			pod:       nil,
+			predicate: common.IsDeleted[corev1.Pod](),
+			matched:   true,
+		},
+		{
+			name: "all of matches",
+			pod:  buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.AllOf(
+				common.HasCondition[corev1.Pod](string(corev1.PodReady), string(corev1.ConditionTrue)),
+				common.JSONPathEquals[corev1.Pod]("{.status.phase}", string(corev1.PodRunning))),
+			matched: true,
+		},
+		{
+			name: "all of one mismatch",
+			pod:  buildPredicateTestPod(corev1.ConditionTrue),
+			predicate: common.AllOf(
+				common.HasCondition[corev1.Pod](string(corev1.PodReady), string(corev1.ConditionTrue)),
+				common.JSONPathEquals[corev1.Pod]("{.status.phase}", string(corev1.PodFailed))),
+			matched: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			matched, reason := testCase.predicate(testCase.pod)
+			assert.Equal(t, testCase.matched, matched)
+
+			if !testCase.matched {
+				assert.NotEmpty(t, reason)
+			}
+		})
+	}
+}
+
+func TestJSONPathEqualsRepeated(t *testing.T) {
+	t.Parallel()
+
+	pod := buildPredicateTestPod(corev1.ConditionTrue)
+	pod.OwnerReferences = []metav1.OwnerReference{{Name: "first"}, {Name: "second"}}
+
+	predicate := common.JSONPathEquals[corev1.Pod]("{range .metadata.ownerReferences[*]}{.name}{end}", "firstsecond")
+
+	// Templates with range blocks must evaluate the same way each time the predicate is called.
+	for range 3 {
+		matched, reason := predicate(pod)
+		assert.True(t, matched, reason)
+	}
+}
+
+func TestObservedGenerationCurrent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		matched            bool
+	}{
+		{
+			name:               "observed generation caught up",
+			generation:         2,
+			observedGeneration: 2,
+			matched:            true,
+		},
+		{
+			name:               "observed generation behind",
+			generation:         3,
+			observedGeneration: 2,
+			matched:            false,
+		},
+		{
+			name:               "observed generation not set",
+			generation:         1,
+			observedGeneration: 0,
+			matched:            false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := &corev1.ReplicationController{
+				ObjectMeta: metav1.ObjectMeta{Generation: testCase.generation},
+				Status:     corev1.ReplicationControllerStatus{ObservedGeneration: testCase.observedGeneration},
+			}
+
+			matched, _ := common.ObservedGenerationCurrent[corev1.ReplicationController]()(controller)
+			assert.Equal(t, testCase.matched, matched)
+		})
+	}
+}
+
+func buildPredicateTestPod(readyStatus corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      waitTestName,
+			Namespace: waitTestNamespace,
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{
+				Type:   corev1.PodReady,
+				Status: readyStatus,
+				Reason: "TestReason",
+			}},
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestCreateRetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		settingsPolicy   *clients.RetryPolicy
+		contextPolicy    *clients.RetryPolicy
+		failures         int
+		expectedAttempts int
+		assertError      func(error) bool
+	}{
+		{
+			name:             "no policy does not retry",
+			failures:         1,
+			expectedAttempts: 1,
+			assertError:      isAPICallFailedWithCreate,
+		},
+		{
+			name:             "settings policy retries transient errors",
+			settingsPolicy:   buildRetryTestPolicy(3),
+			failures:         2,
+			expectedAttempts: 3,
+			assertError:      isNilError,
+		},
+		{
+			name:             "settings policy exhausted",
+			settingsPolicy:   buildRetryTestPolicy(2),
+			failures:         2,
+			expectedAttempts: 2,
+			assertError:      isAPICallFailedWithCreate,
+		},
+		{
+			name:             "context policy overrides settings policy",
+			settingsPolicy:   buildRetryTestPolicy(3),
+			contextPolicy:    clients.NoRetryPolicy(),
+			failures:         1,
+			expectedAttempts: 1,
+			assertError:      isAPICallFailedWithCreate,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			attempts := 0
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+				InterceptorFuncs: interceptor.Funcs{Create: func(
+					ctx context.Context, client runtimeclient.WithWatch, object runtimeclient.Object,
+					options ...runtimeclient.CreateOption,
+				) error {
+					attempts++
+					if attempts <= testCase.failures {
+						return k8serrors.NewTooManyRequests("throttled", 0)
+					}
+
+					return client.Create(ctx, object, options...)
+				}},
+			})
+			testSettings.RetryPolicy = testCase.settingsPolicy
+
+			ctx := t.Context()
+			if testCase.contextPolicy != nil {
+				ctx = clients.WithRetryPolicy(ctx, testCase.contextPolicy)
+			}
+
+			builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+				testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+			err := common.Create(ctx, builder)
+			assert.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
+			assert.Equal(t, testCase.expectedAttempts, attempts)
+		})
+	}
+}
+
+func TestUpdateRetriesConflicts(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildWaitTestConfigMap(false)},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+		InterceptorFuncs: interceptor.Funcs{Update: func(
+			ctx context.Context, client runtimeclient.WithWatch, object runtimeclient.Object,
+			options ...runtimeclient.UpdateOption,
+		) error {
+			attempts++
+
+			// Simulate another client updating the resource between the get and the update.
+			if attempts == 1 {
+				concurrent := buildWaitTestConfigMap(false)
+				require.NoError(t, client.Get(ctx, runtimeclient.ObjectKeyFromObject(concurrent), concurrent))
+
+				concurrent.Labels = map[string]string{"concurrent": "true"}
+				require.NoError(t, client.Update(ctx, concurrent))
+			}
+
+			return client.Update(ctx, object, options...)
+		}},
+	})
+	testSettings.RetryPolicy = buildRetryTestPolicy(3)
+
+	builder, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		t.Context(), testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+	require.NoError(t, err)
+
+	builder.GetDefinition().Data = map[string]string{waitTestDataKey: "true"}
+
+	err = common.Update(t.Context(), builder, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "true", builder.GetObject().Data[waitTestDataKey])
+}
+
+// buildRetryTestPolicy returns a retry policy with the provided number of attempts and a negligible delay.
+func buildRetryTestPolicy(steps int) *clients.RetryPolicy {
+	return &clients.RetryPolicy{Backoff: wait.Backoff{Steps: steps, Duration: time.Millisecond}}
+}
+
+func isAPICallFailedWithCreate(err error) bool {
+	return commonerrors.IsAPICallFailedWithVerb(err, "create")
+}
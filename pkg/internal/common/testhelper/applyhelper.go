@@ -0,0 +1,232 @@
+package testhelper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// Applier is an interface for builders that have an Apply method.
+type Applier[O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]] interface {
+	common.BuilderPointer[B, O, SO]
+	Apply(fieldManager string, force bool) (SB, error)
+}
+
+// internalApplyFunc is the internal function signature used by ApplyTestConfig. All of the other apply functions must
+// be able to be wrapped in this signature.
+type internalApplyFunc[O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]] func(
+	ctx context.Context, builder SB, fieldManager string, force bool) (SB, error)
+
+// GenericApplyFunc is the signature for the common.Apply function that takes context and builder.
+type GenericApplyFunc[O any, SO common.ObjectPointer[O]] func(
+	ctx context.Context, builder common.Builder[O, SO], fieldManager string, force bool) error
+
+// ApplyTestConfig provides the configuration needed to test an Apply method.
+type ApplyTestConfig[O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]] struct {
+	CommonTestConfig[O, B, SO, SB]
+
+	// applyFunc is a function that applies the resource and returns the builder and an error.
+	applyFunc internalApplyFunc[O, B, SO, SB]
+}
+
+// NewApplyTestConfig creates a new ApplyTestConfig with the given parameters for builders that implement the Applier
+// interface.
+func NewApplyTestConfig[O, B any, SO common.ObjectPointer[O], SB Applier[O, B, SO, SB]](
+	commonTestConfig CommonTestConfig[O, B, SO, SB],
+) ApplyTestConfig[O, B, SO, SB] {
+	return ApplyTestConfig[O, B, SO, SB]{
+		CommonTestConfig: commonTestConfig,
+		applyFunc: func(_ context.Context, builder SB, fieldManager string, force bool) (SB, error) {
+			return builder.Apply(fieldManager, force)
+		},
+	}
+}
+
+// NewGenericApplyTestConfig creates a new ApplyTestConfig with a custom apply function. This is useful for testing
+// standalone functions like common.Apply() rather than builder methods.
+func NewGenericApplyTestConfig[O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]](
+	commonTestConfig CommonTestConfig[O, B, SO, SB],
+	applyFunc GenericApplyFunc[O, SO],
+) ApplyTestConfig[O, B, SO, SB] {
+	return ApplyTestConfig[O, B, SO, SB]{
+		CommonTestConfig: commonTestConfig,
+		applyFunc: func(ctx context.Context, builder SB, fieldManager string, force bool) (SB, error) {
+			return builder, applyFunc(ctx, builder, fieldManager, force)
+		},
+	}
+}
+
+// Name returns the name to use for running these tests.
+func (config ApplyTestConfig[O, B, SO, SB]) Name() string {
+	return "Apply"
+}
+
+// ExecuteTests runs the standard set of Apply tests for the configured resource.
+//
+//nolint:funlen // This function is long due to the table of test cases.
+func (config ApplyTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
+	t.Helper()
+
+	t.Run("scheme attacher adds GVK", createSchemeAttacherGVKTest[O, SO](config.SchemeAttacher, config.ExpectedGVK))
+
+	testCases := []struct {
+		name             string
+		objectExists     bool
+		force            bool
+		builderError     error
+		interceptorFuncs interceptor.Funcs
+		assertError      func(error) bool
+	}{
+		{
+			name:             "valid apply existing resource",
+			objectExists:     true,
+			interceptorFuncs: interceptor.Funcs{Patch: testApplyPatch},
+			assertError:      isErrorNil,
+		},
+		{
+			name:             "valid apply creates missing resource",
+			objectExists:     false,
+			interceptorFuncs: interceptor.Funcs{Patch: testApplyPatch},
+			assertError:      isErrorNil,
+		},
+		{
+			name:             "invalid builder returns error",
+			objectExists:     true,
+			builderError:     errInvalidBuilder,
+			interceptorFuncs: interceptor.Funcs{Patch: testApplyPatch},
+			assertError:      isInvalidBuilder,
+		},
+		{
+			name:             "conflicting apply without force returns conflict",
+			objectExists:     true,
+			interceptorFuncs: interceptor.Funcs{Patch: testConflictingApplyPatch},
+			assertError:      isApplyConflict,
+		},
+		{
+			name:             "conflicting apply with force succeeds",
+			objectExists:     true,
+			force:            true,
+			interceptorFuncs: interceptor.Funcs{Patch: testConflictingApplyPatch},
+			assertError:      isErrorNil,
+		},
+		{
+			name:             "failed apply returns error",
+			objectExists:     true,
+			interceptorFuncs: interceptor.Funcs{Patch: testFailingPatch},
+			assertError:      isAPICallFailedWithApply,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var objects []runtime.Object
+
+			if testCase.objectExists {
+				var namespace string
+				if config.ResourceScope.IsNamespaced() {
+					namespace = testResourceNamespace
+				}
+
+				objects = append(objects, buildDummyObject[O, SO](testResourceName, namespace))
+			}
+
+			client := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:   objects,
+				SchemeAttachers:  []clients.SchemeAttacher{config.SchemeAttacher},
+				InterceptorFuncs: testCase.interceptorFuncs,
+			})
+
+			var builder SB
+			if config.ResourceScope.IsNamespaced() {
+				builder = common.NewNamespacedBuilder[O, B, SO, SB](client, config.SchemeAttacher, testResourceName, testResourceNamespace)
+			} else {
+				builder = common.NewClusterScopedBuilder[O, B, SO, SB](client, config.SchemeAttacher, testResourceName)
+			}
+
+			builder.SetError(testCase.builderError)
+			builder.GetDefinition().SetAnnotations(map[string]string{testAnnotationKey: testAnnotationValue})
+
+			result, err := config.applyFunc(t.Context(), builder, "", testCase.force)
+
+			require.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
+
+			if err == nil {
+				require.NotNil(t, result)
+				require.NotNil(t, result.GetObject())
+				assert.Equal(t, testResourceName, result.GetObject().GetName())
+
+				if config.ResourceScope.IsNamespaced() {
+					assert.Equal(t, testResourceNamespace, result.GetObject().GetNamespace())
+				}
+
+				assert.Equal(t, testAnnotationValue, result.GetObject().GetAnnotations()[testAnnotationKey])
+			}
+		})
+	}
+}
+
+// testApplyPatch is an interceptor function that emulates server-side apply on the fake client, which does not support
+// apply patches. The object is merge patched if it exists and created otherwise, which is sufficient for verifying how
+// the builder handles the result.
+func testApplyPatch(
+	ctx context.Context,
+	client runtimeclient.WithWatch,
+	obj runtimeclient.Object,
+	patch runtimeclient.Patch,
+	opts ...runtimeclient.PatchOption,
+) error {
+	if patch.Type() != types.ApplyPatchType {
+		return client.Patch(ctx, obj, patch, opts...)
+	}
+
+	err := client.Patch(ctx, obj, runtimeclient.Merge)
+	if k8serrors.IsNotFound(err) {
+		return client.Create(ctx, obj)
+	}
+
+	return err
+}
+
+// testConflictingApplyPatch is an interceptor function that returns a conflict error for apply patches unless the
+// ForceOwnership option is provided. Forced applies are passed to testApplyPatch.
+func testConflictingApplyPatch(
+	ctx context.Context,
+	client runtimeclient.WithWatch,
+	obj runtimeclient.Object,
+	patch runtimeclient.Patch,
+	opts ...runtimeclient.PatchOption,
+) error {
+	patchOptions := &runtimeclient.PatchOptions{}
+	patchOptions.ApplyOptions(opts)
+
+	if patchOptions.Force == nil || !*patchOptions.Force {
+		return k8serrors.NewConflict(
+			obj.GetObjectKind().GroupVersionKind().GroupVersion().WithResource("").GroupResource(),
+			obj.GetName(), errApplyConflict)
+	}
+
+	return testApplyPatch(ctx, client, obj, patch, opts...)
+}
+
+// testFailingPatch is an interceptor function that always returns errApplyFailure. Used with fake client interceptors
+// to simulate Kubernetes API patch failures.
+func testFailingPatch(
+	ctx context.Context,
+	client runtimeclient.WithWatch,
+	obj runtimeclient.Object,
+	patch runtimeclient.Patch,
+	opts ...runtimeclient.PatchOption,
+) error {
+	return errApplyFailure
+}
@@ -36,6 +36,10 @@ var (
 	errListFailure   = errors.New("simulated list failure")
 	errUpdateFailure = errors.New("simulated update failure")
 	errDeleteFailure = errors.New("simulated delete failure")
+	errApplyFailure  = errors.New("simulated apply failure")
+
+	// errApplyConflict is wrapped in a conflict status error to simulate another field manager owning applied fields.
+	errApplyConflict = errors.New("simulated apply conflict")
 
 	// errInvalidBuilder is injected into builder.errorMsg to test validation logic. Unlike the API errors above,
 	// this simulates a builder-level validation failure rather than a Kubernetes API failure.
@@ -130,6 +134,14 @@ func isAPICallFailedWithDelete(err error) bool {
 	return commonerrors.IsAPICallFailedWithVerb(err, "delete")
 }
 
+func isAPICallFailedWithApply(err error) bool {
+	return commonerrors.IsAPICallFailedWithVerb(err, "apply")
+}
+
+func isApplyConflict(err error) bool {
+	return commonerrors.IsApplyConflict(err)
+}
+
 func isInvalidBuilder(err error) bool {
 	return errors.Is(err, errInvalidBuilder)
 }
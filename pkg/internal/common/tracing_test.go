@@ -0,0 +1,120 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	corev1 "k8s.io/api/core/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestOperationTracing(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+	testSettings.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+	_, err := common.Get(t.Context(), builder)
+	require.Error(t, err)
+
+	err = common.Create(t.Context(), builder)
+	require.NoError(t, err)
+
+	_, err = common.List[corev1.ConfigMap, corev1.ConfigMapList, mockNamespacedBuilder](
+		t.Context(), testSettings, testSchemeAttacher, runtimeclient.InNamespace(waitTestNamespace))
+	require.NoError(t, err)
+
+	err = common.WaitUntil(t.Context(), builder, time.Second, func(object *corev1.ConfigMap) (bool, error) {
+		return object != nil, nil
+	})
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 4)
+
+	expectedSpans := []struct {
+		name      string
+		operation string
+		outcome   string
+		status    codes.Code
+	}{
+		{name: "get ConfigMap", operation: "get", outcome: tracing.OutcomeNotFound, status: codes.Error},
+		{name: "create ConfigMap", operation: "create", outcome: tracing.OutcomeSuccess, status: codes.Unset},
+		{name: "list ConfigMap", operation: "list", outcome: tracing.OutcomeSuccess, status: codes.Unset},
+		{name: "wait ConfigMap", operation: "wait", outcome: tracing.OutcomeSuccess, status: codes.Unset},
+	}
+
+	for index, expected := range expectedSpans {
+		span := spans[index]
+
+		assert.Equal(t, expected.name, span.Name)
+		assert.Equal(t, expected.status, span.Status.Code, expected.name)
+		assertSpanAttribute(t, span, tracing.OperationKey, expected.operation)
+		assertSpanAttribute(t, span, tracing.OutcomeKey, expected.outcome)
+		assertSpanAttribute(t, span, tracing.VersionKey, "v1")
+		assertSpanAttribute(t, span, tracing.KindKey, "ConfigMap")
+		assertSpanAttribute(t, span, tracing.NamespaceKey, waitTestNamespace)
+	}
+
+	assertSpanAttribute(t, spans[1], tracing.NameKey, waitTestName)
+
+	listAttributes := attribute.NewSet(spans[2].Attributes...)
+	_, found := listAttributes.Value(tracing.NameKey)
+	assert.False(t, found, "list span should not have a name attribute")
+}
+
+func TestOperationTracingFromContext(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+	err := common.Create(context.TODO(), builder)
+	require.NoError(t, err)
+
+	assert.Empty(t, exporter.GetSpans(), "operations should not be traced without a provider")
+
+	ctx, parent := provider.Tracer("test").Start(t.Context(), "parent")
+
+	err = common.Delete(ctx, builder)
+	require.NoError(t, err)
+
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "delete ConfigMap", spans[0].Name)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+}
+
+// assertSpanAttribute asserts that the span has a string attribute with the provided key and value.
+func assertSpanAttribute(t *testing.T, span tracetest.SpanStub, key attribute.Key, expected string) {
+	t.Helper()
+
+	attributes := attribute.NewSet(span.Attributes...)
+	value, found := attributes.Value(key)
+	assert.Truef(t, found, "attribute %s not found on span %s", key, span.Name)
+	assert.Equalf(t, expected, value.AsString(), "attribute %s on span %s", key, span.Name)
+}
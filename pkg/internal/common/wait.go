@@ -0,0 +1,321 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultWaitPollInterval is the interval used by [WaitUntil] when it must fall back to polling because a watch
+	// could not be established. It is also the initial delay before re-establishing a watch that has ended.
+	DefaultWaitPollInterval = time.Second
+	// maxWatchBackoff is the longest [WaitUntil] waits before re-establishing a watch that keeps ending without
+	// delivering any events.
+	maxWatchBackoff = 30 * time.Second
+)
+
+// ObjectCondition is a function that checks whether an object has reached some desired state. The object is nil when
+// the resource does not exist on the cluster, which allows conditions to check for deletion. Returning an error stops
+// the wait immediately.
+type ObjectCondition[O any, SO ObjectPointer[O]] func(object SO) (bool, error)
+
+// WaitUntil waits until the condition is met for the resource described by the builder, the timeout elapses, or the
+// context is done. Rather than re-getting the resource at a fixed interval, it watches the resource and evaluates the
+// condition as soon as each event arrives. If the client does not support watches or the watch cannot be established,
+// it falls back to polling every [DefaultWaitPollInterval]. If an established watch is closed or returns an error, it is
+// re-established after re-getting the resource so no state changes are missed. Watches are re-established after a
+// jittered delay starting at [DefaultWaitPollInterval] that doubles, up to 30 seconds, each time a watch ends without
+// delivering any events.
+//
+// Whenever the resource is retrieved, the builder's object is updated with it, or set to nil if the resource does not
+// exist. When the wait times out or the context is cancelled, the returned error satisfies [errors.IsWaitTimeout] and
+// wraps the context's error.
+func WaitUntil[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], timeout time.Duration, condition ObjectCondition[O, SO]) error {
+	if err := Validate(builder); err != nil {
+		return err
+	}
+
+	newOperationLogger(ctx, builder, "wait").V(logging.LevelDebug).Info("Waiting for condition", "timeout", timeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := waitForCondition(ctx, builder, condition)
+	if err != nil && ctx.Err() != nil {
+		return errors.NewWaitTimeout(NewResourceKeyFromBuilder(builder), builder.GetObject(), "", err)
+	}
+
+	return err
+}
+
+// WaitUntilObject is the same as [WaitUntil] but waits on the resource with the name and namespace of the provided
+// definition rather than on a builder. This allows builders that do not implement [Builder] to use the same watch-based
+// wait. The gvk is used to look up the list type for the watch. It returns the last retrieved form of the resource, which
+// is nil if the resource does not exist or could not be retrieved.
+func WaitUntilObject[O any, SO ObjectPointer[O]](
+	ctx context.Context,
+	apiClient runtimeclient.Client,
+	gvk schema.GroupVersionKind,
+	definition SO,
+	timeout time.Duration,
+	condition ObjectCondition[O, SO]) (SO, error) {
+	builder := &EmbeddableBuilder[O, SO]{Definition: definition, apiClient: apiClient, gvk: gvk}
+
+	err := WaitUntil(ctx, builder, timeout, condition)
+
+	return builder.Object, err
+}
+
+// waitForCondition implements the watch loop for [WaitUntil] and [WaitFor]. It assumes the builder has already been
+// validated and waits until the condition is met or the context is done.
+func waitForCondition[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], condition ObjectCondition[O, SO]) error {
+	logger := newOperationLogger(ctx, builder, "wait")
+	ctx, span := startOperationSpan(ctx, builder, "wait")
+	start := time.Now()
+	backoff := newWatchBackoff()
+
+	for {
+		object, resourceVersion, err := getForWait(ctx, builder)
+		if err != nil {
+			logger.V(logging.LevelDebug).Info("Failed to get resource while waiting, falling back to polling", "error", err)
+
+			return recordWaitResult(builder, logger, span, start, pollUntil(ctx, builder, logger, condition))
+		}
+
+		if done, err := condition(object); done || err != nil {
+			return recordWaitResult(builder, logger, span, start, err)
+		}
+
+		watcher, err := startWatch(ctx, builder, resourceVersion)
+		if err != nil {
+			logger.V(logging.LevelDebug).Info("Failed to watch resource, falling back to polling", "error", err)
+
+			return recordWaitResult(builder, logger, span, start, pollUntil(ctx, builder, logger, condition))
+		}
+
+		received, done, err := consumeWatch(ctx, builder, logger, watcher, condition)
+		watcher.Stop()
+
+		if done || err != nil {
+			return recordWaitResult(builder, logger, span, start, err)
+		}
+
+		if received {
+			backoff = newWatchBackoff()
+		}
+
+		delay := backoff.Step()
+		logger.V(logging.LevelDebug).Info("Watch ended, re-establishing", "delay", delay)
+
+		if err := sleepWithContext(ctx, delay); err != nil {
+			return recordWaitResult(builder, logger, span, start, err)
+		}
+	}
+}
+
+// newWatchBackoff returns the backoff used between re-establishing watches in [WaitUntil]. The steps are unlimited so
+// the delay keeps growing until it reaches the cap.
+func newWatchBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: DefaultWaitPollInterval,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    math.MaxInt32,
+		Cap:      maxWatchBackoff,
+	}
+}
+
+// sleepWithContext waits for the provided delay, returning the context's error early if it is done first.
+func sleepWithContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// getForWait gets the resource and updates the builder's object. It returns the object, which is nil if the resource
+// does not exist, and the resource version from which a watch should be started.
+func getForWait[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO]) (SO, string, error) {
+	var object SO = new(O)
+
+	err := builder.GetClient().Get(ctx, runtimeclient.ObjectKeyFromObject(builder.GetDefinition()), object)
+	if k8serrors.IsNotFound(err) {
+		builder.SetObject(nil)
+
+		return nil, "", nil
+	}
+
+	if err != nil {
+		return nil, "", errors.NewAPICallFailed("get", NewResourceKeyFromBuilder(builder), err)
+	}
+
+	builder.SetObject(object)
+
+	return object, object.GetResourceVersion(), nil
+}
+
+// startWatch starts a watch for the resource described by the builder. The list type is looked up from the client's
+// scheme so the events contain typed objects.
+func startWatch[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], resourceVersion string) (watch.Interface, error) {
+	watchClient, ok := builder.GetClient().(runtimeclient.WithWatch)
+	if !ok {
+		return nil, fmt.Errorf("client of type %T does not support watches", builder.GetClient())
+	}
+
+	gvk := builder.GetGVK()
+
+	listObject, err := watchClient.Scheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list for watch: %w", err)
+	}
+
+	list, ok := listObject.(runtimeclient.ObjectList)
+	if !ok {
+		return nil, errors.NewItemTypeMismatch(gvk.Kind+"List", reflect.TypeOf(listObject))
+	}
+
+	options := []runtimeclient.ListOption{
+		runtimeclient.MatchingFields{"metadata.name": builder.GetDefinition().GetName()},
+		&runtimeclient.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: resourceVersion}},
+	}
+
+	if namespace := builder.GetDefinition().GetNamespace(); namespace != "" {
+		options = append(options, runtimeclient.InNamespace(namespace))
+	}
+
+	return watchClient.Watch(ctx, list, options...)
+}
+
+// consumeWatch evaluates the condition for each event on the watch that matches the builder's resource. The first
+// return value reports whether any event for the resource was received, which the caller uses to reset its backoff. The
+// second is true once the condition is met. It returns false without an error when the watch ends, the context is done,
+// or an error event is received so that the caller may decide whether to re-establish the watch.
+func consumeWatch[O any, SO ObjectPointer[O]](
+	ctx context.Context,
+	builder Builder[O, SO],
+	logger logr.Logger,
+	watcher watch.Interface,
+	condition ObjectCondition[O, SO]) (bool, bool, error) {
+	resourceKey := NewResourceKeyFromBuilder(builder)
+	received := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return received, false, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return received, false, nil
+			}
+
+			object, matches, err := objectFromEvent(builder, resourceKey, event)
+			if err != nil {
+				logger.V(logging.LevelDebug).Info("Received error event while watching", "error", err)
+
+				return received, false, nil
+			}
+
+			if !matches {
+				continue
+			}
+
+			received = true
+
+			builder.SetObject(object)
+
+			if done, err := condition(object); done || err != nil {
+				return received, done, err
+			}
+		}
+	}
+}
+
+// objectFromEvent extracts the object for the builder's resource from a watch event. It returns false if the event is
+// for a different resource or carries no object, such as a bookmark. The returned object is nil for deletion events.
+func objectFromEvent[O any, SO ObjectPointer[O]](
+	builder Builder[O, SO], resourceKey key.ResourceKey, event watch.Event) (SO, bool, error) {
+	switch event.Type {
+	case watch.Added, watch.Modified, watch.Deleted:
+	case watch.Error:
+		return nil, false, k8serrors.FromObject(event.Object)
+	default:
+		return nil, false, nil
+	}
+
+	object, ok := event.Object.(SO)
+	if !ok {
+		return nil, false, errors.NewItemTypeMismatch(resourceKey.Kind, reflect.TypeOf(event.Object))
+	}
+
+	// Not all clients respect the field selector, so the name and namespace are also checked here.
+	if object.GetName() != builder.GetDefinition().GetName() ||
+		object.GetNamespace() != builder.GetDefinition().GetNamespace() {
+		return nil, false, nil
+	}
+
+	if event.Type == watch.Deleted {
+		return nil, true, nil
+	}
+
+	return object, true, nil
+}
+
+// pollUntil re-gets the resource every [DefaultWaitPollInterval] until the condition is met or the context is done.
+// Errors getting the resource are logged and retried rather than ending the wait.
+func pollUntil[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], logger logr.Logger, condition ObjectCondition[O, SO]) error {
+	return wait.PollUntilContextCancel(ctx, DefaultWaitPollInterval, true, func(ctx context.Context) (bool, error) {
+		object, _, err := getForWait(ctx, builder)
+		if err != nil {
+			logger.V(logging.LevelDebug).Info("Failed to get resource while polling", "error", err)
+
+			return false, nil
+		}
+
+		return condition(object)
+	})
+}
+
+// recordWaitResult logs whether the wait ended with the condition met and how long it took, then ends the span for the
+// wait and records it in the metrics of the builder's client with the same outcome. It returns err unchanged.
+func recordWaitResult[O any, SO ObjectPointer[O]](
+	builder Builder[O, SO], logger logr.Logger, span trace.Span, start time.Time, err error) error {
+	tracing.EndSpan(span, err)
+	clients.MetricsFrom(builder.GetClient()).ObserveOperation(
+		builder.GetGVK(), "wait", tracing.Outcome(err), time.Since(start))
+
+	if err != nil {
+		logger.V(logging.LevelOperation).Info("Wait failed", "duration", time.Since(start), "error", err)
+
+		return err
+	}
+
+	logger.V(logging.LevelOperation).Info("Wait succeeded", "duration", time.Since(start))
+
+	return nil
+}
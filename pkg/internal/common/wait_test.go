@@ -0,0 +1,302 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	waitTestName      = "wait-test-name"
+	waitTestNamespace = "wait-test-namespace"
+	waitTestDataKey   = "ready"
+)
+
+var errWaitCondition = errors.New("condition failed")
+
+func TestWaitUntil(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		objectExists    bool
+		alreadyReady    bool
+		mutate          func(ctx context.Context, client runtimeclient.Client) error
+		hideWatch       bool
+		expectWatch     bool
+		builderError    error
+		condition       common.ObjectCondition[corev1.ConfigMap, *corev1.ConfigMap]
+		assertError     func(error) bool
+		expectNilObject bool
+	}{
+		{
+			name:         "condition already met",
+			objectExists: true,
+			alreadyReady: true,
+			condition:    isWaitTestConfigMapReady,
+			assertError:  isNilError,
+		},
+		{
+			name:         "condition met after update through watch",
+			objectExists: true,
+			mutate:       markWaitTestConfigMapReady,
+			expectWatch:  true,
+			condition:    isWaitTestConfigMapReady,
+			assertError:  isNilError,
+		},
+		{
+			name:         "condition met after update through polling",
+			objectExists: true,
+			mutate:       markWaitTestConfigMapReady,
+			hideWatch:    true,
+			condition:    isWaitTestConfigMapReady,
+			assertError:  isNilError,
+		},
+		{
+			name:            "condition met after deletion",
+			objectExists:    true,
+			mutate:          deleteWaitTestConfigMap,
+			condition:       isWaitTestConfigMapDeleted,
+			assertError:     isNilError,
+			expectNilObject: true,
+		},
+		{
+			name:            "resource already missing",
+			objectExists:    false,
+			condition:       isWaitTestConfigMapDeleted,
+			assertError:     isNilError,
+			expectNilObject: true,
+		},
+		{
+			name:         "condition never met times out",
+			objectExists: true,
+			condition:    isWaitTestConfigMapReady,
+			assertError:  isWaitTimeoutDeadlineExceeded,
+		},
+		{
+			name:         "condition error stops wait",
+			objectExists: true,
+			condition:    failWaitTestCondition,
+			assertError:  isWaitConditionError,
+		},
+		{
+			name:         "invalid builder returns error",
+			objectExists: true,
+			builderError: errWaitCondition,
+			condition:    isWaitTestConfigMapReady,
+			assertError:  isWaitConditionError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var objects []runtime.Object
+
+			if testCase.objectExists {
+				objects = append(objects, buildWaitTestConfigMap(testCase.alreadyReady))
+			}
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  objects,
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+			})
+
+			countingClient := &countingClient{WithWatch: testSettings}
+
+			var apiClient runtimeclient.Client = countingClient
+			if testCase.hideWatch {
+				apiClient = &noWatchClient{Client: countingClient}
+			}
+
+			builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+				apiClient, testSchemeAttacher, waitTestName, waitTestNamespace)
+			builder.SetError(testCase.builderError)
+
+			if testCase.mutate != nil {
+				go func() {
+					time.Sleep(100 * time.Millisecond)
+
+					_ = testCase.mutate(t.Context(), testSettings.Client)
+				}()
+			}
+
+			err := common.WaitUntil(t.Context(), builder, 3*time.Second, testCase.condition)
+			assert.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
+
+			if err == nil && testCase.expectNilObject {
+				assert.Nil(t, builder.GetObject())
+			}
+
+			// When the update is seen through the watch, the resource is only retrieved once before watching.
+			if testCase.expectWatch {
+				assert.Equal(t, int32(1), countingClient.gets.Load())
+				assert.Equal(t, int32(1), countingClient.watches.Load())
+			}
+
+			if testCase.hideWatch {
+				assert.Zero(t, countingClient.watches.Load())
+			}
+		})
+	}
+}
+
+func TestWaitUntilWatchBackoff(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildWaitTestConfigMap(false)},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+	countingClient := &countingClient{WithWatch: testSettings, closeWatches: true}
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		countingClient, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+	err := common.WaitUntil(t.Context(), builder, 1500*time.Millisecond, isWaitTestConfigMapReady)
+	assert.True(t, isWaitTimeoutDeadlineExceeded(err), "unexpected error, got: %v", err)
+
+	// Watches that end immediately are re-established after DefaultWaitPollInterval rather than in a tight loop.
+	assert.Equal(t, int32(2), countingClient.watches.Load())
+	assert.Equal(t, int32(2), countingClient.gets.Load())
+}
+
+func TestWaitUntilStatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	testSettings, err := clients.NewTestClientBuilder().
+		WithObjects(buildWaitTestConfigMap(false)).
+		WithSchemeAttachers(testSchemeAttacher).
+		WithStatusTransitions(&corev1.ConfigMap{},
+			clients.AfterReads(5, func(configMap *corev1.ConfigMap) {
+				configMap.Data = map[string]string{waitTestDataKey: "true"}
+			})).
+		Build()
+	assert.NoError(t, err)
+
+	countingClient := &countingClient{WithWatch: testSettings}
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		countingClient, testSchemeAttacher, waitTestName, waitTestNamespace)
+
+	// The transition happens while the wait is watching the resource, without any further Get calls.
+	err = common.WaitUntil(t.Context(), builder, 3*time.Second, isWaitTestConfigMapReady)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), countingClient.gets.Load())
+	assert.Equal(t, int32(1), countingClient.watches.Load())
+}
+
+func TestWaitUntilObject(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildWaitTestConfigMap(false)},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	})
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		_ = markWaitTestConfigMapReady(t.Context(), testSettings.Client)
+	}()
+
+	configMap, err := common.WaitUntilObject(t.Context(), testSettings, corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+		buildWaitTestConfigMap(false), 3*time.Second, isWaitTestConfigMapReady)
+	assert.NoError(t, err)
+	assert.NotNil(t, configMap)
+
+	_, err = common.WaitUntilObject(t.Context(), nil, corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+		buildWaitTestConfigMap(false), time.Second, isWaitTestConfigMapReady)
+	assert.True(t, commonerrors.IsAPIClientNil(err), "unexpected error, got: %v", err)
+}
+
+// countingClient counts the Get and Watch calls made through it so that tests can check which wait path was used. If
+// closeWatches is set, every watch it returns is already closed.
+type countingClient struct {
+	runtimeclient.WithWatch
+	gets         atomic.Int32
+	watches      atomic.Int32
+	closeWatches bool
+}
+
+func (client *countingClient) Get(
+	ctx context.Context, key runtimeclient.ObjectKey, object runtimeclient.Object, opts ...runtimeclient.GetOption) error {
+	client.gets.Add(1)
+
+	return client.WithWatch.Get(ctx, key, object, opts...)
+}
+
+func (client *countingClient) Watch(
+	ctx context.Context, list runtimeclient.ObjectList, opts ...runtimeclient.ListOption) (watch.Interface, error) {
+	client.watches.Add(1)
+
+	if client.closeWatches {
+		return watch.NewEmptyWatch(), nil
+	}
+
+	return client.WithWatch.Watch(ctx, list, opts...)
+}
+
+// noWatchClient wraps a client without exposing its Watch method so that the polling fallback is used.
+type noWatchClient struct {
+	runtimeclient.Client
+}
+
+func buildWaitTestConfigMap(ready bool) *corev1.ConfigMap {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      waitTestName,
+			Namespace: waitTestNamespace,
+		},
+	}
+
+	if ready {
+		configMap.Data = map[string]string{waitTestDataKey: "true"}
+	}
+
+	return configMap
+}
+
+func markWaitTestConfigMapReady(ctx context.Context, client runtimeclient.Client) error {
+	return client.Update(ctx, buildWaitTestConfigMap(true))
+}
+
+func deleteWaitTestConfigMap(ctx context.Context, client runtimeclient.Client) error {
+	return client.Delete(ctx, buildWaitTestConfigMap(false))
+}
+
+func isWaitTestConfigMapReady(configMap *corev1.ConfigMap) (bool, error) {
+	return configMap != nil && configMap.Data[waitTestDataKey] == "true", nil
+}
+
+func isWaitTestConfigMapDeleted(configMap *corev1.ConfigMap) (bool, error) {
+	return configMap == nil, nil
+}
+
+func failWaitTestCondition(*corev1.ConfigMap) (bool, error) {
+	return false, errWaitCondition
+}
+
+func isNilError(err error) bool {
+	return err == nil
+}
+
+func isWaitTimeoutDeadlineExceeded(err error) bool {
+	return commonerrors.IsWaitTimeout(err) && errors.Is(err, context.DeadlineExceeded)
+}
+
+func isWaitConditionError(err error) bool {
+	return errors.Is(err, errWaitCondition) && !commonerrors.IsAPICallFailed(err)
+}
@@ -9,5 +9,12 @@ import (
 // DiscardContext returns a context with a logr.Discard logger. This is useful for ignoring the logging of functions
 // which receive this context.
 func DiscardContext() context.Context {
-	return logr.NewContext(context.TODO(), logr.Discard())
+	return DiscardContextFrom(context.TODO())
+}
+
+// DiscardContextFrom returns a child of the provided context with a logr.Discard logger. Unlike [DiscardContext], the
+// cancellation and deadline of the parent context are preserved, so this should be used when a caller-provided context
+// is available.
+func DiscardContextFrom(ctx context.Context) context.Context {
+	return logr.NewContext(ctx, logr.Discard())
 }
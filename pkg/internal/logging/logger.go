@@ -0,0 +1,67 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// LevelOperation is the verbosity of logs describing the API operations performed by builders, such as creating or
+	// deleting a resource, along with their outcome and duration.
+	LevelOperation = 1
+	// LevelDebug is the verbosity of detailed logs, such as the steps taken during an operation and why validation
+	// failed.
+	LevelDebug = 2
+
+	// klogVerbosityOffset is added to the verbosity of logs sent to klog, so that LevelOperation logs at V(100) like the
+	// legacy klog.V(100) logging and running tests with a low -v does not make builders noisy.
+	klogVerbosityOffset = 99
+)
+
+// loggerGetter is implemented by clients which carry their own logger, such as clients.Settings.
+type loggerGetter interface {
+	GetLogger() logr.Logger
+}
+
+// FromContext returns the logger that should be used for an operation. The logger from the context, added using
+// logr.NewContext, takes precedence over the logger of the client, which is used if the client has a GetLogger method
+// returning a non-zero logger. Otherwise, logs are sent to klog at a verbosity offset by 99, matching the legacy
+// klog.V(100) logging.
+//
+// Since the context is checked first, callers may route the logs of a single call, such as those made while running a
+// test, or silence them using [DiscardContextFrom].
+func FromContext(ctx context.Context, client any) logr.Logger {
+	return FromContextOr(ctx, FromClient(client))
+}
+
+// FromContextOr is the same as [FromContext] but falls back to the provided logger rather than the logger of a client.
+// It is used by builders which keep the logger of the client they were created with. If the provided logger is the
+// zero logger, logs are sent to klog.
+func FromContextOr(ctx context.Context, logger logr.Logger) logr.Logger {
+	if ctx != nil {
+		if contextLogger, err := logr.FromContext(ctx); err == nil {
+			return contextLogger
+		}
+	}
+
+	if logger.GetSink() != nil {
+		return logger
+	}
+
+	return FromClient(nil)
+}
+
+// FromClient returns the logger of the client if it has a GetLogger method returning a non-zero logger. Otherwise, it
+// returns a logger sending logs to klog, with the verbosity offset so LevelOperation is V(100). It should only be used
+// when no context is available.
+func FromClient(client any) logr.Logger {
+	if getter, ok := client.(loggerGetter); ok {
+		if logger := getter.GetLogger(); logger.GetSink() != nil {
+			return logger
+		}
+	}
+
+	return klog.Background().V(klogVerbosityOffset)
+}
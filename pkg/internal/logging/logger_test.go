@@ -0,0 +1,103 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLoggerClient struct {
+	logger logr.Logger
+}
+
+func (client *testLoggerClient) GetLogger() logr.Logger {
+	return client.logger
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	var contextMessages, clientMessages []string
+
+	contextLogger := funcr.New(func(_, args string) { contextMessages = append(contextMessages, args) }, funcr.Options{})
+	clientLogger := funcr.New(func(_, args string) { clientMessages = append(clientMessages, args) }, funcr.Options{})
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		client          any
+		contextMessages int
+		clientMessages  int
+	}{
+		{
+			name:            "context logger takes precedence",
+			ctx:             logr.NewContext(context.TODO(), contextLogger),
+			client:          &testLoggerClient{logger: clientLogger},
+			contextMessages: 1,
+		},
+		{
+			name:           "client logger used without context logger",
+			ctx:            context.TODO(),
+			client:         &testLoggerClient{logger: clientLogger},
+			clientMessages: 1,
+		},
+		{
+			name:   "zero client logger falls back to klog",
+			ctx:    context.TODO(),
+			client: &testLoggerClient{},
+		},
+		{
+			name:   "client without logger falls back to klog",
+			ctx:    context.TODO(),
+			client: "not a client",
+		},
+	}
+
+	for _, testCase := range testCases {
+		contextMessages, clientMessages = nil, nil
+
+		FromContext(testCase.ctx, testCase.client).Info("test message")
+
+		assert.Len(t, contextMessages, testCase.contextMessages, testCase.name)
+		assert.Len(t, clientMessages, testCase.clientMessages, testCase.name)
+	}
+}
+
+func TestDiscardContextFromSilencesClientLogger(t *testing.T) {
+	t.Parallel()
+
+	var messages []string
+
+	clientLogger := funcr.New(func(_, args string) { messages = append(messages, args) }, funcr.Options{})
+
+	FromContext(DiscardContextFrom(context.TODO()), &testLoggerClient{logger: clientLogger}).Info("test message")
+
+	assert.Empty(t, messages)
+}
+
+func TestFromContextOr(t *testing.T) {
+	t.Parallel()
+
+	var contextMessages, fallbackMessages []string
+
+	contextLogger := funcr.New(func(_, args string) { contextMessages = append(contextMessages, args) }, funcr.Options{})
+	fallbackLogger := funcr.New(func(_, args string) { fallbackMessages = append(fallbackMessages, args) }, funcr.Options{})
+
+	FromContextOr(logr.NewContext(context.TODO(), contextLogger), fallbackLogger).Info("test message")
+	FromContextOr(context.TODO(), fallbackLogger).Info("test message")
+	FromContextOr(context.TODO(), logr.Logger{}).Info("test message")
+
+	assert.Len(t, contextMessages, 1)
+	assert.Len(t, fallbackMessages, 1)
+}
+
+func TestFromClientKlogVerbosity(t *testing.T) {
+	t.Parallel()
+
+	// The klog fallback keeps the legacy verbosity, so LevelOperation logs are only shown with -v=100.
+	assert.Equal(t, klogVerbosityOffset, FromClient(nil).GetV())
+	assert.Equal(t, 100, FromClient(nil).V(LevelOperation).GetV())
+}
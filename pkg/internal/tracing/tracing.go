@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TracerName is the instrumentation scope name of the tracer used for all spans created by eco-goinfra.
+const TracerName = "github.com/rh-ecosystem-edge/eco-goinfra"
+
+const (
+	// GroupKey is the attribute key for the API group of the resource an operation acts on.
+	GroupKey = attribute.Key("k8s.resource.group")
+	// VersionKey is the attribute key for the API version of the resource an operation acts on.
+	VersionKey = attribute.Key("k8s.resource.version")
+	// KindKey is the attribute key for the kind of the resource an operation acts on.
+	KindKey = attribute.Key("k8s.resource.kind")
+	// NameKey is the attribute key for the name of the resource an operation acts on.
+	NameKey = attribute.Key("k8s.resource.name")
+	// NamespaceKey is the attribute key for the namespace of the resource an operation acts on. It matches the
+	// semantic convention for Kubernetes namespaces.
+	NamespaceKey = semconv.K8SNamespaceNameKey
+	// OperationKey is the attribute key for the operation performed, such as create or wait.
+	OperationKey = attribute.Key("eco_goinfra.operation")
+	// OutcomeKey is the attribute key for the outcome of the operation. See the Outcome constants for its values.
+	OutcomeKey = attribute.Key("eco_goinfra.outcome")
+)
+
+const (
+	// OutcomeSuccess is the outcome of an operation which did not return an error.
+	OutcomeSuccess = "success"
+	// OutcomeNotFound is the outcome of an operation which failed because the resource does not exist.
+	OutcomeNotFound = "not_found"
+	// OutcomeAlreadyExists is the outcome of an operation which failed because the resource already exists.
+	OutcomeAlreadyExists = "already_exists"
+	// OutcomeConflict is the outcome of an operation which failed because of a conflict.
+	OutcomeConflict = "conflict"
+	// OutcomeTimeout is the outcome of an operation which failed because the context deadline was exceeded.
+	OutcomeTimeout = "timeout"
+	// OutcomeCancelled is the outcome of an operation which failed because the context was cancelled.
+	OutcomeCancelled = "cancelled"
+	// OutcomeError is the outcome of an operation which failed for any other reason.
+	OutcomeError = "error"
+)
+
+// tracerProviderGetter is implemented by clients which carry their own TracerProvider, such as clients.Settings.
+type tracerProviderGetter interface {
+	GetTracerProvider() trace.TracerProvider
+}
+
+// ProviderFromContext returns the TracerProvider that should be used for an operation. The TracerProvider of the
+// client is used if the client has a GetTracerProvider method returning a non-nil provider. Otherwise, the provider of
+// the span in the context is used so operations are traced whenever the caller is tracing. If there is no span in the
+// context, the returned provider does not record anything.
+func ProviderFromContext(ctx context.Context, client any) trace.TracerProvider {
+	if getter, ok := client.(tracerProviderGetter); ok {
+		if provider := getter.GetTracerProvider(); provider != nil {
+			return provider
+		}
+	}
+
+	return trace.SpanFromContext(ctx).TracerProvider()
+}
+
+// Tracer returns the eco-goinfra tracer from the provider, or from the span in the context if the provider is nil.
+func Tracer(ctx context.Context, provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = trace.SpanFromContext(ctx).TracerProvider()
+	}
+
+	return provider.Tracer(TracerName)
+}
+
+// ResourceAttributes returns the attributes describing a resource. The name and namespace are omitted when empty.
+func ResourceAttributes(gvk schema.GroupVersionKind, name, namespace string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		GroupKey.String(gvk.Group),
+		VersionKey.String(gvk.Version),
+		KindKey.String(gvk.Kind),
+	}
+
+	if name != "" {
+		attributes = append(attributes, NameKey.String(name))
+	}
+
+	if namespace != "" {
+		attributes = append(attributes, NamespaceKey.String(namespace))
+	}
+
+	return attributes
+}
+
+// Outcome classifies the error returned from an operation into one of the Outcome constants.
+func Outcome(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case k8serrors.IsNotFound(err):
+		return OutcomeNotFound
+	case k8serrors.IsAlreadyExists(err):
+		return OutcomeAlreadyExists
+	case k8serrors.IsConflict(err):
+		return OutcomeConflict
+	case errors.Is(err, context.DeadlineExceeded):
+		return OutcomeTimeout
+	case errors.Is(err, context.Canceled):
+		return OutcomeCancelled
+	default:
+		return OutcomeError
+	}
+}
+
+// EndSpan records the outcome of the operation on the span and ends it. If err is not nil, it is recorded on the span
+// and the span status is set to error.
+func EndSpan(span trace.Span, err error) {
+	span.SetAttributes(OutcomeKey.String(Outcome(err)))
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceKey is the attribute key for the service an HTTP request was sent to, such as redfish or o2ims.
+const ServiceKey = attribute.Key("eco_goinfra.service")
+
+// transport is an http.RoundTripper that creates a client span around each request sent using the base RoundTripper.
+type transport struct {
+	base     http.RoundTripper
+	provider trace.TracerProvider
+	service  string
+}
+
+// NewTransport returns an http.RoundTripper which sends requests using base, creating a client span around each one.
+// The span is named after the service and request method and has attributes for the method, server, path, status code,
+// and outcome. If base is nil, http.DefaultTransport is used. If provider is nil, the provider of the span in the
+// request context is used.
+func NewTransport(base http.RoundTripper, provider trace.TracerProvider, service string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &transport{base: base, provider: provider, service: service}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (transport *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx, span := Tracer(request.Context(), transport.provider).Start(
+		request.Context(),
+		transport.service+" "+request.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(request.Method),
+			semconv.ServerAddress(request.URL.Hostname()),
+			semconv.URLPath(request.URL.Path),
+			ServiceKey.String(transport.service),
+		))
+
+	response, err := transport.base.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		EndSpan(span, err)
+
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode), OutcomeKey.String(httpOutcome(response)))
+
+	if response.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, strconv.Itoa(response.StatusCode))
+	}
+
+	span.End()
+
+	return response, nil
+}
+
+// httpOutcome classifies the status code of the response into one of the Outcome constants.
+func httpOutcome(response *http.Response) string {
+	switch {
+	case response.StatusCode < http.StatusBadRequest:
+		return OutcomeSuccess
+	case response.StatusCode == http.StatusNotFound:
+		return OutcomeNotFound
+	case response.StatusCode == http.StatusConflict:
+		return OutcomeConflict
+	default:
+		return OutcomeError
+	}
+}
@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		statusCode      int
+		expectedOutcome string
+		expectedStatus  codes.Code
+	}{
+		{
+			name:            "success",
+			statusCode:      http.StatusOK,
+			expectedOutcome: OutcomeSuccess,
+			expectedStatus:  codes.Unset,
+		},
+		{
+			name:            "not found",
+			statusCode:      http.StatusNotFound,
+			expectedOutcome: OutcomeNotFound,
+			expectedStatus:  codes.Error,
+		},
+		{
+			name:            "server error",
+			statusCode:      http.StatusInternalServerError,
+			expectedOutcome: OutcomeError,
+			expectedStatus:  codes.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				writer.WriteHeader(testCase.statusCode)
+			}))
+			defer server.Close()
+
+			exporter := tracetest.NewInMemoryExporter()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+			client := &http.Client{Transport: NewTransport(nil, provider, "test")}
+
+			request, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, server.URL+"/test/path", nil)
+			require.NoError(t, err)
+
+			response, err := client.Do(request)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 1)
+			assert.Equal(t, "test GET", spans[0].Name)
+			assert.Equal(t, testCase.expectedStatus, spans[0].Status.Code)
+
+			assertAttribute(t, spans[0], OutcomeKey, testCase.expectedOutcome)
+			assertAttribute(t, spans[0], semconv.URLPathKey, "/test/path")
+			assertAttribute(t, spans[0], semconv.HTTPRequestMethodKey, http.MethodGet)
+
+			attributes := attribute.NewSet(spans[0].Attributes...)
+			statusCode, found := attributes.Value(semconv.HTTPResponseStatusCodeKey)
+			assert.True(t, found)
+			assert.Equal(t, int64(testCase.statusCode), statusCode.AsInt64())
+		})
+	}
+}
+
+func TestTransportRequestError(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	client := &http.Client{Transport: NewTransport(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}), provider, "test")}
+
+	request, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, "http://example.com", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(request)
+	require.Error(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	require.Len(t, spans[0].Events, 1)
+	assert.Equal(t, semconv.ExceptionEventName, spans[0].Events[0].Name)
+	assertAttribute(t, spans[0], OutcomeKey, OutcomeError)
+}
+
+func TestOutcome(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		err      error
+		expected string
+	}{
+		{err: nil, expected: OutcomeSuccess},
+		{err: k8serrors.NewNotFound(schema.GroupResource{}, "test"), expected: OutcomeNotFound},
+		{err: k8serrors.NewAlreadyExists(schema.GroupResource{}, "test"), expected: OutcomeAlreadyExists},
+		{err: k8serrors.NewConflict(schema.GroupResource{}, "test", errors.New("test")), expected: OutcomeConflict},
+		{err: context.DeadlineExceeded, expected: OutcomeTimeout},
+		{err: context.Canceled, expected: OutcomeCancelled},
+		{err: errors.New("test"), expected: OutcomeError},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, Outcome(testCase.err))
+	}
+}
+
+// roundTripperFunc allows a function to be used as an http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements the http.RoundTripper interface.
+func (function roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return function(request)
+}
+
+// assertAttribute asserts that the span has a string attribute with the provided key and value.
+func assertAttribute(t *testing.T, span tracetest.SpanStub, key attribute.Key, expected string) {
+	t.Helper()
+
+	attributes := attribute.NewSet(span.Attributes...)
+	value, found := attributes.Value(key)
+	assert.True(t, found, "attribute %s not found", key)
+	assert.Equal(t, expected, value.AsString())
+}
@@ -568,6 +568,39 @@ func TestImageBasedUpgradeWaitUntilStageComplete(t *testing.T) {
 	}
 }
 
+func TestImageBasedUpgradeWaitUntilStageCompleteStatusTransitions(t *testing.T) {
+	stageCompleted := func(stage string) func(*lcav1.ImageBasedUpgrade) {
+		return func(ibu *lcav1.ImageBasedUpgrade) {
+			ibu.Status.Conditions = []metav1.Condition{{
+				Type:    stage + "InProgress",
+				Status:  isFalse,
+				Reason:  isComplete,
+				Message: stage + " completed",
+			}}
+		}
+	}
+
+	// Pulling reads the imagebasedupgrade once, then each wait checks that it exists and reads it once right away, so
+	// every transition happens on the first poll of the wait that expects it.
+	testSettings, err := clients.NewTestClientBuilder().
+		WithObjects(buildDummyIBU()...).
+		WithSchemeAttachers(lcav1TestSchemes...).
+		WithStatusTransitions(&lcav1.ImageBasedUpgrade{},
+			clients.AfterReads(2, stageCompleted("Prep")),
+			clients.AfterReads(4, stageCompleted("Upgrade"))).
+		Build()
+	assert.Nil(t, err)
+
+	ibuBuilder, err := PullImageBasedUpgrade(testSettings)
+	assert.Nil(t, err)
+
+	for _, stage := range []string{"Prep", "Upgrade"} {
+		ibuBuilder, err = ibuBuilder.WaitUntilStageComplete(stage)
+		assert.Nil(t, err)
+		assert.Equal(t, stage+"InProgress", ibuBuilder.Object.Status.Conditions[0].Type)
+	}
+}
+
 func TestImageBasedUpgradeWithStage(t *testing.T) {
 	testCases := []struct {
 		expectedError       error
@@ -476,6 +476,24 @@ func TestIPConfigWaitUntilComplete(t *testing.T) {
 	}
 }
 
+func TestIPConfigWaitUntilCompleteStatusTransitions(t *testing.T) {
+	// The ipconfig is read once to check that it exists and completes on the first poll after that.
+	testSettings, err := clients.NewTestClientBuilder().
+		WithObjects(generateIPConfig(ipConfigName)).
+		WithSchemeAttachers(lcaipcv1TestSchemes...).
+		WithStatusTransitions(&lcaipcv1.IPConfig{},
+			clients.AfterReads(1, func(ipConfig *lcaipcv1.IPConfig) {
+				ipConfig.Status.Conditions = []metav1.Condition{
+					{Status: "True", Type: "ConfigCompleted", Reason: "Completed"}}
+			})).
+		Build()
+	assert.Nil(t, err)
+
+	testIPConfigBuilder, err := buildValidIPConfigBuilder(testSettings).WaitUntilComplete(10 * time.Second)
+	assert.Nil(t, err)
+	assert.Len(t, testIPConfigBuilder.Object.Status.Conditions, 1)
+}
+
 func buildTestIPConfigBuilderWithFakeObjects() *IPConfigBuilder {
 	apiClient := buildIPConfigTestClientWithDummyObject(buildDummyIPConfigRuntime())
 
@@ -340,6 +340,24 @@ func TestSeedGeneratorWaitUntilComplete(t *testing.T) {
 	}
 }
 
+func TestSeedGeneratorWaitUntilCompleteStatusTransitions(t *testing.T) {
+	// The seedgenerator is read once to check that it exists and completes on the first poll after that.
+	testSettings, err := clients.NewTestClientBuilder().
+		WithObjects(generateSeedGenerator(seedImageName)).
+		WithSchemeAttachers(lcasgv1TestSchemes...).
+		WithStatusTransitions(&lcasgv1.SeedGenerator{},
+			clients.AfterReads(1, func(seedGenerator *lcasgv1.SeedGenerator) {
+				seedGenerator.Status.Conditions = []metav1.Condition{
+					{Status: "True", Type: "SeedGenCompleted", Reason: "Completed"}}
+			})).
+		Build()
+	assert.Nil(t, err)
+
+	testSeedGeneratorBuilder, err := buildValidSeedGeneratorBuilder(testSettings).WaitUntilComplete(10 * time.Second)
+	assert.Nil(t, err)
+	assert.Len(t, testSeedGeneratorBuilder.Object.Status.Conditions, 1)
+}
+
 func buildTestBuilderWithFakeObjects() *SeedGeneratorBuilder {
 	return NewSeedGeneratorBuilder(
 		buildSeedGeneratorTestClientWithDummyObject(buildDummySeedGeneratorRuntime()), seedImageName)
@@ -43,7 +43,8 @@ func ListWorkerMachineSets(
 
 	klog.V(100).Infof("%v", logMessage)
 
-	machineSetList, err := apiClient.MachineSets(namespace).List(logging.DiscardContext(), passedOptions)
+	machineSetList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.MachineSets(namespace).List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list MachineSets in the namespace %s due to %s",
 			namespace, err.Error())
@@ -0,0 +1,136 @@
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// kustomizationFileName is the name of the kustomization file written by WriteKustomization.
+const kustomizationFileName = "kustomization.yaml"
+
+// Exporter is implemented by builders that can export their definition as a YAML manifest.
+type Exporter interface {
+	ToYAML() ([]byte, error)
+}
+
+// kustomization is the subset of the kustomize Kustomization type that WriteKustomization sets.
+type kustomization struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Resources  []string `json:"resources"`
+}
+
+// WriteBundle exports each of the builders and writes them to a single multi-document YAML file at the provided path,
+// in the order they are provided. The file is overwritten if it exists.
+func WriteBundle(path string, exporters ...Exporter) error {
+	klog.V(100).Infof("Writing bundle of %d manifests to %s", len(exporters), path)
+
+	var bundle bytes.Buffer
+
+	for index, exporter := range exporters {
+		manifest, err := exportManifest(exporter, index)
+		if err != nil {
+			return err
+		}
+
+		bundle.WriteString("---\n")
+		bundle.Write(manifest)
+	}
+
+	err := os.WriteFile(path, bundle.Bytes(), 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write bundle %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// WriteKustomization exports each of the builders and writes them as separate files in the provided directory, along
+// with a kustomization.yaml listing them as resources in the order they are provided. Files are named after the
+// namespace, kind, and name of each resource. The directory is created if it does not exist.
+func WriteKustomization(directory string, exporters ...Exporter) error {
+	klog.V(100).Infof("Writing kustomization of %d manifests to %s", len(exporters), directory)
+
+	err := os.MkdirAll(directory, 0o750)
+	if err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", directory, err)
+	}
+
+	config := kustomization{APIVersion: "kustomize.config.k8s.io/v1beta1", Kind: "Kustomization"}
+
+	for index, exporter := range exporters {
+		manifest, err := exportManifest(exporter, index)
+		if err != nil {
+			return err
+		}
+
+		fileName, err := getManifestFileName(manifest)
+		if err != nil {
+			return fmt.Errorf("failed to name manifest %d: %w", index, err)
+		}
+
+		for _, resource := range config.Resources {
+			if resource == fileName {
+				return fmt.Errorf("manifest %d has the same file name as another manifest: %s", index, fileName)
+			}
+		}
+
+		err = os.WriteFile(filepath.Join(directory, fileName), manifest, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to write manifest %s: %w", fileName, err)
+		}
+
+		config.Resources = append(config.Resources, fileName)
+	}
+
+	kustomizationYAML, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+
+	err = os.WriteFile(filepath.Join(directory, kustomizationFileName), kustomizationYAML, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write kustomization: %w", err)
+	}
+
+	return nil
+}
+
+// exportManifest exports the YAML manifest for a builder, wrapping errors with its index.
+func exportManifest(exporter Exporter, index int) ([]byte, error) {
+	if exporter == nil {
+		return nil, fmt.Errorf("cannot export manifest %d from nil builder", index)
+	}
+
+	manifest, err := exporter.ToYAML()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export manifest %d: %w", index, err)
+	}
+
+	return manifest, nil
+}
+
+// getManifestFileName returns the file name for a manifest in the form namespace-kind-name.yaml, or kind-name.yaml for
+// cluster-scoped resources.
+func getManifestFileName(manifest []byte) (string, error) {
+	var metadata metav1.PartialObjectMetadata
+
+	err := yaml.Unmarshal(manifest, &metadata)
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{metadata.Kind, metadata.Name}
+	if metadata.Namespace != "" {
+		parts = append([]string{metadata.Namespace}, parts...)
+	}
+
+	return strings.ToLower(strings.Join(parts, "-")) + ".yaml", nil
+}
@@ -0,0 +1,48 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteBundle(t *testing.T) {
+	bundlePath := filepath.Join(t.TempDir(), "bundle.yaml")
+
+	err := WriteBundle(bundlePath, buildTestConfigMapBuilder("first"), buildTestConfigMapBuilder("second"))
+	require.NoError(t, err)
+
+	builders, err := NewLoader(clients.GetTestClients(clients.TestClientParams{})).LoadFile(bundlePath)
+	assert.NoError(t, err)
+	assert.Len(t, builders, 2)
+
+	err = WriteBundle(bundlePath, nil)
+	assert.EqualError(t, err, "cannot export manifest 0 from nil builder")
+}
+
+func TestWriteKustomization(t *testing.T) {
+	directory := filepath.Join(t.TempDir(), "kustomization")
+
+	err := WriteKustomization(directory, buildTestConfigMapBuilder("first"), buildTestConfigMapBuilder("second"))
+	require.NoError(t, err)
+
+	kustomizationYAML, err := os.ReadFile(filepath.Join(directory, kustomizationFileName))
+	assert.NoError(t, err)
+	assert.Equal(t, "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n"+
+		"- test-namespace-configmap-first.yaml\n- test-namespace-configmap-second.yaml\n", string(kustomizationYAML))
+	assert.FileExists(t, filepath.Join(directory, "test-namespace-configmap-first.yaml"))
+
+	err = WriteKustomization(directory, buildTestConfigMapBuilder("first"), buildTestConfigMapBuilder("first"))
+	assert.EqualError(t, err,
+		"manifest 1 has the same file name as another manifest: test-namespace-configmap-first.yaml")
+}
+
+func buildTestConfigMapBuilder(name string) *configmap.Builder {
+	return configmap.NewBuilder(clients.GetTestClients(clients.TestClientParams{}), name, "test-namespace").
+		WithData(map[string]string{"key": "value"})
+}
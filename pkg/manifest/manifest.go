@@ -0,0 +1,156 @@
+// Package manifest provides a loader that creates builders from multi-document YAML or JSON manifests, choosing the
+// builder type based on the kind of each document.
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/deployment"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/namespace"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/serviceaccount"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog/v2"
+)
+
+// BuilderFactory creates a builder from a single manifest document. Errors in the manifest should be reported through
+// the returned builder, the same as with the builder's other constructors.
+type BuilderFactory func(apiClient *clients.Settings, manifest []byte) any
+
+// Loader creates builders from manifests using the factory registered for the kind of each document.
+type Loader struct {
+	apiClient *clients.Settings
+	factories map[schema.GroupVersionKind]BuilderFactory
+}
+
+// NewLoader creates a new Loader with factories registered for all of the builders that support being created from a
+// manifest: *pod.Builder, *deployment.Builder, *configmap.Builder, *namespace.Builder, *secret.Builder,
+// *service.Builder, and *serviceaccount.Builder. Other kinds may be added using Register.
+func NewLoader(apiClient *clients.Settings) *Loader {
+	loader := &Loader{
+		apiClient: apiClient,
+		factories: make(map[schema.GroupVersionKind]BuilderFactory),
+	}
+
+	loader.Register(corev1.SchemeGroupVersion.WithKind("Pod"), func(apiClient *clients.Settings, manifest []byte) any {
+		return pod.NewBuilderFromManifest(apiClient, manifest)
+	})
+	loader.Register(appsv1.SchemeGroupVersion.WithKind("Deployment"),
+		func(apiClient *clients.Settings, manifest []byte) any {
+			return deployment.NewBuilderFromManifest(apiClient, manifest)
+		})
+	loader.Register(corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+		func(apiClient *clients.Settings, manifest []byte) any {
+			return configmap.NewBuilderFromManifest(apiClient, manifest)
+		})
+	loader.Register(corev1.SchemeGroupVersion.WithKind("Namespace"),
+		func(apiClient *clients.Settings, manifest []byte) any {
+			return namespace.NewBuilderFromManifest(apiClient, manifest)
+		})
+	loader.Register(corev1.SchemeGroupVersion.WithKind("Secret"), func(apiClient *clients.Settings, manifest []byte) any {
+		return secret.NewBuilderFromManifest(apiClient, manifest)
+	})
+	loader.Register(corev1.SchemeGroupVersion.WithKind("Service"),
+		func(apiClient *clients.Settings, manifest []byte) any {
+			return service.NewBuilderFromManifest(apiClient, manifest)
+		})
+	loader.Register(corev1.SchemeGroupVersion.WithKind("ServiceAccount"),
+		func(apiClient *clients.Settings, manifest []byte) any {
+			return serviceaccount.NewBuilderFromManifest(apiClient, manifest)
+		})
+
+	return loader
+}
+
+// Register sets the factory used for documents of the provided kind, replacing any existing factory for it.
+func (loader *Loader) Register(gvk schema.GroupVersionKind, factory BuilderFactory) *Loader {
+	if loader == nil {
+		return nil
+	}
+
+	loader.factories[gvk] = factory
+
+	return loader
+}
+
+// Load creates a builder for each document in the manifests, in the order they appear. Callers should use a type
+// switch to get the concrete builder types. An error is returned if a document cannot be read or has a kind with no
+// registered factory.
+func (loader *Loader) Load(manifests []byte) ([]any, error) {
+	if loader == nil {
+		return nil, fmt.Errorf("cannot load manifests using nil loader")
+	}
+
+	if loader.apiClient == nil {
+		klog.V(100).Info("The apiClient of the manifest loader is nil")
+
+		return nil, fmt.Errorf("cannot load manifests using nil apiClient")
+	}
+
+	documents, err := common.SplitManifestDocuments(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Loading builders from %d manifest documents", len(documents))
+
+	var builders []any
+
+	for index, document := range documents {
+		gvk, err := getDocumentGVK(document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kind of document %d: %w", index, err)
+		}
+
+		factory, ok := loader.factories[gvk]
+		if !ok {
+			return nil, fmt.Errorf("no builder registered for kind %s in document %d", gvk.String(), index)
+		}
+
+		builders = append(builders, factory(loader.apiClient, document))
+	}
+
+	return builders, nil
+}
+
+// LoadFile is the same as Load but reads the manifests from the file at the provided path.
+func (loader *Loader) LoadFile(path string) ([]any, error) {
+	manifests, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file %s: %w", path, err)
+	}
+
+	return loader.Load(manifests)
+}
+
+// getDocumentGVK reads the apiVersion and kind from a single YAML or JSON document.
+func getDocumentGVK(document []byte) (schema.GroupVersionKind, error) {
+	jsonDocument, err := yaml.ToJSON(document)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+
+	var typeMeta metav1.TypeMeta
+
+	err = json.Unmarshal(jsonDocument, &typeMeta)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+
+	if typeMeta.APIVersion == "" || typeMeta.Kind == "" {
+		return schema.GroupVersionKind{}, fmt.Errorf("document is missing apiVersion or kind")
+	}
+
+	return schema.FromAPIVersionAndKind(typeMeta.APIVersion, typeMeta.Kind), nil
+}
@@ -0,0 +1,173 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/deployment"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/namespace"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/serviceaccount"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const testManifests = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-configmap
+  namespace: test-namespace
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: test-namespace
+spec:
+  selector:
+    matchLabels:
+      app: test
+  template:
+    metadata:
+      labels:
+        app: test
+    spec:
+      containers:
+      - name: test
+        image: test-image
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test-pod
+  namespace: test-namespace
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test-namespace
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: test-secret
+  namespace: test-namespace
+type: Opaque
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: test-service
+  namespace: test-namespace
+spec:
+  ports:
+  - port: 8080
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: test-serviceaccount
+  namespace: test-namespace
+`
+
+func TestLoaderLoad(t *testing.T) {
+	testCases := []struct {
+		manifests     string
+		client        bool
+		expectedCount int
+		expectedError string
+	}{
+		{
+			manifests:     testManifests,
+			client:        true,
+			expectedCount: 7,
+			expectedError: "",
+		},
+		{
+			manifests:     "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: test\n",
+			client:        true,
+			expectedError: "no builder registered for kind batch/v1, Kind=Job in document 0",
+		},
+		{
+			manifests:     "metadata:\n  name: test\n",
+			client:        true,
+			expectedError: "failed to read kind of document 0: document is missing apiVersion or kind",
+		},
+		{
+			manifests:     testManifests,
+			client:        false,
+			expectedError: "cannot load manifests using nil apiClient",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		builders, err := NewLoader(testSettings).Load([]byte(testCase.manifests))
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Len(t, builders, testCase.expectedCount)
+
+		configMapBuilder, ok := builders[0].(*configmap.Builder)
+		assert.True(t, ok)
+		assert.Equal(t, "test-configmap", configMapBuilder.Definition.Name)
+
+		deploymentBuilder, ok := builders[1].(*deployment.Builder)
+		assert.True(t, ok)
+		assert.Equal(t, "test-image", deploymentBuilder.Definition.Spec.Template.Spec.Containers[0].Image)
+
+		podBuilder, ok := builders[2].(*pod.Builder)
+		assert.True(t, ok)
+		assert.Equal(t, "test-pod", podBuilder.Definition.Name)
+
+		namespaceBuilder, ok := builders[3].(*namespace.Builder)
+		assert.True(t, ok)
+		assert.Equal(t, "test-namespace", namespaceBuilder.Definition.Name)
+
+		secretBuilder, ok := builders[4].(*secret.Builder)
+		assert.True(t, ok)
+		assert.Equal(t, corev1.SecretTypeOpaque, secretBuilder.Definition.Type)
+
+		serviceBuilder, ok := builders[5].(*service.Builder)
+		assert.True(t, ok)
+		assert.Equal(t, int32(8080), serviceBuilder.Definition.Spec.Ports[0].Port)
+
+		serviceAccountBuilder, ok := builders[6].(*serviceaccount.Builder)
+		assert.True(t, ok)
+		assert.Equal(t, "test-serviceaccount", serviceAccountBuilder.Definition.Name)
+	}
+}
+
+func TestLoaderRegisterAndLoadFile(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "manifests.yaml")
+	err := os.WriteFile(manifestPath, []byte("apiVersion: v1\nkind: Secret\nmetadata:\n  name: test\n"), 0o600)
+	assert.NoError(t, err)
+
+	loader := NewLoader(clients.GetTestClients(clients.TestClientParams{})).Register(
+		corev1.SchemeGroupVersion.WithKind("Secret"), func(_ *clients.Settings, manifest []byte) any {
+			return string(manifest)
+		})
+
+	builders, err := loader.LoadFile(manifestPath)
+	assert.NoError(t, err)
+	assert.Len(t, builders, 1)
+
+	_, err = loader.LoadFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read manifest file")
+}
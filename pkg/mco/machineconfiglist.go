@@ -43,7 +43,7 @@ func ListMC(apiClient *clients.Settings, options ...runtimeclient.ListOptions) (
 
 	mcList := new(mcv1.MachineConfigList)
 
-	err = apiClient.List(logging.DiscardContext(), mcList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, mcList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list MC objects due to %s", err.Error())
 
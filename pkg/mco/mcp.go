@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -238,21 +239,7 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 	klog.V(100).Infof("WaitToBeInCondition waits up to specified time duration %v until "+
 		"MachineConfigPool condition %v is met", timeout, conditionType)
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-			mcp, err := builder.Get()
-			if err != nil {
-				return false, nil
-			}
-
-			for _, condition := range mcp.Status.Conditions {
-				if condition.Type == conditionType && condition.Status == conditionStatus {
-					return true, nil
-				}
-			}
-
-			return false, nil
-		})
+	return builder.waitUntilInCondition(conditionType, conditionStatus, timeout)
 }
 
 // WaitForUpdate waits for a MachineConfigPool to be updating and then updated.
@@ -269,30 +256,46 @@ func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
 		return err
 	}
 
-	for _, condition := range mcpUpdating.Status.Conditions {
-		if condition.Type == "Updating" && condition.Status == corev1.ConditionTrue {
-			err := wait.PollUntilContextTimeout(
-				context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-					mcpUpdated, err := builder.Get()
-					if err != nil {
-						return false, nil
-					}
+	if !hasMCPCondition(mcpUpdating, mcv1.MachineConfigPoolUpdating, corev1.ConditionTrue) {
+		return nil
+	}
 
-					for _, condition := range mcpUpdated.Status.Conditions {
-						if condition.Type == "Updated" && condition.Status == corev1.ConditionTrue {
-							return true, nil
-						}
-					}
+	return builder.waitUntilInCondition(mcv1.MachineConfigPoolUpdated, corev1.ConditionTrue, timeout)
+}
 
-					return false, nil
-				})
-			if err != nil {
-				return err
-			}
+// waitUntilInCondition watches the MachineConfigPool until it has the condition type with the expected status or the
+// timeout elapses. It assumes the builder has already been validated.
+func (builder *MCPBuilder) waitUntilInCondition(
+	conditionType mcv1.MachineConfigPoolConditionType,
+	conditionStatus corev1.ConditionStatus,
+	timeout time.Duration,
+) error {
+	_, err := common.WaitUntilObject(context.TODO(), builder.apiClient,
+		mcv1.GroupVersion.WithKind("MachineConfigPool"), builder.Definition, timeout,
+		func(mcp *mcv1.MachineConfigPool) (bool, error) {
+			return hasMCPCondition(mcp, conditionType, conditionStatus), nil
+		})
+
+	return err
+}
+
+// hasMCPCondition returns true if the MachineConfigPool exists and has the condition type with the expected status.
+func hasMCPCondition(
+	mcp *mcv1.MachineConfigPool,
+	conditionType mcv1.MachineConfigPoolConditionType,
+	conditionStatus corev1.ConditionStatus,
+) bool {
+	if mcp == nil {
+		return false
+	}
+
+	for _, condition := range mcp.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == conditionStatus {
+			return true
 		}
 	}
 
-	return nil
+	return false
 }
 
 // WaitToBeStableFor waits on MachineConfigPool to stable for a time duration or until timeout.
@@ -2,12 +2,14 @@ package mco
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	mcv1 "github.com/openshift/api/machineconfiguration/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -306,7 +308,7 @@ func TestMachineConfigPoolWaitToBeInCondition(t *testing.T) {
 		testBuilder := buildMCPBuilderWithUpdatingCondition(testCase.exists, testCase.hasCondition, testCase.valid)
 		err := testBuilder.WaitToBeInCondition(updatingMCPCondition.Type, updatingMCPCondition.Status, time.Second)
 
-		assert.Equal(t, testCase.expectedError, err)
+		assertMCPWaitError(t, testCase.expectedError, err)
 	}
 }
 
@@ -348,11 +350,7 @@ func TestMachineConfigPoolWaitForUpdate(t *testing.T) {
 		testBuilder := buildMCPBuilderWithUpdatingCondition(testCase.exists, testCase.updating, testCase.valid)
 		err := testBuilder.WaitForUpdate(time.Second)
 
-		if testCase.expectedError == nil {
-			assert.Nil(t, err)
-		} else {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
-		}
+		assertMCPWaitError(t, testCase.expectedError, err)
 	}
 }
 
@@ -563,3 +561,23 @@ func buildMCPBuilderWithUpdatingCondition(exists, hasCondition, valid bool) *MCP
 
 	return testBuilder
 }
+
+// assertMCPWaitError asserts that err matches expectedError. Context errors are expected to be wrapped in a wait timeout.
+func assertMCPWaitError(t *testing.T, expectedError, err error) {
+	t.Helper()
+
+	if expectedError == nil {
+		assert.Nil(t, err)
+
+		return
+	}
+
+	if errors.Is(expectedError, context.DeadlineExceeded) {
+		assert.True(t, goinfraerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+		assert.ErrorIs(t, err, expectedError)
+
+		return
+	}
+
+	assert.EqualError(t, err, expectedError.Error())
+}
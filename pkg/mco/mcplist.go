@@ -47,7 +47,7 @@ func ListMCP(apiClient *clients.Settings, options ...runtimeclient.ListOptions)
 
 	mcpList := new(mcv1.MachineConfigPoolList)
 
-	err = apiClient.List(logging.DiscardContext(), mcpList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, mcpList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list MCP objects due to %s", err.Error())
 
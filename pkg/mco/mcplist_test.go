@@ -159,3 +159,18 @@ func TestListMCPWaitToBeStableFor(t *testing.T) {
 		assert.Equal(t, testCase.expectedError, err)
 	}
 }
+
+func TestListMCPLimit(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildDummyMCP("mcp-0"), buildDummyMCP("mcp-1"), buildDummyMCP("mcp-2")},
+		SchemeAttachers: testSchemes,
+	})
+
+	mcBuilders, err := ListMCP(testSettings, clients.RuntimeListOptionsFrom(runtimeclient.Limit(2)))
+	assert.Nil(t, err)
+	assert.Len(t, mcBuilders, 2)
+
+	mcBuilders, err = ListMCP(testSettings)
+	assert.Nil(t, err)
+	assert.Len(t, mcBuilders, 3)
+}
@@ -44,7 +44,7 @@ func ListBGPSessionState(
 
 	bgpSessionStateList := new(frrtypes.BGPSessionStateList)
 
-	err = apiClient.List(logging.DiscardContext(), bgpSessionStateList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, bgpSessionStateList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list BGPSessionStates due to %s", err.Error())
 
@@ -44,7 +44,7 @@ func ListFrrNodeState(
 
 	frrNodeStateList := new(frrtypes.FRRNodeStateList)
 
-	err = apiClient.List(logging.DiscardContext(), frrNodeStateList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, frrNodeStateList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list FrrNodeStates due to %s", err.Error())
 
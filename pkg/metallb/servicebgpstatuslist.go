@@ -44,7 +44,7 @@ func ListServiceBGPStatus(
 
 	serviceBGPStatusList := new(mlbtypes.ServiceBGPStatusList)
 
-	err = apiClient.List(logging.DiscardContext(), serviceBGPStatusList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, serviceBGPStatusList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list ServiceBGPStatuses due to %s", err.Error())
 
@@ -37,7 +37,7 @@ func List(apiClient *clients.Settings, nsname string) ([]*Builder, error) {
 
 	nadList := &nadV1.NetworkAttachmentDefinitionList{}
 
-	err = apiClient.List(logging.DiscardContext(), nadList, &goclient.ListOptions{Namespace: nsname})
+	err = clients.ListInto(logging.DiscardContext(), apiClient, nadList, &goclient.ListOptions{Namespace: nsname})
 	if err != nil {
 		klog.V(100).Infof("Failed to list NADs in namespace: %s due to %s",
 			nsname, err.Error())
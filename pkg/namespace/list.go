@@ -27,7 +27,8 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 
 	klog.V(100).Infof("%v", logMessage)
 
-	namespacesList, err := apiClient.CoreV1Interface.Namespaces().List(logging.DiscardContext(), passedOptions)
+	namespacesList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.CoreV1Interface.Namespaces().List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list namespaces due to %s", err.Error())
 
@@ -44,6 +45,8 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 			Definition: &copiedNamespace,
 		}
 
+		namespaceBuilder.AttachMixins()
+
 		namespaceObjects = append(namespaceObjects, namespaceBuilder)
 	}
 
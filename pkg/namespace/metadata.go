@@ -0,0 +1,83 @@
+package namespace
+
+import (
+	"errors"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Accessors required by common.Builder, which the namespace Builder implements only so it can embed the
+// common.EmbeddableMetadata, common.EmbeddableApplier, and common.EmbeddablePatcher mixins. Callers should use the
+// Definition and Object fields instead.
+
+// AttachMixins attaches the mixins to the builder. It is called by the functions which create builders.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableMetadata.SetBase(builder)
+	builder.EmbeddableApplier.SetBase(builder)
+	builder.EmbeddablePatcher.SetBase(builder)
+}
+
+// GetDefinition returns the definition of the namespace.
+func (builder *Builder) GetDefinition() *corev1.Namespace {
+	return builder.Definition
+}
+
+// SetDefinition sets the definition of the namespace.
+func (builder *Builder) SetDefinition(definition *corev1.Namespace) {
+	builder.Definition = definition
+}
+
+// GetObject returns the namespace as last pulled from the cluster.
+func (builder *Builder) GetObject() *corev1.Namespace {
+	return builder.Object
+}
+
+// SetObject sets the namespace as last pulled from the cluster.
+func (builder *Builder) SetObject(object *corev1.Namespace) {
+	builder.Object = object
+}
+
+// GetError returns the error stored in the builder, or nil if there is none.
+func (builder *Builder) GetError() error {
+	if builder.errorMsg == "" {
+		return nil
+	}
+
+	return errors.New(builder.errorMsg)
+}
+
+// SetError stores the error in the builder, clearing it if err is nil.
+func (builder *Builder) SetError(err error) {
+	builder.errorMsg = ""
+
+	if err != nil {
+		builder.errorMsg = err.Error()
+	}
+}
+
+// GetClient returns the client used to connect to the cluster.
+func (builder *Builder) GetClient() runtimeclient.Client {
+	if builder.apiClient == nil {
+		return nil
+	}
+
+	return builder.apiClient
+}
+
+// SetClient sets the client used to connect to the cluster. Only *clients.Settings is supported, since the other
+// methods of the builder use its clientsets.
+func (builder *Builder) SetClient(apiClient runtimeclient.Client) {
+	settings, _ := apiClient.(*clients.Settings)
+	builder.apiClient = settings
+}
+
+// GetGVK returns the GVK of a namespace.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return corev1.SchemeGroupVersion.WithKind("Namespace")
+}
+
+// SetGVK does nothing since the GVK of a namespace is constant.
+func (builder *Builder) SetGVK(schema.GroupVersionKind) {}
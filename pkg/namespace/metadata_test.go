@@ -0,0 +1,40 @@
+package namespace
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceMetadata(t *testing.T) {
+	// StripFinalizers patches using the runtime client, so the clients must share a tracker.
+	testSettings, err := clients.NewTestClientBuilder().WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:       "test-namespace",
+		Finalizers: []string{"example.com/finalizer"},
+	}}).Build()
+	assert.Nil(t, err)
+
+	builder := NewBuilder(testSettings, "test-namespace").
+		WithAnnotation("example.com/annotation", "value").
+		WithFinalizer("example.com/other")
+	assert.Nil(t, builder.GetError())
+	assert.Equal(t, map[string]string{"example.com/annotation": "value"}, builder.Definition.Annotations)
+	assert.Equal(t, []string{"example.com/other"}, builder.Definition.Finalizers)
+
+	// Errors from the mixin are stored in the builder, so they are returned by the legacy methods too.
+	builder.WithAnnotation("", "value")
+	assert.ErrorContains(t, builder.GetError(), "annotation key")
+
+	_, err = builder.Create()
+	assert.ErrorContains(t, err, "annotation key")
+
+	pulledBuilder, err := Pull(testSettings, "test-namespace")
+	assert.Nil(t, err)
+
+	_, err = pulledBuilder.StripFinalizers()
+	assert.Nil(t, err)
+	assert.Empty(t, pulledBuilder.Object.Finalizers)
+}
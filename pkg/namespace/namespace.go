@@ -8,6 +8,7 @@ import (
 	"slices"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
@@ -29,6 +30,14 @@ type Builder struct {
 	// object is created
 	errorMsg  string
 	apiClient *clients.Settings
+
+	// EmbeddableMetadata provides the label, annotation, owner reference, and finalizer modifiers. The existing
+	// WithLabel and RemoveLabels methods of the builder take precedence over those of the mixin.
+	common.EmbeddableMetadata[corev1.Namespace, Builder, *corev1.Namespace, *Builder]
+	// EmbeddableApplier provides Apply, which uses server-side apply instead of the Get-then-Update of Update.
+	common.EmbeddableApplier[corev1.Namespace, Builder, *corev1.Namespace, *Builder]
+	// EmbeddablePatcher provides Patch and PatchChanges, which only send the fields that changed.
+	common.EmbeddablePatcher[corev1.Namespace, Builder, *corev1.Namespace, *Builder]
 }
 
 // AdditionalOptions additional options for namespace object.
@@ -48,6 +57,8 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 		},
 	}
 
+	builder.AttachMixins()
+
 	if name == "" {
 		klog.V(100).Info("The name of the namespace is empty")
 
@@ -59,6 +70,46 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	return builder
 }
 
+// NewBuilderFromManifest creates a new instance of Builder whose definition is decoded from a single YAML or JSON
+// namespace manifest. Unknown fields and kinds other than Namespace are rejected.
+func NewBuilderFromManifest(apiClient *clients.Settings, manifest []byte) *Builder {
+	klog.V(100).Info("Initializing new namespace structure from manifest")
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the namespace is nil")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient:  apiClient,
+		Definition: &corev1.Namespace{},
+	}
+
+	builder.AttachMixins()
+
+	definition, err := common.DecodeManifest[corev1.Namespace](apiClient.Scheme(), manifest)
+	if err != nil {
+		klog.V(100).Infof("Failed to decode namespace manifest: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("failed to decode namespace manifest: %v", err)
+
+		return builder
+	}
+
+	builder.Definition = definition
+
+	if builder.Definition.Name == "" {
+		klog.V(100).Info("The name of the namespace is empty")
+
+		builder.errorMsg = "namespace 'name' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
 // WithLabel redefines namespace definition with the given label.
 func (builder *Builder) WithLabel(key string, value string) *Builder {
 	if valid, _ := builder.validate(); !valid {
@@ -260,6 +311,8 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 		},
 	}
 
+	builder.AttachMixins()
+
 	if nsname == "" {
 		klog.V(100).Info("Namespace name is empty")
 
@@ -270,7 +323,7 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("namespace object %s does not exist", nsname)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
 
 	return builder, nil
 }
@@ -0,0 +1,566 @@
+package namespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/klog/v2"
+)
+
+// snapshotExcludedResources are the resources which are never included in a snapshot taken using discovery. Events
+// are records of what happened in the namespace rather than part of its state, while leases, endpoints, and endpoint
+// slices are continually updated by controllers and leader election, so restoring them would fight those updates.
+// Pods are excluded since most of their spec cannot be updated, so a changed pod could never be reverted.
+var snapshotExcludedResources = []schema.GroupResource{
+	{Group: "", Resource: "events"},
+	{Group: "events.k8s.io", Resource: "events"},
+	{Group: "coordination.k8s.io", Resource: "leases"},
+	{Group: "", Resource: "endpoints"},
+	{Group: "discovery.k8s.io", Resource: "endpointslices"},
+	{Group: "", Resource: "pods"},
+}
+
+// snapshotExcludedObjects are the names of the objects, by resource, which controllers create in every namespace
+// without an owner reference. They are never included in a snapshot, so Restore does not revert their updates, such
+// as a rotated CA bundle.
+var snapshotExcludedObjects = map[schema.GroupResource][]string{
+	{Group: "", Resource: "configmaps"}:      {"kube-root-ca.crt", "openshift-service-ca.crt"},
+	{Group: "", Resource: "serviceaccounts"}: {"default", "builder", "deployer"},
+}
+
+// serviceAccountSecretAnnotations are the annotations set on the secrets that controllers create for ServiceAccounts,
+// such as their tokens and image pull secrets. Secrets with any of them are never included in a snapshot.
+var serviceAccountSecretAnnotations = []string{
+	"kubernetes.io/service-account.name",
+	"openshift.io/internal-registry-auth-token.service-account",
+}
+
+// secretResource is the resource for Secrets, of which those created for ServiceAccounts are not restored.
+var secretResource = schema.GroupResource{Group: "", Resource: "secrets"}
+
+// pvcResource is the resource for PersistentVolumeClaims, whose binding to a volume is not restored.
+var pvcResource = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
+
+// pvcBindingAnnotations are the annotations set on a PersistentVolumeClaim by the controllers which bind it to a
+// volume. Like spec.volumeName, they describe the binding, so they are neither compared nor restored.
+var pvcBindingAnnotations = []string{
+	"pv.kubernetes.io/bind-completed",
+	"pv.kubernetes.io/bound-by-controller",
+	"volume.kubernetes.io/selected-node",
+}
+
+// snapshotRequiredVerbs are the verbs a resource must support to be included in a snapshot taken using discovery.
+var snapshotRequiredVerbs = []string{"list", "create", "update", "delete"}
+
+// Snapshot holds the objects in one or more namespaces at the time it was taken, so that the namespaces can later be
+// returned to that state using Restore. Objects with a controller owner reference, such as the pods of a deployment,
+// are left out of the snapshot and are never changed by Restore, since their controller recreates them. The same is
+// true of the objects controllers create in every namespace without an owner, such as the kube-root-ca.crt ConfigMap,
+// the default ServiceAccount, and the secrets created for ServiceAccounts.
+type Snapshot struct {
+	apiClient  *clients.Settings
+	namespaces []string
+	resources  []schema.GroupVersionResource
+	objects    map[snapshotKey]*unstructured.Unstructured
+}
+
+// snapshotKey identifies an object in a Snapshot.
+type snapshotKey struct {
+	resource  schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// Snapshot takes a snapshot of the objects in the namespace. If no resources are provided, all namespaced resources
+// found using discovery which can be listed, created, updated, and deleted are included, other than events, leases,
+// endpoints, endpoint slices, and pods.
+func (builder *Builder) Snapshot(resources ...schema.GroupVersionResource) (*Snapshot, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return TakeSnapshot(builder.apiClient, []string{builder.Definition.Name}, resources...)
+}
+
+// TakeSnapshot takes a snapshot of the objects in the given namespaces, which must all exist. If no resources are
+// provided, all namespaced resources found using discovery which can be listed, created, updated, and deleted are
+// included, other than events, leases, endpoints, endpoint slices, and pods.
+func TakeSnapshot(
+	apiClient *clients.Settings, nsnames []string, resources ...schema.GroupVersionResource) (*Snapshot, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient for the namespace snapshot is nil")
+
+		return nil, fmt.Errorf("namespace snapshot 'apiClient' cannot be nil")
+	}
+
+	if len(nsnames) == 0 {
+		klog.V(100).Info("The namespaces of the snapshot are empty")
+
+		return nil, fmt.Errorf("failed to take snapshot of empty list of namespaces")
+	}
+
+	klog.V(100).Infof("Taking snapshot of namespaces: %v", nsnames)
+
+	for _, nsname := range nsnames {
+		if err := checkSnapshotNamespace(apiClient, nsname); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(resources) == 0 {
+		var err error
+
+		resources, err = discoverSnapshotResources(apiClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := &Snapshot{
+		apiClient:  apiClient,
+		namespaces: slices.Clone(nsnames),
+		resources:  slices.Clone(resources),
+		objects:    make(map[snapshotKey]*unstructured.Unstructured),
+	}
+
+	for _, nsname := range nsnames {
+		for _, resource := range resources {
+			objList, err := clients.CollectPages(
+				context.TODO(), metav1.ListOptions{}, apiClient.Resource(resource).Namespace(nsname).List)
+			if err != nil {
+				klog.V(100).Infof("Failed to list resources: %s in namespace: %s", resource.Resource, nsname)
+
+				return nil, err
+			}
+
+			for _, object := range objList.Items {
+				if !isSnapshotManaged(resource, &object) || object.GetDeletionTimestamp() != nil {
+					continue
+				}
+
+				saved := object.DeepCopy()
+
+				// Items are not guaranteed to have their type set, but it is required to create them again.
+				if saved.GetKind() == "" {
+					saved.SetAPIVersion(objList.GetAPIVersion())
+					saved.SetKind(strings.TrimSuffix(objList.GetKind(), "List"))
+				}
+
+				snapshot.objects[snapshotKey{resource, nsname, object.GetName()}] = saved
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Namespaces returns the namespaces included in the snapshot.
+func (snapshot *Snapshot) Namespaces() []string {
+	if snapshot == nil {
+		return nil
+	}
+
+	return slices.Clone(snapshot.namespaces)
+}
+
+// Resources returns the resources included in the snapshot.
+func (snapshot *Snapshot) Resources() []schema.GroupVersionResource {
+	if snapshot == nil {
+		return nil
+	}
+
+	return slices.Clone(snapshot.resources)
+}
+
+// Restore returns the namespaces of the snapshot to the state they were in when it was taken. Objects created since
+// the snapshot are deleted, objects deleted since are recreated, and objects whose labels, annotations, or contents
+// other than their status have changed are updated back to their snapshotted values. Recreated objects do not keep
+// their owner references, since the UIDs of their owners may have changed. PersistentVolumeClaims are recreated
+// without their spec.volumeName and binding annotations, since the volume they were bound to is not released for a
+// new claim, and changes to their binding are ignored. The namespaces must still exist.
+//
+// Restore continues past failures to restore individual objects, so that one object which cannot be restored does not
+// prevent the others from being restored, and returns all of the errors joined together. The timeout applies to the
+// whole restore, including waiting for deleted objects to be removed.
+func (snapshot *Snapshot) Restore(timeout time.Duration) error {
+	if snapshot == nil || snapshot.apiClient == nil {
+		klog.V(100).Info("The namespace snapshot is uninitialized")
+
+		return fmt.Errorf("error: received nil namespace snapshot")
+	}
+
+	klog.V(100).Infof("Restoring snapshot of namespaces: %v", snapshot.namespaces)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+	defer cancel()
+
+	var errs []error
+
+	for _, nsname := range snapshot.namespaces {
+		if err := checkSnapshotNamespace(snapshot.apiClient, nsname); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		for _, resource := range snapshot.resources {
+			if err := snapshot.restoreResource(ctx, resource, nsname); err != nil {
+				klog.V(100).Infof("Failed to restore resources: %s in namespace: %s", resource.Resource, nsname)
+
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// restoreResource restores the objects of a single resource in a single namespace. Failures to restore an object do not
+// stop the others from being restored, and all of them are returned joined together.
+func (snapshot *Snapshot) restoreResource(
+	ctx context.Context, resource schema.GroupVersionResource, nsname string) error {
+	client := snapshot.apiClient.Resource(resource).Namespace(nsname)
+
+	objList, err := clients.CollectPages(ctx, metav1.ListOptions{}, client.List)
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs        []error
+		existing    = make(map[string]bool)
+		terminating []string
+	)
+
+	for _, object := range objList.Items {
+		if !isSnapshotManaged(resource, &object) {
+			continue
+		}
+
+		saved, inSnapshot := snapshot.objects[snapshotKey{resource, nsname, object.GetName()}]
+
+		switch {
+		case object.GetDeletionTimestamp() != nil:
+			terminating = append(terminating, object.GetName())
+		case !inSnapshot:
+			klog.V(100).Infof("Deleting %s %s in namespace %s", resource.Resource, object.GetName(), nsname)
+
+			err = client.Delete(ctx, object.GetName(), metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to delete %s %s in namespace %s: %w",
+					resource.Resource, object.GetName(), nsname, err))
+
+				continue
+			}
+
+			terminating = append(terminating, object.GetName())
+		default:
+			existing[object.GetName()] = true
+
+			if snapshotContentEqual(resource, saved, &object) {
+				continue
+			}
+
+			klog.V(100).Infof("Reverting %s %s in namespace %s", resource.Resource, object.GetName(), nsname)
+
+			_, err = client.Update(ctx, revertedObject(resource, saved, &object), metav1.UpdateOptions{})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to revert %s %s in namespace %s: %w",
+					resource.Resource, object.GetName(), nsname, err))
+			}
+		}
+	}
+
+	errs = append(errs, waitForSnapshotDeletions(ctx, snapshot.apiClient, resource, nsname, terminating)...)
+
+	for key, saved := range snapshot.objects {
+		if key.resource != resource || key.namespace != nsname || existing[key.name] {
+			continue
+		}
+
+		klog.V(100).Infof("Recreating %s %s in namespace %s", resource.Resource, key.name, nsname)
+
+		_, err = client.Create(ctx, recreatedObject(resource, saved), metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			errs = append(errs, fmt.Errorf("failed to recreate %s %s in namespace %s: %w",
+				resource.Resource, key.name, nsname, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkSnapshotNamespace returns an error if the namespace name is empty or the namespace does not exist.
+func checkSnapshotNamespace(apiClient *clients.Settings, nsname string) error {
+	if nsname == "" {
+		klog.V(100).Info("The namespace name of the snapshot is empty")
+
+		return fmt.Errorf("namespace snapshot cannot contain empty namespace name")
+	}
+
+	_, err := apiClient.Namespaces().Get(context.TODO(), nsname, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("namespace %s of the snapshot does not exist", nsname)
+	}
+
+	return err
+}
+
+// discoverSnapshotResources returns the namespaced resources, in their preferred versions, which support all of the
+// snapshotRequiredVerbs and are not in snapshotExcludedResources.
+func discoverSnapshotResources(apiClient *clients.Settings) ([]schema.GroupVersionResource, error) {
+	if apiClient.K8sClient == nil {
+		return nil, fmt.Errorf("cannot discover snapshot resources with nil K8sClient")
+	}
+
+	groups, resourceLists, err := apiClient.K8sClient.Discovery().ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		klog.V(100).Infof("Failed to discover resources for snapshot: %v", err)
+
+		return nil, err
+	}
+
+	preferredVersions := make(map[string]string)
+
+	for _, group := range groups {
+		preferredVersions[group.Name] = group.PreferredVersion.Version
+	}
+
+	var resources []schema.GroupVersionResource
+
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		if preferred, ok := preferredVersions[groupVersion.Group]; ok && preferred != groupVersion.Version {
+			continue
+		}
+
+		for _, apiResource := range resourceList.APIResources {
+			resource := groupVersion.WithResource(apiResource.Name)
+
+			if !apiResource.Namespaced || strings.Contains(apiResource.Name, "/") ||
+				slices.Contains(snapshotExcludedResources, resource.GroupResource()) ||
+				!hasAllVerbs(apiResource.Verbs, snapshotRequiredVerbs) {
+				continue
+			}
+
+			resources = append(resources, resource)
+		}
+	}
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("failed to discover any namespaced resources for snapshot")
+	}
+
+	return resources, nil
+}
+
+// waitForSnapshotDeletions waits until none of the named objects exist or the context is done, returning an error for
+// each object which was not removed.
+func waitForSnapshotDeletions(
+	ctx context.Context, apiClient *clients.Settings, resource schema.GroupVersionResource, nsname string,
+	names []string) []error {
+	var errs []error
+
+	for _, name := range names {
+		err := wait.PollUntilContextCancel(ctx, 3*time.Second, true, func(ctx context.Context) (bool, error) {
+			_, err := apiClient.Resource(resource).Namespace(nsname).Get(ctx, name, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				return true, nil
+			}
+
+			return false, err
+		})
+		if err != nil {
+			klog.V(100).Infof("Failed to wait for %s %s in namespace %s to be deleted", resource.Resource, name, nsname)
+
+			errs = append(errs, fmt.Errorf("failed to wait for %s %s in namespace %s to be deleted: %w",
+				resource.Resource, name, nsname, err))
+		}
+	}
+
+	return errs
+}
+
+// hasAllVerbs returns true if verbs contains all of the required verbs.
+func hasAllVerbs(verbs metav1.Verbs, required []string) bool {
+	for _, verb := range required {
+		if !slices.Contains(verbs, verb) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSnapshotManaged returns true if the object is included in snapshots, which is the case unless it has a controller
+// or is one of the objects that controllers create without an owner, as described by snapshotExcludedObjects and
+// serviceAccountSecretAnnotations.
+func isSnapshotManaged(resource schema.GroupVersionResource, object *unstructured.Unstructured) bool {
+	if metav1.GetControllerOfNoCopy(object) != nil {
+		return false
+	}
+
+	if slices.Contains(snapshotExcludedObjects[resource.GroupResource()], object.GetName()) {
+		return false
+	}
+
+	if resource.GroupResource() == secretResource {
+		annotations := object.GetAnnotations()
+
+		for _, annotation := range serviceAccountSecretAnnotations {
+			if _, ok := annotations[annotation]; ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// snapshotContentEqual returns true if the labels, annotations, and top-level fields other than metadata and status
+// of the objects are equal. The binding of PersistentVolumeClaims is ignored.
+func snapshotContentEqual(resource schema.GroupVersionResource, saved, current *unstructured.Unstructured) bool {
+	return reflect.DeepEqual(snapshotContent(resource, saved), snapshotContent(resource, current))
+}
+
+// snapshotContent returns the parts of the object restored by Restore: its labels, annotations, and top-level fields
+// other than metadata and status.
+func snapshotContent(resource schema.GroupVersionResource, object *unstructured.Unstructured) map[string]any {
+	if resource.GroupResource() == pvcResource {
+		object = object.DeepCopy()
+		removePVCBinding(object)
+	}
+
+	content := make(map[string]any)
+
+	for field, value := range object.Object {
+		if !isSnapshotMetaField(field) {
+			content[field] = value
+		}
+	}
+
+	if labels := object.GetLabels(); len(labels) > 0 {
+		content["metadata.labels"] = labels
+	}
+
+	if annotations := object.GetAnnotations(); len(annotations) > 0 {
+		content["metadata.annotations"] = annotations
+	}
+
+	return content
+}
+
+// revertedObject returns a copy of current with the contents restored by Restore replaced by those of saved. The
+// binding of PersistentVolumeClaims is kept from current, since spec.volumeName cannot be changed once set.
+func revertedObject(
+	resource schema.GroupVersionResource, saved, current *unstructured.Unstructured) *unstructured.Unstructured {
+	reverted := current.DeepCopy()
+
+	for field := range current.Object {
+		if !isSnapshotMetaField(field) {
+			delete(reverted.Object, field)
+		}
+	}
+
+	for field, value := range saved.DeepCopy().Object {
+		if !isSnapshotMetaField(field) {
+			reverted.Object[field] = value
+		}
+	}
+
+	reverted.SetAPIVersion(saved.GetAPIVersion())
+	reverted.SetKind(saved.GetKind())
+	reverted.SetLabels(saved.GetLabels())
+	reverted.SetAnnotations(saved.GetAnnotations())
+
+	if resource.GroupResource() == pvcResource {
+		removePVCBinding(reverted)
+		copyPVCBinding(current, reverted)
+	}
+
+	return reverted
+}
+
+// recreatedObject returns a copy of saved without the status and the metadata set by the server, so it can be
+// created again. PersistentVolumeClaims are also unbound so that they can be bound to a new volume.
+func recreatedObject(
+	resource schema.GroupVersionResource, saved *unstructured.Unstructured) *unstructured.Unstructured {
+	recreated := saved.DeepCopy()
+
+	delete(recreated.Object, "status")
+
+	recreated.SetResourceVersion("")
+	recreated.SetUID("")
+	recreated.SetCreationTimestamp(metav1.Time{})
+	recreated.SetGeneration(0)
+	recreated.SetManagedFields(nil)
+	recreated.SetOwnerReferences(nil)
+
+	if resource.GroupResource() == pvcResource {
+		removePVCBinding(recreated)
+	}
+
+	return recreated
+}
+
+// removePVCBinding removes spec.volumeName and the pvcBindingAnnotations from the PersistentVolumeClaim.
+func removePVCBinding(pvc *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(pvc.Object, "spec", "volumeName")
+
+	annotations := pvc.GetAnnotations()
+	if len(annotations) == 0 {
+		return
+	}
+
+	for _, annotation := range pvcBindingAnnotations {
+		delete(annotations, annotation)
+	}
+
+	pvc.SetAnnotations(annotations)
+}
+
+// copyPVCBinding copies spec.volumeName and the pvcBindingAnnotations from one PersistentVolumeClaim to another.
+func copyPVCBinding(from, to *unstructured.Unstructured) {
+	if volumeName, found, _ := unstructured.NestedString(from.Object, "spec", "volumeName"); found {
+		_ = unstructured.SetNestedField(to.Object, volumeName, "spec", "volumeName")
+	}
+
+	fromAnnotations := from.GetAnnotations()
+	toAnnotations := to.GetAnnotations()
+
+	for _, annotation := range pvcBindingAnnotations {
+		value, ok := fromAnnotations[annotation]
+		if !ok {
+			continue
+		}
+
+		if toAnnotations == nil {
+			toAnnotations = make(map[string]string)
+		}
+
+		toAnnotations[annotation] = value
+	}
+
+	to.SetAnnotations(toAnnotations)
+}
+
+// isSnapshotMetaField returns true for the top-level fields which are not compared or restored as a whole.
+func isSnapshotMetaField(field string) bool {
+	return field == "apiVersion" || field == "kind" || field == "metadata" || field == "status"
+}
@@ -0,0 +1,364 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	snapshotTestNamespace      = "snapshot-test-namespace"
+	snapshotTestOtherNamespace = "snapshot-test-other-namespace"
+)
+
+var configMapGVR = corev1.SchemeGroupVersion.WithResource("configmaps")
+
+func TestTakeSnapshot(t *testing.T) {
+	testCases := []struct {
+		nsnames           []string
+		resources         []schema.GroupVersionResource
+		client            bool
+		expectedResources []schema.GroupVersionResource
+		expectedError     string
+	}{
+		{
+			nsnames:           []string{snapshotTestNamespace, snapshotTestOtherNamespace},
+			resources:         []schema.GroupVersionResource{configMapGVR},
+			client:            true,
+			expectedResources: []schema.GroupVersionResource{configMapGVR},
+		},
+		{
+			nsnames:           []string{snapshotTestNamespace},
+			client:            true,
+			expectedResources: []schema.GroupVersionResource{configMapGVR},
+		},
+		{
+			nsnames:       []string{snapshotTestNamespace},
+			resources:     []schema.GroupVersionResource{configMapGVR},
+			client:        false,
+			expectedError: "namespace snapshot 'apiClient' cannot be nil",
+		},
+		{
+			nsnames:       nil,
+			resources:     []schema.GroupVersionResource{configMapGVR},
+			client:        true,
+			expectedError: "failed to take snapshot of empty list of namespaces",
+		},
+		{
+			nsnames:       []string{""},
+			resources:     []schema.GroupVersionResource{configMapGVR},
+			client:        true,
+			expectedError: "namespace snapshot cannot contain empty namespace name",
+		},
+		{
+			nsnames:       []string{"missing-namespace"},
+			resources:     []schema.GroupVersionResource{configMapGVR},
+			client:        true,
+			expectedError: "namespace missing-namespace of the snapshot does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildSnapshotTestClient(t, nil)
+		}
+
+		snapshot, err := TakeSnapshot(testSettings, testCase.nsnames, testCase.resources...)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, snapshot)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.nsnames, snapshot.Namespaces())
+		assert.Equal(t, testCase.expectedResources, snapshot.Resources())
+	}
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	controller := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:            "controlled",
+		Namespace:       snapshotTestNamespace,
+		OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "owner", UID: "uid", Controller: ptr.To(true)}},
+	}}
+
+	testSettings := buildSnapshotTestClient(t, []runtime.Object{
+		buildSnapshotTestConfigMap("unchanged", snapshotTestNamespace, "original"),
+		buildSnapshotTestConfigMap("modified", snapshotTestNamespace, "original"),
+		buildSnapshotTestConfigMap("deleted", snapshotTestNamespace, "original"),
+		buildSnapshotTestConfigMap("deleted", snapshotTestOtherNamespace, "original"),
+	})
+
+	builder, err := Pull(testSettings, snapshotTestNamespace)
+	require.NoError(t, err)
+
+	snapshot, err := TakeSnapshot(testSettings, []string{snapshotTestNamespace, snapshotTestOtherNamespace}, configMapGVR)
+	require.NoError(t, err)
+
+	configMaps := testSettings.ConfigMaps(snapshotTestNamespace)
+
+	modified, err := configMaps.Get(context.TODO(), "modified", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	modified.Data["key"] = "changed"
+	modified.Labels = map[string]string{"changed": "true"}
+	_, err = configMaps.Update(context.TODO(), modified, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, configMaps.Delete(context.TODO(), "deleted", metav1.DeleteOptions{}))
+	require.NoError(t, testSettings.ConfigMaps(snapshotTestOtherNamespace).Delete(
+		context.TODO(), "deleted", metav1.DeleteOptions{}))
+
+	_, err = configMaps.Create(
+		context.TODO(), buildSnapshotTestConfigMap("extra", snapshotTestNamespace, "extra"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = configMaps.Create(context.TODO(), controller, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	err = snapshot.Restore(5 * time.Second)
+	require.NoError(t, err)
+
+	for _, name := range []string{"unchanged", "modified", "deleted"} {
+		configMap, err := configMaps.Get(context.TODO(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"key": "original"}, configMap.Data)
+		assert.Empty(t, configMap.Labels)
+	}
+
+	_, err = testSettings.ConfigMaps(snapshotTestOtherNamespace).Get(context.TODO(), "deleted", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	_, err = configMaps.Get(context.TODO(), "extra", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Objects with a controller are not part of the snapshot and are left alone.
+	_, err = configMaps.Get(context.TODO(), "controlled", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// A snapshot of a single namespace can also be taken from its builder.
+	builderSnapshot, err := builder.Snapshot(configMapGVR)
+	require.NoError(t, err)
+	assert.Equal(t, []string{snapshotTestNamespace}, builderSnapshot.Namespaces())
+}
+
+func TestSnapshotRestorePersistentVolumeClaims(t *testing.T) {
+	pvcGVR := corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims")
+
+	testSettings := buildSnapshotTestClient(t, []runtime.Object{
+		buildSnapshotTestPVC("deleted", "volume-0"),
+		buildSnapshotTestPVC("rebound", "volume-1"),
+	})
+
+	snapshot, err := TakeSnapshot(testSettings, []string{snapshotTestNamespace}, pvcGVR)
+	require.NoError(t, err)
+
+	pvcs := testSettings.PersistentVolumeClaims(snapshotTestNamespace)
+
+	require.NoError(t, pvcs.Delete(context.TODO(), "deleted", metav1.DeleteOptions{}))
+
+	// A claim recreated by an earlier restore is bound to a new volume, which is not a change to be reverted.
+	rebound, err := pvcs.Get(context.TODO(), "rebound", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	rebound.Spec.VolumeName = "volume-2"
+	_, err = pvcs.Update(context.TODO(), rebound, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	err = snapshot.Restore(5 * time.Second)
+	require.NoError(t, err)
+
+	// The volume of the deleted claim is not released for a new claim, so it must be recreated unbound.
+	recreated, err := pvcs.Get(context.TODO(), "deleted", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, recreated.Spec.VolumeName)
+	assert.NotContains(t, recreated.Annotations, "pv.kubernetes.io/bind-completed")
+	assert.Equal(t, "test", recreated.Annotations["example.com/annotation"])
+
+	rebound, err = pvcs.Get(context.TODO(), "rebound", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "volume-2", rebound.Spec.VolumeName)
+}
+
+func TestSnapshotRestoreControllerManagedObjects(t *testing.T) {
+	secretGVR := corev1.SchemeGroupVersion.WithResource("secrets")
+
+	testSettings := buildSnapshotTestClient(t, []runtime.Object{
+		buildSnapshotTestConfigMap("kube-root-ca.crt", snapshotTestNamespace, "original"),
+	})
+
+	snapshot, err := TakeSnapshot(testSettings, []string{snapshotTestNamespace}, configMapGVR, secretGVR)
+	require.NoError(t, err)
+
+	configMaps := testSettings.ConfigMaps(snapshotTestNamespace)
+
+	// The CA bundle is rotated by its controller, which Restore must not revert.
+	rootCA, err := configMaps.Get(context.TODO(), "kube-root-ca.crt", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	rootCA.Data["key"] = "rotated"
+	_, err = configMaps.Update(context.TODO(), rootCA, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// Token secrets are created for ServiceAccounts by a controller, so Restore must not delete them.
+	tokenSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:        "default-token",
+		Namespace:   snapshotTestNamespace,
+		Annotations: map[string]string{"kubernetes.io/service-account.name": "default"},
+	}}
+
+	_, err = testSettings.Secrets(snapshotTestNamespace).Create(context.TODO(), tokenSecret, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	err = snapshot.Restore(5 * time.Second)
+	require.NoError(t, err)
+
+	rootCA, err = configMaps.Get(context.TODO(), "kube-root-ca.crt", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", rootCA.Data["key"])
+
+	_, err = testSettings.Secrets(snapshotTestNamespace).Get(context.TODO(), "default-token", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestSnapshotRestoreContinuesPastFailures(t *testing.T) {
+	testSettings := buildSnapshotTestClient(t, []runtime.Object{
+		buildSnapshotTestConfigMap("immutable", snapshotTestNamespace, "original"),
+		buildSnapshotTestConfigMap("modified", snapshotTestNamespace, "original"),
+	})
+
+	snapshot, err := TakeSnapshot(testSettings, []string{snapshotTestNamespace}, configMapGVR)
+	require.NoError(t, err)
+
+	configMaps := testSettings.ConfigMaps(snapshotTestNamespace)
+
+	for _, name := range []string{"immutable", "modified"} {
+		configMap, err := configMaps.Get(context.TODO(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		configMap.Data["key"] = "changed"
+		_, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	dynamicClient, ok := testSettings.Interface.(*dynamicfake.FakeDynamicClient)
+	require.True(t, ok)
+
+	dynamicClient.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		updateAction, ok := action.(k8stesting.UpdateAction)
+		if !ok {
+			return false, nil, nil
+		}
+
+		object, err := meta.Accessor(updateAction.GetObject())
+		if err != nil || object.GetName() != "immutable" {
+			return false, nil, nil
+		}
+
+		return true, nil, fmt.Errorf("field is immutable")
+	})
+
+	err = snapshot.Restore(5 * time.Second)
+	assert.ErrorContains(t, err, "failed to revert configmaps immutable in namespace snapshot-test-namespace")
+
+	// The failure to revert one object must not stop the others from being reverted.
+	modified, err := configMaps.Get(context.TODO(), "modified", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "original", modified.Data["key"])
+}
+
+func TestSnapshotRestoreErrors(t *testing.T) {
+	var nilSnapshot *Snapshot
+
+	assert.EqualError(t, nilSnapshot.Restore(time.Second), "error: received nil namespace snapshot")
+
+	testSettings := buildSnapshotTestClient(t, nil)
+
+	snapshot, err := TakeSnapshot(testSettings, []string{snapshotTestNamespace}, configMapGVR)
+	require.NoError(t, err)
+
+	require.NoError(t, testSettings.Namespaces().Delete(context.TODO(), snapshotTestNamespace, metav1.DeleteOptions{}))
+
+	err = snapshot.Restore(time.Second)
+	assert.EqualError(t, err, "namespace snapshot-test-namespace of the snapshot does not exist")
+}
+
+// buildSnapshotTestClient returns test clients containing the snapshot test namespaces and objects. Discovery of the
+// clients returns configmaps, events, endpoints, pods, namespaces, leases, and endpointslices, as well as the
+// configmaps/status subresource. Only configmaps are included in snapshots by default.
+func buildSnapshotTestClient(t *testing.T, objects []runtime.Object) *clients.Settings {
+	t.Helper()
+
+	objects = append(objects,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: snapshotTestNamespace}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: snapshotTestOtherNamespace}})
+
+	// The clients must share a tracker, since the snapshot uses the dynamic client and the tests the typed one.
+	testSettings, err := clients.NewTestClientBuilder().WithObjects(objects...).Build()
+	require.NoError(t, err)
+
+	fakeClient, ok := testSettings.K8sClient.(*k8sfake.Clientset)
+	require.True(t, ok)
+
+	verbs := metav1.Verbs{"create", "delete", "get", "list", "update"}
+	fakeClient.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "configmaps", Namespaced: true, Verbs: verbs},
+			{Name: "configmaps/status", Namespaced: true, Verbs: verbs},
+			{Name: "events", Namespaced: true, Verbs: verbs},
+			{Name: "endpoints", Namespaced: true, Verbs: verbs},
+			{Name: "pods", Namespaced: true, Verbs: verbs},
+			{Name: "namespaces", Namespaced: false, Verbs: verbs},
+		},
+	}, {
+		GroupVersion: "coordination.k8s.io/v1",
+		APIResources: []metav1.APIResource{{Name: "leases", Namespaced: true, Verbs: verbs}},
+	}, {
+		GroupVersion: "discovery.k8s.io/v1",
+		APIResources: []metav1.APIResource{{Name: "endpointslices", Namespaced: true, Verbs: verbs}},
+	}}
+
+	return testSettings
+}
+
+// buildSnapshotTestConfigMap returns a ConfigMap with a single key set to value.
+func buildSnapshotTestConfigMap(name, nsname, value string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: nsname},
+		Data:       map[string]string{"key": value},
+	}
+}
+
+// buildSnapshotTestPVC returns a PersistentVolumeClaim bound to the volume.
+func buildSnapshotTestPVC(name, volumeName string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: snapshotTestNamespace,
+			Annotations: map[string]string{
+				"pv.kubernetes.io/bind-completed": "yes",
+				"example.com/annotation":          "test",
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{VolumeName: volumeName},
+	}
+}
@@ -33,7 +33,8 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	klog.V(100).Infof("%v", logMessage)
 
-	networkpolicyList, err := apiClient.NetworkPolicies(nsname).List(logging.DiscardContext(), passedOptions)
+	networkpolicyList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.NetworkPolicies(nsname).List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list networkpolicies in the namespace %s due to %s", nsname, err.Error())
 
@@ -46,7 +46,7 @@ func ListDeviceConfigs(
 		passedOptions.Namespace = nsname
 	}
 
-	err = apiClient.List(logging.DiscardContext(), deviceConfigList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, deviceConfigList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list DeviceConfig objects due to %s", err.Error())
 
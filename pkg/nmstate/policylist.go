@@ -43,7 +43,7 @@ func ListPolicy(apiClient *clients.Settings, options ...goclient.ListOptions) ([
 
 	policyList := &nmstateV1.NodeNetworkConfigurationPolicyList{}
 
-	err = apiClient.List(logging.DiscardContext(), policyList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, policyList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list NodeNetworkConfigurationPolicy due to %s", err.Error())
 
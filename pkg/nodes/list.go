@@ -42,7 +42,8 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 
 	klog.V(100).Infof("%v", logMessage)
 
-	nodeList, err := apiClient.CoreV1Interface.Nodes().List(logging.DiscardContext(), passedOptions)
+	nodeList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.CoreV1Interface.Nodes().List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list nodes due to %s", err.Error())
 
@@ -54,11 +55,14 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 	for _, runningNode := range nodeList.Items {
 		copiedNode := runningNode
 		nodeBuilder := &Builder{
-			apiClient:  apiClient.K8sClient,
-			Object:     &copiedNode,
-			Definition: &copiedNode,
+			apiClient:     apiClient.K8sClient,
+			runtimeClient: apiClient,
+			Object:        &copiedNode,
+			Definition:    &copiedNode,
 		}
 
+		nodeBuilder.AttachMixins()
+
 		nodeObjects = append(nodeObjects, nodeBuilder)
 	}
 
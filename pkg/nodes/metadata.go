@@ -0,0 +1,76 @@
+package nodes
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// These methods satisfy common.Builder for the metadata, applier, and patcher mixins. Unlike the rest of the node
+// Builder, they use the controller-runtime client, since the mixins cannot work through a clientset.
+
+// AttachMixins attaches the mixins to the builder. It is called by the functions which create builders.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableMetadata.SetBase(builder)
+	builder.EmbeddableApplier.SetBase(builder)
+	builder.EmbeddablePatcher.SetBase(builder)
+}
+
+// GetDefinition returns the definition of the node.
+func (builder *Builder) GetDefinition() *corev1.Node {
+	return builder.Definition
+}
+
+// SetDefinition sets the definition of the node.
+func (builder *Builder) SetDefinition(definition *corev1.Node) {
+	builder.Definition = definition
+}
+
+// GetObject returns the node as last pulled from the cluster.
+func (builder *Builder) GetObject() *corev1.Node {
+	return builder.Object
+}
+
+// SetObject sets the node as last pulled from the cluster.
+func (builder *Builder) SetObject(object *corev1.Node) {
+	builder.Object = object
+}
+
+// GetError returns the error stored in the builder, or nil if there is none.
+func (builder *Builder) GetError() error {
+	if builder.errorMsg == "" {
+		return nil
+	}
+
+	return errors.New(builder.errorMsg)
+}
+
+// SetError stores the error in the builder, clearing it if err is nil.
+func (builder *Builder) SetError(err error) {
+	builder.errorMsg = ""
+
+	if err != nil {
+		builder.errorMsg = err.Error()
+	}
+}
+
+// GetClient returns the controller-runtime client used to connect to the cluster.
+func (builder *Builder) GetClient() runtimeclient.Client {
+	return builder.runtimeClient
+}
+
+// SetClient sets the controller-runtime client used to connect to the cluster. The clientset used by the other
+// methods of the builder is unchanged.
+func (builder *Builder) SetClient(apiClient runtimeclient.Client) {
+	builder.runtimeClient = apiClient
+}
+
+// GetGVK returns the GVK of a node.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return corev1.SchemeGroupVersion.WithKind("Node")
+}
+
+// SetGVK does nothing since the GVK of a node is constant.
+func (builder *Builder) SetGVK(schema.GroupVersionKind) {}
@@ -0,0 +1,35 @@
+package nodes
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeMetadata(t *testing.T) {
+	dummyNode := buildDummyNode(defaultNodeName)
+	dummyNode.Labels = map[string]string{defaultNodeLabel: ""}
+	dummyNode.Finalizers = []string{"example.com/finalizer"}
+
+	// StripFinalizers patches using the runtime client, so the clients must share a tracker.
+	testSettings, err := clients.NewTestClientBuilder().WithObjects(dummyNode).Build()
+	assert.Nil(t, err)
+
+	testBuilder, err := Pull(testSettings, defaultNodeName)
+	assert.Nil(t, err)
+
+	// Unlike WithNewLabel, WithLabel overwrites existing labels.
+	testBuilder.WithLabel(defaultNodeLabel, "true").WithAnnotation("example.com/annotation", "value")
+	assert.Nil(t, testBuilder.GetError())
+	assert.Equal(t, "true", testBuilder.Definition.Labels[defaultNodeLabel])
+	assert.Equal(t, "value", testBuilder.Definition.Annotations["example.com/annotation"])
+
+	testBuilder.RemoveLabels(defaultNodeLabel).RemoveFinalizer("example.com/finalizer")
+	assert.Empty(t, testBuilder.Definition.Labels)
+	assert.Empty(t, testBuilder.Definition.Finalizers)
+
+	_, err = testBuilder.StripFinalizers()
+	assert.Nil(t, err)
+	assert.Empty(t, testBuilder.Object.Finalizers)
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
@@ -20,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"k8s.io/kubectl/pkg/drain"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -33,6 +35,17 @@ type Builder struct {
 	apiClient   kubernetes.Interface
 	errorMsg    string
 	drainHelper *drain.Helper
+	// runtimeClient is the controller-runtime client used by the metadata mixin, since apiClient is a clientset.
+	runtimeClient runtimeclient.Client
+
+	// EmbeddableMetadata provides the label, annotation, owner reference, and finalizer modifiers. Unlike the
+	// existing WithNewLabel method, its WithLabel method overwrites labels which are already set.
+	common.EmbeddableMetadata[corev1.Node, Builder, *corev1.Node, *Builder]
+	// EmbeddableApplier provides Apply, which uses server-side apply instead of the Get-then-Update of Update.
+	common.EmbeddableApplier[corev1.Node, Builder, *corev1.Node, *Builder]
+	// EmbeddablePatcher provides Patch and PatchChanges, which only send the fields that changed. Unlike the
+	// Get-then-Update of Update, they do not overwrite the labels and taints controllers set on the node.
+	common.EmbeddablePatcher[corev1.Node, Builder, *corev1.Node, *Builder]
 }
 
 // SetDrainHelper builds drain Helper that contains parameters to control the behaviour of drain.
@@ -138,7 +151,8 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 	}
 
 	builder := Builder{
-		apiClient: apiClient.K8sClient,
+		apiClient:     apiClient.K8sClient,
+		runtimeClient: apiClient,
 		Definition: &corev1.Node{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: nodeName,
@@ -146,6 +160,8 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 		},
 	}
 
+	builder.AttachMixins()
+
 	if nodeName == "" {
 		klog.V(100).Info("The name of the node is empty")
 
@@ -156,7 +172,7 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 		return nil, fmt.Errorf("node object %s does not exist", nodeName)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
 
 	return &builder, nil
 }
@@ -43,7 +43,7 @@ func ListProfiles(apiClient *clients.Settings, options ...goclient.ListOptions)
 
 	var performanceProfiles performanceprofilev2.PerformanceProfileList
 
-	err = apiClient.List(logging.DiscardContext(), &performanceProfiles, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, &performanceProfiles, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list PerformanceProfiles due to %s", err.Error())
 
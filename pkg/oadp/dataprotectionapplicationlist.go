@@ -50,7 +50,7 @@ func ListDataProtectionApplication(
 
 	dataprotectionapplications := new(oadpv1alpha1.DataProtectionApplicationList)
 
-	err := apiClient.List(logging.DiscardContext(), dataprotectionapplications, &passedOptions)
+	err := clients.ListInto(logging.DiscardContext(), apiClient, dataprotectionapplications, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all dataprotectionapplications due to %s", err.Error())
 
@@ -45,7 +45,7 @@ func ListPlacementBindingsInAllNamespaces(apiClient *clients.Settings,
 
 	placementBindingList := new(policiesv1.PlacementBindingList)
 
-	err = apiClient.List(logging.DiscardContext(), placementBindingList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, placementBindingList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all placementBindings in all namespaces due to %s", err.Error())
 
@@ -45,7 +45,7 @@ func ListPlacementrulesInAllNamespaces(apiClient *clients.Settings,
 
 	placementRuleList := new(placementrulev1.PlacementRuleList)
 
-	err = apiClient.List(logging.DiscardContext(), placementRuleList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, placementRuleList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all placementrules in all namespaces due to %s", err.Error())
 
@@ -48,7 +48,7 @@ func ListPoliciesInAllNamespaces(apiClient *clients.Settings,
 
 	policyList := new(policiesv1.PolicyList)
 
-	err = apiClient.List(logging.DiscardContext(), policyList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, policyList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all policies in all namespaces due to %s", err.Error())
 
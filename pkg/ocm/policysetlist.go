@@ -45,7 +45,7 @@ func ListPolicieSetsInAllNamespaces(apiClient *clients.Settings,
 
 	policySetList := new(policiesv1beta1.PolicySetList)
 
-	err = apiClient.List(logging.DiscardContext(), policySetList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, policySetList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all policySets in all namespaces due to %s", err.Error())
 
@@ -55,7 +55,7 @@ func ListCatalogSources(
 
 	catalogSourceList := new(oplmV1alpha1.CatalogSourceList)
 
-	err = apiClient.List(logging.DiscardContext(), catalogSourceList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, catalogSourceList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list catalogsources in the namespace %s due to %s", nsname, err.Error())
 
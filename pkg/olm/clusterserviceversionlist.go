@@ -54,7 +54,7 @@ func ListClusterServiceVersion(
 
 	csvList := new(oplmV1alpha1.ClusterServiceVersionList)
 
-	err = apiClient.List(logging.DiscardContext(), csvList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, csvList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list clusterserviceversion in the nsname %s due to %s", nsname, err.Error())
 
@@ -155,7 +155,7 @@ func ListClusterServiceVersionInAllNamespaces(
 
 	csvList := new(oplmV1alpha1.ClusterServiceVersionList)
 
-	err = apiClient.List(logging.DiscardContext(), csvList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, csvList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list CSVs in all namespaces due to %s", err.Error())
 
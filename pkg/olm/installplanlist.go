@@ -51,7 +51,7 @@ func ListInstallPlan(
 
 	installPlanList := new(oplmV1alpha1.InstallPlanList)
 
-	err = apiClient.List(logging.DiscardContext(), installPlanList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, installPlanList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list all installplan in namespace %s due to %s",
 			nsname, err.Error())
@@ -53,7 +53,7 @@ func ListPackageManifest(
 
 	pkgManifestList := new(operatorv1.PackageManifestList)
 
-	err = apiClient.List(logging.DiscardContext(), pkgManifestList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, pkgManifestList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
 			nsname, err.Error())
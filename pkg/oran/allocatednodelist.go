@@ -44,7 +44,7 @@ func ListAllocatedNodes(
 
 	nodeList := new(pluginsv1alpha1.AllocatedNodeList)
 
-	err = apiClient.List(logging.DiscardContext(), nodeList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, nodeList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list AllocatedNodes in all namespaces due to %v", err)
 
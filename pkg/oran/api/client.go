@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/oran/api/internal/alarms"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/oran/api/internal/artifacts"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/oran/api/internal/provisioning"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ClientType is a string that represents the type of client being used. It is used to identify the client type in error
@@ -31,10 +33,11 @@ const (
 // Error messages are stored in the builder and only returned when the builder is used to create a client. If an error
 // message is present, all methods on the builder become no-ops.
 type ClientBuilder struct {
-	baseURL string
-	client  *http.Client
-	token   string
-	err     error
+	baseURL        string
+	client         *http.Client
+	token          string
+	tracerProvider trace.TracerProvider
+	err            error
 }
 
 // NewClientBuilder creates a new ClientBuilder with the given base URL. It should include the scheme and will likely be
@@ -101,6 +104,25 @@ func (builder *ClientBuilder) WithToken(token string) *ClientBuilder {
 	return builder
 }
 
+// WithTracerProvider sets the TracerProvider used to create a span around each request sent by the clients created by
+// this builder. The HTTP client set using WithHTTPClient is not modified. It does not affect any clients that have
+// already been created.
+func (builder *ClientBuilder) WithTracerProvider(provider trace.TracerProvider) *ClientBuilder {
+	if builder.validate() != nil {
+		return builder
+	}
+
+	if provider == nil {
+		builder.err = fmt.Errorf("error: tracerProvider cannot be nil")
+
+		return builder
+	}
+
+	builder.tracerProvider = provider
+
+	return builder
+}
+
 // BuildProvisioning creates a new ProvisioningClient using the configuration set on this builder. If the builder has an
 // error message, it will be returned here. This method does not modify the builder so the builder can be reused.
 func (builder *ClientBuilder) BuildProvisioning() (*ProvisioningClient, error) {
@@ -110,8 +132,8 @@ func (builder *ClientBuilder) BuildProvisioning() (*ProvisioningClient, error) {
 
 	var opts []provisioning.ClientOption
 
-	if builder.client != nil {
-		opts = append(opts, provisioning.WithHTTPClient(builder.client))
+	if client := builder.httpClient(); client != nil {
+		opts = append(opts, provisioning.WithHTTPClient(client))
 	}
 
 	if builder.token != "" {
@@ -141,8 +163,8 @@ func (builder *ClientBuilder) BuildArtifacts() (*ArtifactsClient, error) {
 
 	var opts []artifacts.ClientOption
 
-	if builder.client != nil {
-		opts = append(opts, artifacts.WithHTTPClient(builder.client))
+	if client := builder.httpClient(); client != nil {
+		opts = append(opts, artifacts.WithHTTPClient(client))
 	}
 
 	if builder.token != "" {
@@ -172,8 +194,8 @@ func (builder *ClientBuilder) BuildAlarms() (*AlarmsClient, error) {
 
 	var opts []alarms.ClientOption
 
-	if builder.client != nil {
-		opts = append(opts, alarms.WithHTTPClient(builder.client))
+	if client := builder.httpClient(); client != nil {
+		opts = append(opts, alarms.WithHTTPClient(client))
 	}
 
 	if builder.token != "" {
@@ -192,6 +214,25 @@ func (builder *ClientBuilder) BuildAlarms() (*AlarmsClient, error) {
 	return &AlarmsClient{client}, nil
 }
 
+// httpClient returns the HTTP client to be used by the clients created by this builder, or nil if the generated clients
+// should use their default. If a TracerProvider has been set, the returned client is a copy whose transport traces each
+// request.
+func (builder *ClientBuilder) httpClient() *http.Client {
+	if builder.tracerProvider == nil {
+		return builder.client
+	}
+
+	client := &http.Client{}
+	if builder.client != nil {
+		clientCopy := *builder.client
+		client = &clientCopy
+	}
+
+	client.Transport = tracing.NewTransport(client.Transport, builder.tracerProvider, "o2ims")
+
+	return client
+}
+
 // validate checks if the builder is valid and returns an error if not. A valid builder is defined as being non-nil,
 // having a nil error, and having a non-empty base URL.
 func (builder *ClientBuilder) validate() error {
@@ -44,7 +44,7 @@ func ListClusterTemplates(
 
 	clusterTemplateList := new(provisioningv1alpha1.ClusterTemplateList)
 
-	err = apiClient.List(logging.DiscardContext(), clusterTemplateList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, clusterTemplateList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list ClusterTemplates in all namespaces due to %v", err)
 
@@ -44,7 +44,7 @@ func ListNodeAllocationRequests(
 
 	nodeAllocationRequestList := new(pluginsv1alpha1.NodeAllocationRequestList)
 
-	err = apiClient.List(logging.DiscardContext(), nodeAllocationRequestList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, nodeAllocationRequestList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list NodeAllocationRequests in all namespaces due to %v", err)
 
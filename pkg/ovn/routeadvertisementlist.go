@@ -30,7 +30,7 @@ func ListRouteAdvertisements(apiClient *clients.Settings,
 
 	routeAdvertisementList := &ovnv1.RouteAdvertisementsList{}
 
-	err = apiClient.List(logging.DiscardContext(), routeAdvertisementList, options...)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, routeAdvertisementList, options...)
 	if err != nil {
 		klog.V(100).Infof("Failed to list RouteAdvertisements due to %s", err.Error())
 
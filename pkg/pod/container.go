@@ -7,8 +7,9 @@ import (
 
 	"slices"
 
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/klog/v2"
 )
 
 var (
@@ -42,8 +43,9 @@ type ContainerBuilder struct {
 
 // NewContainerBuilder creates a new instance of ContainerBuilder.
 func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
-	klog.V(100).Infof("Initializing new container structure with the following params: "+
-		"name: %s, image: %s, cmd: %v", name, image, cmd)
+	logger := logging.FromClient(nil).WithValues("container", name)
+
+	logger.V(logging.LevelDebug).Info("Initializing new container structure", "image", image, "command", cmd)
 
 	builder := &ContainerBuilder{
 		definition: &corev1.Container{
@@ -55,7 +57,7 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 	}
 
 	if name == "" {
-		klog.V(100).Info("The name of the container is empty")
+		logger.V(logging.LevelDebug).Info("The name of the container is empty")
 
 		builder.errorMsg = "container's name is empty"
 
@@ -63,7 +65,7 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 	}
 
 	if image == "" {
-		klog.V(100).Info("Container's image is empty")
+		logger.V(logging.LevelDebug).Info("Container's image is empty")
 
 		builder.errorMsg = "container's image is empty"
 
@@ -71,7 +73,7 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 	}
 
 	if len(cmd) < 1 {
-		klog.V(100).Info("Container's cmd is empty")
+		logger.V(logging.LevelDebug).Info("Container's cmd is empty")
 
 		builder.errorMsg = "container's cmd is empty"
 
@@ -83,12 +85,14 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 
 // WithSecurityCapabilities applies SecurityCapabilities to the container definition.
 func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string, redefine bool) *ContainerBuilder {
-	klog.V(100).Infof("Applying a list of SecurityCapabilities %v to container %s",
-		sCapabilities, builder.definition.Name)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info(
+		"Applying a list of SecurityCapabilities to container", "capabilities", sCapabilities)
 
 	if builder.definition.SecurityContext != nil {
 		if !redefine {
-			klog.V(100).Info("Cannot modify pre-existing SecurityContext")
+			logger.V(logging.LevelDebug).Info("Cannot modify pre-existing SecurityContext")
 
 			builder.errorMsg = "can not modify pre-existing security context"
 
@@ -99,8 +103,8 @@ func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string
 	}
 
 	if !areCapabilitiesValid(sCapabilities) {
-		klog.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
-			sCapabilities, AllowedSCList)
+		logger.V(logging.LevelDebug).Info(
+			"Given SecurityCapabilities are not valid", "capabilities", sCapabilities, "allowed", AllowedSCList)
 
 		builder.errorMsg = "one of the give securityCapabilities is invalid. Please extend allowed list or fix parameter"
 
@@ -123,12 +127,14 @@ func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string
 
 // WithDropSecurityCapabilities drops SecurityCapabilities from the container definition.
 func (builder *ContainerBuilder) WithDropSecurityCapabilities(sCapabilities []string, redefine bool) *ContainerBuilder {
-	klog.V(100).Infof("Dropping a list of SecurityCapabilities %v from container %s",
-		sCapabilities, builder.definition.Name)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info(
+		"Dropping a list of SecurityCapabilities from container", "capabilities", sCapabilities)
 
 	if !areCapabilitiesValid(sCapabilities) {
-		klog.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
-			sCapabilities, AllowedSCList)
+		logger.V(logging.LevelDebug).Info(
+			"Given SecurityCapabilities are not valid", "capabilities", sCapabilities, "allowed", AllowedSCList)
 
 		builder.errorMsg = "one of the provided securityCapabilities is invalid. " +
 			"Please extend the allowed list or fix parameter"
@@ -143,23 +149,24 @@ func (builder *ContainerBuilder) WithDropSecurityCapabilities(sCapabilities []st
 
 	// filter possible duplicated capabilities from user's input
 	sCapabilitiesList = uniqueCapabilities(sCapabilitiesList)
-	klog.V(100).Infof("Filtered user input: %v", sCapabilitiesList)
+	logger.V(logging.LevelDebug).Info("Filtered user input", "capabilities", sCapabilitiesList)
 
 	// filter conflicting capabilities between ADD and DROP
 	if builder.definition.SecurityContext != nil &&
 		builder.definition.SecurityContext.Capabilities != nil &&
 		builder.definition.SecurityContext.Capabilities.Add != nil {
-		klog.V(100).Info("Filtering conflicting options between ADD and DROP capabilities")
+		logger.V(logging.LevelDebug).Info("Filtering conflicting options between ADD and DROP capabilities")
 
 		confCapabilitiesList := capabilitiesIntersection(
 			builder.definition.SecurityContext.Capabilities.Add,
 			sCapabilitiesList)
 
 		if len(confCapabilitiesList) > 0 {
-			klog.V(100).Info("Conflicting ADD and DROP capabilities")
+			logger.V(logging.LevelDebug).Info("Conflicting ADD and DROP capabilities")
 
 			for _, mcap := range confCapabilitiesList {
-				klog.V(100).Infof("SecurityCapability %q already present in the Capabilities.Add list", mcap)
+				logger.V(logging.LevelDebug).Info(
+					"SecurityCapability already present in the Capabilities.Add list", "capability", mcap)
 			}
 
 			builder.errorMsg = "Conflicting ADD and DROP SecurityCapabilities"
@@ -169,28 +176,30 @@ func (builder *ContainerBuilder) WithDropSecurityCapabilities(sCapabilities []st
 	}
 
 	if builder.definition.SecurityContext == nil {
-		klog.V(100).Info("SecurityContext is nil. Initializing one")
+		logger.V(logging.LevelDebug).Info("SecurityContext is nil. Initializing one")
 
 		builder.definition.SecurityContext = new(corev1.SecurityContext)
 	}
 
 	if builder.definition.SecurityContext.Capabilities == nil {
-		klog.V(100).Info("Capabilities are nil. Initializing one")
+		logger.V(logging.LevelDebug).Info("Capabilities are nil. Initializing one")
 
 		builder.definition.SecurityContext.Capabilities = new(corev1.Capabilities)
 	}
 
 	if !redefine {
-		klog.V(100).Info("SecurityContext.Capabilities will not be redefined")
-		klog.V(100).Infof("Filtering duplicated DROP capabilities - %v", sCapabilitiesList)
+		logger.V(logging.LevelDebug).Info("SecurityContext.Capabilities will not be redefined")
+		logger.V(logging.LevelDebug).Info("Filtering duplicated DROP capabilities", "capabilities", sCapabilitiesList)
 		sCapabilitiesList = capabilitiesDifference(builder.definition.SecurityContext.Capabilities.Drop,
 			sCapabilitiesList)
 
-		klog.V(100).Infof("Updating existing SecurityContext.Capabilities.Drop list with %v", sCapabilitiesList)
+		logger.V(logging.LevelDebug).Info(
+			"Updating existing SecurityContext.Capabilities.Drop list", "capabilities", sCapabilitiesList)
 		builder.definition.SecurityContext.Capabilities.Drop = append(builder.definition.SecurityContext.Capabilities.Drop,
 			sCapabilitiesList...)
 	} else {
-		klog.V(100).Infof("Redefining existing SecurityContext.Capabilities.Drop list with %v", sCapabilitiesList)
+		logger.V(logging.LevelDebug).Info(
+			"Redefining existing SecurityContext.Capabilities.Drop list", "capabilities", sCapabilitiesList)
 		builder.definition.SecurityContext.Capabilities.Drop = sCapabilitiesList
 	}
 
@@ -199,10 +208,12 @@ func (builder *ContainerBuilder) WithDropSecurityCapabilities(sCapabilities []st
 
 // WithSecurityContext applies security Context on container.
 func (builder *ContainerBuilder) WithSecurityContext(securityContext *corev1.SecurityContext) *ContainerBuilder {
-	klog.V(100).Infof("Applying custom securityContext %v", securityContext)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Applying custom securityContext", "securityContext", securityContext)
 
 	if securityContext == nil {
-		klog.V(100).Info("Cannot add empty securityContext to container structure")
+		logger.V(logging.LevelDebug).Info("Cannot add empty securityContext to container structure")
 
 		builder.errorMsg = "can not modify container config with empty securityContext"
 
@@ -216,11 +227,13 @@ func (builder *ContainerBuilder) WithSecurityContext(securityContext *corev1.Sec
 
 // WithResourceLimit applies resource limit on container.
 func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu int64) *ContainerBuilder {
-	klog.V(100).Infof("Applying custom resource limit to container: hugePages: %s memory: %s cpu: %d",
-		hugePages, memory, cpu)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info(
+		"Applying custom resource limit to container", "hugePages", hugePages, "memory", memory, "cpu", cpu)
 
 	if hugePages == "" {
-		klog.V(100).Info("Container's resource limit hugePages is empty")
+		logger.V(logging.LevelDebug).Info("Container's resource limit hugePages is empty")
 
 		builder.errorMsg = "container's resource limit 'hugePages' is empty"
 
@@ -228,7 +241,7 @@ func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu
 	}
 
 	if memory == "" {
-		klog.V(100).Info("Container's resource limit memory is empty")
+		logger.V(logging.LevelDebug).Info("Container's resource limit memory is empty")
 
 		builder.errorMsg = "container's resource limit 'memory' is empty"
 
@@ -236,7 +249,7 @@ func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu
 	}
 
 	if cpu <= 0 {
-		klog.V(100).Info("Container's resource limit cpu can not be zero or negative number.")
+		logger.V(logging.LevelDebug).Info("Container's resource limit cpu can not be zero or negative number.")
 
 		builder.errorMsg = "container's resource limit 'cpu' is invalid"
 
@@ -254,11 +267,13 @@ func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu
 
 // WithResourceRequest applies resource request on container.
 func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, cpu int64) *ContainerBuilder {
-	klog.V(100).Infof("Applying custom resource request to container: hugePages: %s memory: %s cpu: %d",
-		hugePages, memory, cpu)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info(
+		"Applying custom resource request to container", "hugePages", hugePages, "memory", memory, "cpu", cpu)
 
 	if hugePages == "" {
-		klog.V(100).Info("Container's resource request hugePages is empty")
+		logger.V(logging.LevelDebug).Info("Container's resource request hugePages is empty")
 
 		builder.errorMsg = "container's resource request 'hugePages' is empty"
 
@@ -266,7 +281,7 @@ func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, c
 	}
 
 	if memory == "" {
-		klog.V(100).Info("Container's resource request memory is empty")
+		logger.V(logging.LevelDebug).Info("Container's resource request memory is empty")
 
 		builder.errorMsg = "container's resource request 'memory' is empty"
 
@@ -274,7 +289,7 @@ func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, c
 	}
 
 	if cpu <= 0 {
-		klog.V(100).Info("Container's resource request cpu can not be zero or negative number.")
+		logger.V(logging.LevelDebug).Info("Container's resource request cpu can not be zero or negative number.")
 
 		builder.errorMsg = "container's resource request 'cpu' is invalid"
 
@@ -292,10 +307,12 @@ func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, c
 
 // WithCustomResourcesRequests applies custom resource requests struct on container.
 func (builder *ContainerBuilder) WithCustomResourcesRequests(resourceList corev1.ResourceList) *ContainerBuilder {
-	klog.V(100).Infof("Applying custom resource requests to container: %v", resourceList)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Applying custom resource requests to container", "resources", resourceList)
 
 	if len(resourceList) == 0 {
-		klog.V(100).Info("Container's resource limit var 'resourceList' is empty")
+		logger.V(logging.LevelDebug).Info("Container's resource limit var 'resourceList' is empty")
 
 		builder.errorMsg = "container's resource requests var 'resourceList' is empty"
 
@@ -309,10 +326,12 @@ func (builder *ContainerBuilder) WithCustomResourcesRequests(resourceList corev1
 
 // WithCustomResourcesLimits applies custom resource limit struct on container.
 func (builder *ContainerBuilder) WithCustomResourcesLimits(resourceList corev1.ResourceList) *ContainerBuilder {
-	klog.V(100).Infof("Applying custom resource limit to container: %v", resourceList)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Applying custom resource limit to container", "resources", resourceList)
 
 	if len(resourceList) == 0 {
-		klog.V(100).Info("Container's resource limit var 'resourceList' is empty")
+		logger.V(logging.LevelDebug).Info("Container's resource limit var 'resourceList' is empty")
 
 		builder.errorMsg = "container's resource limit var 'resourceList' is empty"
 
@@ -326,10 +345,12 @@ func (builder *ContainerBuilder) WithCustomResourcesLimits(resourceList corev1.R
 
 // WithImagePullPolicy applies specific image pull policy on container.
 func (builder *ContainerBuilder) WithImagePullPolicy(pullPolicy corev1.PullPolicy) *ContainerBuilder {
-	klog.V(100).Infof("Applying image pull policy to container: %s", pullPolicy)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Applying image pull policy to container", "pullPolicy", pullPolicy)
 
 	if len(pullPolicy) == 0 {
-		klog.V(100).Info("Container's image pull policy 'pullPolicy' is empty")
+		logger.V(logging.LevelDebug).Info("Container's image pull policy 'pullPolicy' is empty")
 
 		builder.errorMsg = "container's pull policy var 'pullPolicy' is empty"
 
@@ -343,10 +364,13 @@ func (builder *ContainerBuilder) WithImagePullPolicy(pullPolicy corev1.PullPolic
 
 // WithEnvVar adds environment variables to container.
 func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilder {
-	klog.V(100).Infof("Applying custom environment variables to container: name %s, value: %s", name, value)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info(
+		"Applying custom environment variables to container", "envName", name, "envValue", value)
 
 	if name == "" {
-		klog.V(100).Info("Container's environment var 'name' is empty")
+		logger.V(logging.LevelDebug).Info("Container's environment var 'name' is empty")
 
 		builder.errorMsg = "container's environment var 'name' is empty"
 
@@ -354,7 +378,7 @@ func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilde
 	}
 
 	if value == "" {
-		klog.V(100).Info("Container's environment var 'value' is empty")
+		logger.V(logging.LevelDebug).Info("Container's environment var 'value' is empty")
 
 		builder.errorMsg = "container's environment var 'value' is empty"
 
@@ -374,10 +398,12 @@ func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilde
 
 // WithVolumeMount adds a pod volume mount inside the container.
 func (builder *ContainerBuilder) WithVolumeMount(volMount corev1.VolumeMount) *ContainerBuilder {
-	klog.V(100).Infof("Adding VolumeMount to the %s container's definition", builder.definition.Name)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Adding VolumeMount to the container's definition")
 
 	if volMount.Name == "" {
-		klog.V(100).Info("Container's VolumeMount name cannot be empty")
+		logger.V(logging.LevelDebug).Info("Container's VolumeMount name cannot be empty")
 
 		builder.errorMsg = "container's volume mount name is empty"
 
@@ -385,14 +411,15 @@ func (builder *ContainerBuilder) WithVolumeMount(volMount corev1.VolumeMount) *C
 	}
 
 	if volMount.MountPath == "" {
-		klog.V(100).Info("Container's VolumeMount mount path cannot be empty")
+		logger.V(logging.LevelDebug).Info("Container's VolumeMount mount path cannot be empty")
 
 		builder.errorMsg = "container's volume mount path is empty"
 
 		return builder
 	}
 
-	klog.V(100).Infof("VolumeMount %s will be mounted at %s", volMount.Name, volMount.MountPath)
+	logger.V(logging.LevelDebug).Info(
+		"VolumeMount will be mounted", "volume", volMount.Name, "mountPath", volMount.MountPath)
 	builder.definition.VolumeMounts = append(builder.definition.VolumeMounts, volMount)
 
 	return builder
@@ -400,10 +427,12 @@ func (builder *ContainerBuilder) WithVolumeMount(volMount corev1.VolumeMount) *C
 
 // WithPorts adds a list of ports to expose from the container.
 func (builder *ContainerBuilder) WithPorts(ports []corev1.ContainerPort) *ContainerBuilder {
-	klog.V(100).Infof("Configuring continer port %v", ports)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Configuring container ports", "ports", ports)
 
 	if len(ports) == 0 {
-		klog.V(100).Info("Ports can not be empty")
+		logger.V(logging.LevelDebug).Info("Ports can not be empty")
 
 		builder.errorMsg = "can not modify container config without any port"
 
@@ -417,10 +446,12 @@ func (builder *ContainerBuilder) WithPorts(ports []corev1.ContainerPort) *Contai
 
 // WithReadinessProbe adds a readinessProbe to the container.
 func (builder *ContainerBuilder) WithReadinessProbe(readinessProbe *corev1.Probe) *ContainerBuilder {
-	klog.V(100).Infof("Adding readinessProbe to the %s container's definition", builder.definition.Name)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Adding readinessProbe to the container's definition")
 
 	if readinessProbe == nil {
-		klog.V(100).Info("Container's readinessProbe name cannot be empty")
+		logger.V(logging.LevelDebug).Info("Container's readinessProbe name cannot be empty")
 
 		builder.errorMsg = "container's readinessProbe is empty"
 
@@ -434,7 +465,7 @@ func (builder *ContainerBuilder) WithReadinessProbe(readinessProbe *corev1.Probe
 
 // WithTTY applies TTY value on container.
 func (builder *ContainerBuilder) WithTTY(enableTTY bool) *ContainerBuilder {
-	klog.V(100).Infof("Applying TTY value to container: %v", enableTTY)
+	builder.getLogger().V(logging.LevelDebug).Info("Applying TTY value to container", "tty", enableTTY)
 
 	builder.definition.TTY = enableTTY
 
@@ -443,7 +474,8 @@ func (builder *ContainerBuilder) WithTTY(enableTTY bool) *ContainerBuilder {
 
 // WithStdin applies Stdin value on container.
 func (builder *ContainerBuilder) WithStdin(enableStdin bool) *ContainerBuilder {
-	klog.V(100).Infof("Applying TTY value to container: %v", enableStdin)
+	builder.getLogger().V(logging.LevelDebug).Info(
+		"Applying stdin value to container", "stdin", enableStdin)
 
 	builder.definition.Stdin = enableStdin
 
@@ -452,10 +484,12 @@ func (builder *ContainerBuilder) WithStdin(enableStdin bool) *ContainerBuilder {
 
 // GetContainerCfg returns Container struct.
 func (builder *ContainerBuilder) GetContainerCfg() (*corev1.Container, error) {
-	klog.V(100).Infof("Returning configuration for container %s", builder.definition.Name)
+	logger := builder.getLogger()
+
+	logger.V(logging.LevelDebug).Info("Returning configuration for container")
 
 	if builder.errorMsg != "" {
-		klog.V(100).Infof("Failed to build container configuration due to %s", builder.errorMsg)
+		logger.V(logging.LevelDebug).Info("Failed to build container configuration", "errorMsg", builder.errorMsg)
 
 		return nil, fmt.Errorf("%s", builder.errorMsg)
 	}
@@ -536,3 +570,9 @@ func capabilitiesDifference(aCaps, bCaps []corev1.Capability) []corev1.Capabilit
 
 	return resultCaps
 }
+
+// getLogger returns the logger for the container builder, with the name of the container as a key/value. Since the
+// container builder has no client, logs are sent to klog.
+func (builder *ContainerBuilder) getLogger() logr.Logger {
+	return logging.FromClient(nil).WithValues("container", builder.definition.Name)
+}
@@ -3,6 +3,7 @@ package pod
 import (
 	"context"
 	"fmt"
+	"iter"
 	"strings"
 	"time"
 
@@ -11,142 +12,141 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // List returns pod inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "namespace", nsname)
+
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty")
 
 		return nil, fmt.Errorf("podList 'apiClient' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("pod 'nsname' parameter can not be empty")
+		logger.V(logging.LevelDebug).Info("pod 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
 
-	logMessage := fmt.Sprintf("Listing pods in the nsname %s", nsname)
 	passedOptions := metav1.ListOptions{}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	} else if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
-	klog.V(100).Infof("%v", logMessage)
+	logger.V(logging.LevelDebug).Info("Listing pods in the namespace", "options", passedOptions)
 
-	podList, err := apiClient.Pods(nsname).List(logging.DiscardContext(), passedOptions)
+	podObjects, err := collectPods(listPodPages(apiClient, nsname, passedOptions, 0))
 	if err != nil {
-		klog.V(100).Infof("Failed to list pods in the nsname %s due to %s", nsname, err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list pods in the namespace", "error", err)
 
 		return nil, err
 	}
 
-	var podObjects []*Builder
-
-	for _, runningPod := range podList.Items {
-		copiedPod := runningPod
-		podBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedPod,
-			Definition: &copiedPod,
-		}
-
-		podObjects = append(podObjects, podBuilder)
-	}
-
 	return podObjects, nil
 }
 
 // ListInAllNamespaces returns a cluster-wide pod inventory.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
-	logMessage := "Listing all pods in all namespaces"
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
 	passedOptions := metav1.ListOptions{}
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty")
 
 		return nil, fmt.Errorf("podList 'apiClient' cannot be empty")
 	}
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Infof("%v", logMessage)
+	logger.V(logging.LevelDebug).Info("Listing all pods in all namespaces", "options", passedOptions)
 
-	podList, err := apiClient.Pods("").List(logging.DiscardContext(), passedOptions)
+	podObjects, err := collectPods(listPodPages(apiClient, "", passedOptions, 0))
 	if err != nil {
-		klog.V(100).Infof("Failed to list all pods due to %s", err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list all pods", "error", err)
 
 		return nil, err
 	}
 
-	var podObjects []*Builder
+	return podObjects, nil
+}
+
+// ListSeq returns an iterator over the pods matching the options, in all namespaces unless the options include
+// runtimeclient.InNamespace. Typed selectors, such as clients.MatchingNodeName, may be used in the options. Pods are
+// requested a page at a time, as described in clients.ListPages, so large numbers of pods can be processed without
+// holding them all in memory. The page size may be set using clients.WithPageSize, while a Limit caps the total number
+// of pods. If an error occurs, it is yielded with a nil builder and iteration stops.
+func ListSeq(apiClient *clients.Settings, options ...runtimeclient.ListOption) iter.Seq2[*Builder, error] {
+	return func(yield func(*Builder, error) bool) {
+		logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
 
-	for _, runningPod := range podList.Items {
-		copiedPod := runningPod
-		podBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedPod,
-			Definition: &copiedPod,
+		if apiClient == nil {
+			logger.V(logging.LevelDebug).Info("The apiClient is empty")
+
+			yield(nil, fmt.Errorf("podList 'apiClient' cannot be empty"))
+
+			return
 		}
 
-		podObjects = append(podObjects, podBuilder)
-	}
+		listOptions := (&runtimeclient.ListOptions{}).ApplyOptions(options)
 
-	return podObjects, nil
+		logger.V(logging.LevelDebug).Info("Iterating over pods",
+			"namespace", listOptions.Namespace, "options", *listOptions.AsListOptions())
+
+		pages := listPodPages(apiClient, listOptions.Namespace, *listOptions.AsListOptions(), clients.PageSizeFrom(options...))
+
+		for podBuilder, err := range pages {
+			if !yield(podBuilder, err) || err != nil {
+				return
+			}
+		}
+	}
 }
 
 // ListByNamePattern returns pod inventory in the given namespace filtered by name pattern.
 func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string) ([]*Builder, error) {
-	klog.V(100).Infof("Listing pods in the nsname %s filtered by the name pattern %s", nsname, namePattern)
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info("Listing pods filtered by the name pattern", "namePattern", namePattern)
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty")
 
 		return nil, fmt.Errorf("podList 'apiClient' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("pod 'nsname' parameter can not be empty")
+		logger.V(logging.LevelDebug).Info("pod 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
 
-	podList, err := apiClient.Pods(nsname).List(logging.DiscardContext(), metav1.ListOptions{})
-	if err != nil {
-		klog.V(100).Infof("Failed to list pods filtered by the name pattern %s in the nsname %s due to %s",
-			namePattern, nsname, err.Error())
-
-		return nil, err
-	}
-
 	var podObjects []*Builder
 
-	for _, runningPod := range podList.Items {
-		if strings.Contains(runningPod.Name, namePattern) {
-			copiedPod := runningPod
-			podBuilder := &Builder{
-				apiClient:  apiClient,
-				Object:     &copiedPod,
-				Definition: &copiedPod,
-			}
+	for podBuilder, err := range listPodPages(apiClient, nsname, metav1.ListOptions{}, 0) {
+		if err != nil {
+			logger.V(logging.LevelDebug).Info(
+				"Failed to list pods filtered by the name pattern", "namePattern", namePattern, "error", err)
 
+			return nil, err
+		}
+
+		if strings.Contains(podBuilder.Object.Name, namePattern) {
 			podObjects = append(podObjects, podBuilder)
 		}
 	}
@@ -160,37 +160,38 @@ func WaitForAllPodsInNamespaceRunning(
 	nsname string,
 	timeout time.Duration,
 	options ...metav1.ListOptions) (bool, error) {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "namespace", nsname)
+
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty")
 
 		return false, fmt.Errorf("podList 'apiClient' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("'nsname' parameter can not be empty")
+		logger.V(logging.LevelDebug).Info("'nsname' parameter can not be empty")
 
 		return false, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
 
-	logMessage := fmt.Sprintf("Waiting for all pods in %s namespace", nsname)
 	passedOptions := metav1.ListOptions{}
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be empty or single-valued")
 
 		return false, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Infof("%s are in running state", logMessage)
+	logger.V(logging.LevelDebug).Info(
+		"Waiting for all pods in the namespace to be in running state", "options", passedOptions, "timeout", timeout)
 
 	podList, err := List(apiClient, nsname, passedOptions)
 	if err != nil {
-		klog.V(100).Infof("Failed to list all pods due to %s", err.Error())
+		logger.V(logging.LevelDebug).Info("Failed to list all pods", "error", err)
 
 		return false, err
 	}
@@ -198,7 +199,8 @@ func WaitForAllPodsInNamespaceRunning(
 	for _, podObj := range podList {
 		err = podObj.WaitUntilRunning(timeout)
 		if err != nil {
-			klog.V(100).Infof("Timout was reached while waiting for all pods in running state: %s", err.Error())
+			logger.V(logging.LevelDebug).Info(
+				"Timeout was reached while waiting for all pods in running state", "error", err)
 
 			return false, err
 		}
@@ -211,27 +213,27 @@ func WaitForAllPodsInNamespaceRunning(
 // RestartPolicy of Never are ignored. It works by listing pods every 15 seconds until every listed pod is healthy.
 func WaitForPodsInNamespacesHealthy(
 	apiClient *clients.Settings, namespaces []string, timeout time.Duration, options ...metav1.ListOptions) error {
-	logMessage := fmt.Sprintf("Waiting for all pods in namespaces %v to be healthy", namespaces)
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
 	passedOptions := metav1.ListOptions{}
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is nil")
+		logger.V(logging.LevelDebug).Info("The apiClient is nil")
 
 		return fmt.Errorf("podList 'apiClient' cannot be empty")
 	}
 
 	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelDebug).Info("'options' parameter must be empty or single-valued")
 
 		return fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
 	if len(options) == 1 {
 		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	klog.V(100).Info(logMessage)
+	logger.V(logging.LevelDebug).Info("Waiting for all pods in the namespaces to be healthy",
+		"namespaces", namespaces, "options", passedOptions, "timeout", timeout)
 
 	return wait.PollUntilContextTimeout(
 		context.TODO(), 15*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
@@ -269,7 +271,8 @@ func listPodsInNamespaces(
 	for _, namespace := range namespaces {
 		namespacePods, err := List(apiClient, namespace, options...)
 		if err != nil {
-			klog.V(100).Infof("Failed to list pods in namespace %s: %v", namespace, err)
+			logging.FromClient(apiClient).V(logging.LevelDebug).Info(
+				"Failed to list pods in namespace", "kind", resourceCRD, "namespace", namespace, "error", err)
 
 			return nil, err
 		}
@@ -279,3 +282,47 @@ func listPodsInNamespaces(
 
 	return allPods, nil
 }
+
+// listPodPages returns an iterator over builders for the pods in nsname, or in all namespaces if nsname is empty,
+// requesting pageSize pods at a time. It does not perform validation.
+func listPodPages(
+	apiClient *clients.Settings, nsname string, options metav1.ListOptions, pageSize int64) iter.Seq2[*Builder, error] {
+	return func(yield func(*Builder, error) bool) {
+		pages := clients.ListPages(logging.DiscardContext(), options, pageSize, apiClient.Pods(nsname).List)
+
+		for podList, err := range pages {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			for index := range podList.Items {
+				podBuilder := &Builder{
+					apiClient:  apiClient,
+					Object:     &podList.Items[index],
+					Definition: &podList.Items[index],
+				}
+
+				if !yield(podBuilder, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// collectPods returns the builders from the iterator, or the first error it yields.
+func collectPods(pods iter.Seq2[*Builder, error]) ([]*Builder, error) {
+	var podObjects []*Builder
+
+	for podBuilder, err := range pods {
+		if err != nil {
+			return nil, err
+		}
+
+		podObjects = append(podObjects, podBuilder)
+	}
+
+	return podObjects, nil
+}
@@ -11,6 +11,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestWaitForPodsInNamespacesHealthy(t *testing.T) {
@@ -92,3 +95,155 @@ func TestWaitForPodsInNamespacesHealthy(t *testing.T) {
 		assert.Equal(t, testCase.expectedError, err)
 	}
 }
+
+func TestListSeq(t *testing.T) {
+	testCases := []struct {
+		options       []runtimeclient.ListOption
+		stopAfter     int
+		client        bool
+		expectedCount int
+		expectedNames []string
+		expectedError error
+	}{
+		{
+			options:       nil,
+			client:        true,
+			expectedCount: 3,
+			expectedNames: []string{"pod-0", "pod-1", "pod-2"},
+		},
+		{
+			options:       []runtimeclient.ListOption{runtimeclient.InNamespace(defaultPodNsName)},
+			client:        true,
+			expectedCount: 2,
+			expectedNames: []string{"pod-0", "pod-1"},
+		},
+		{
+			options:       []runtimeclient.ListOption{runtimeclient.MatchingLabels{"app": "test"}},
+			client:        true,
+			expectedCount: 1,
+			expectedNames: []string{"pod-1"},
+		},
+		{
+			options:       nil,
+			stopAfter:     1,
+			client:        true,
+			expectedCount: 1,
+		},
+		{
+			options:       nil,
+			client:        false,
+			expectedError: fmt.Errorf("podList 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			labeledPod := buildDummyPod("pod-1", defaultPodNsName, defaultPodImage)
+			labeledPod.Labels = map[string]string{"app": "test"}
+
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{
+					buildDummyPod("pod-0", defaultPodNsName, defaultPodImage),
+					labeledPod,
+					buildDummyPod("pod-2", "other-namespace", defaultPodImage),
+				},
+			})
+		}
+
+		var (
+			names []string
+			err   error
+		)
+
+		for podBuilder, podErr := range ListSeq(testSettings, testCase.options...) {
+			if podErr != nil {
+				err = podErr
+
+				continue
+			}
+
+			names = append(names, podBuilder.Object.Name)
+
+			if len(names) == testCase.stopAfter {
+				break
+			}
+		}
+
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Len(t, names, testCase.expectedCount)
+
+		if testCase.expectedNames != nil {
+			assert.ElementsMatch(t, testCase.expectedNames, names)
+		}
+	}
+}
+
+func TestListPaginated(t *testing.T) {
+	fakeClient := k8sfake.NewSimpleClientset()
+	listCalls := 0
+
+	// The fake clientset ignores Limit and Continue, so serve one pod per page with the page index as the token.
+	fakeClient.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		listAction, ok := action.(k8stesting.ListActionImpl)
+		if !ok {
+			return false, nil, nil
+		}
+
+		listCalls++
+
+		assert.Equal(t, clients.DefaultListPageSize, listAction.ListOptions.Limit)
+
+		podList := &corev1.PodList{}
+		podList.Items = []corev1.Pod{*buildDummyPod(fmt.Sprintf("pod-%d", listCalls-1), defaultPodNsName, defaultPodImage)}
+
+		if listCalls < 3 {
+			podList.Continue = fmt.Sprintf("%d", listCalls)
+		}
+
+		return true, podList, nil
+	})
+
+	podBuilders, err := List(&clients.Settings{CoreV1Interface: fakeClient.CoreV1()}, defaultPodNsName)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, listCalls)
+	assert.Len(t, podBuilders, 3)
+}
+
+func TestListSeqPageSizeAndLimit(t *testing.T) {
+	fakeClient := k8sfake.NewSimpleClientset()
+
+	var limits []int64
+
+	// Serve one pod per page, with the page index as the continue token, until 5 pods have been listed.
+	fakeClient.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		listAction, ok := action.(k8stesting.ListActionImpl)
+		if !ok {
+			return false, nil, nil
+		}
+
+		limits = append(limits, listAction.ListOptions.Limit)
+
+		podList := &corev1.PodList{}
+		podList.Items = []corev1.Pod{*buildDummyPod(fmt.Sprintf("pod-%d", len(limits)-1), defaultPodNsName, defaultPodImage)}
+
+		if len(limits) < 5 {
+			podList.Continue = fmt.Sprintf("%d", len(limits))
+		}
+
+		return true, podList, nil
+	})
+
+	var names []string
+
+	for podBuilder, err := range ListSeq(
+		&clients.Settings{CoreV1Interface: fakeClient.CoreV1()}, clients.WithPageSize(1), runtimeclient.Limit(3)) {
+		assert.Nil(t, err)
+
+		names = append(names, podBuilder.Object.Name)
+	}
+
+	assert.Equal(t, []string{"pod-0", "pod-1", "pod-2"}, names)
+	assert.Equal(t, []int64{1, 1, 1}, limits)
+}
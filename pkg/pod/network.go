@@ -4,16 +4,18 @@ import (
 	"fmt"
 	"net/netip"
 
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
-	"k8s.io/klog/v2"
 )
 
 // StaticAnnotation defines network annotation for pod object.
 func StaticAnnotation(name string) *multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static network annotation for pod object with name %s", name)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info("Build static network annotation for pod object", "name", name)
 
 	if name == "" {
-		klog.V(100).Info("The name of the StaticAnnotation is empty")
+		logger.V(logging.LevelDebug).Info("The name of the StaticAnnotation is empty")
 
 		return nil
 	}
@@ -25,18 +27,20 @@ func StaticAnnotation(name string) *multus.NetworkSelectionElement {
 
 // StaticIPAnnotation defines static ip address network annotation for pod object.
 func StaticIPAnnotation(name string, ipAddr []string) []*multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static IP network annotation for pod object with name %s and ip addresses %v",
-		name, ipAddr)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static IP network annotation for pod object", "name", name, "ipAddresses", ipAddr)
 
 	if name == "" {
-		klog.V(100).Info("The name of the StaticIPAnnotation is empty")
+		logger.V(logging.LevelDebug).Info("The name of the StaticIPAnnotation is empty")
 
 		return nil
 	}
 	// Add new function that doesn't use IP address.
 	// Uncomment the following validation when the new function is added.
 	// if len(ipAddr) == 0 {
-	//	klog.V(100).Info("The ip address list of the StaticIPAnnotation is empty")
+	//	logger.V(logging.LevelDebug).Info("The ip address list of the StaticIPAnnotation is empty")
 	//
 	//	return nil
 	//}
@@ -57,11 +61,15 @@ func StaticIPAnnotation(name string, ipAddr []string) []*multus.NetworkSelection
 // network annotation for pod object.
 func StaticIPAnnotationWithInterfaceAndNamespace(
 	name, namespace, intName string, ipAddr []string) []*multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static network ip annotation with interface for pod object with "+
-		"name: %s, namespace: %s, interfaceName: %s and ipAddress: %v", name, namespace, intName, ipAddr)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static network ip annotation with interface for pod object",
+		"name", name, "namespace", namespace, "interfaceName", intName, "ipAddresses", ipAddr)
 
 	if intName == "" {
-		klog.V(100).Info("The interface name of the pod's static IP annotation with namespace is empty")
+		logger.V(logging.LevelDebug).Info(
+			"The interface name of the pod's static IP annotation with namespace is empty")
 
 		return nil
 	}
@@ -79,15 +87,17 @@ func StaticIPAnnotationWithInterfaceAndNamespace(
 
 // StaticIPAnnotationWithMacAddress defines static ip address and static macaddress network annotation for pod object.
 func StaticIPAnnotationWithMacAddress(name string, ipAddr []string, macAddr string) []*multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static ip network annotation for pod object with "+
-		"name: %s, ip addresses: %v, mac address: %s", name, ipAddr, macAddr)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static ip network annotation for pod object", "name", name, "ipAddresses", ipAddr, "macAddress", macAddr)
 
 	baseAnnotation := StaticIPAnnotation(name, ipAddr)
 
 	// Add new function that doesn't use mac address.
 	// Uncomment the following validation when the new function is added.
 	// if macAddr == "" {
-	//	klog.V(100).Info("The mac address of the pod's static IP annotation empty")
+	//	logger.V(logging.LevelDebug).Info("The mac address of the pod's static IP annotation empty")
 	//
 	//	return nil
 	//}
@@ -103,11 +113,14 @@ func StaticIPAnnotationWithMacAddress(name string, ipAddr []string, macAddr stri
 
 // StaticIPAnnotationWithNamespace defines static ip address and namespace network annotation for pod object.
 func StaticIPAnnotationWithNamespace(name, namespace string, ipAddr []string) []*multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static ip network annotation for pod object with "+
-		"name: %s, namespace: %s, ip addresses: %v", name, namespace, ipAddr)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static ip network annotation for pod object",
+		"name", name, "namespace", namespace, "ipAddresses", ipAddr)
 
 	if namespace == "" {
-		klog.V(100).Info("The namespace of the pod's static IP annotation with namespace is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the pod's static IP annotation with namespace is empty")
 
 		return nil
 	}
@@ -126,19 +139,22 @@ func StaticIPAnnotationWithNamespace(name, namespace string, ipAddr []string) []
 // StaticIPAnnotationWithMacAndNamespace defines static ip address and namespace, mac address network annotation
 // for pod object.
 func StaticIPAnnotationWithMacAndNamespace(name, namespace, macAddr string) []*multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static ip network annotation for pod object with "+
-		"name: %s, namespace: %s, mac address: %s", name, namespace, macAddr)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static ip network annotation for pod object",
+		"name", name, "namespace", namespace, "macAddress", macAddr)
 
 	// Add new function that doesn't use mac address.
 	// Uncomment the following validation when the new function is added.
 	// if macAddr == "" {
-	//	klog.V(100).Info("The mac address of the pod's static IP annotation empty")
+	//	logger.V(logging.LevelDebug).Info("The mac address of the pod's static IP annotation empty")
 	//
 	//	return nil
 	//}
 
 	if namespace == "" {
-		klog.V(100).Info("The namespace of the pod's static IP annotation with namespace is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the pod's static IP annotation with namespace is empty")
 
 		return nil
 	}
@@ -158,11 +174,15 @@ func StaticIPAnnotationWithMacAndNamespace(name, namespace, macAddr string) []*m
 // mac address network annotation for pod object.
 func StaticIPAnnotationWithInterfaceMacAndNamespace(
 	name, namespace, intName, macAddr string) []*multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static network ip annotation for pod object with "+
-		"name: %s, namespace: %s, interfaceName: %s and mac address: %v", name, namespace, intName, macAddr)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static network ip annotation for pod object",
+		"name", name, "namespace", namespace, "interfaceName", intName, "macAddress", macAddr)
 
 	if intName == "" {
-		klog.V(100).Info("The interface name of the pod's static IP annotation with namespace is empty")
+		logger.V(logging.LevelDebug).Info(
+			"The interface name of the pod's static IP annotation with namespace is empty")
 
 		return nil
 	}
@@ -182,24 +202,28 @@ func StaticIPAnnotationWithInterfaceMacAndNamespace(
 // main bond int.
 func StaticIPBondAnnotationWithInterface(
 	bondNadName, bondIntName string, sriovNetworkNameList, ipAddrBond []string) []*multus.NetworkSelectionElement {
-	klog.V(100).Infof("Build static network bond ip annotation for pod object with "+
-		"name: %s, bond interface name: %s, sriov network name list: %v and ip addresses bond: %v",
-		bondIntName, bondIntName, sriovNetworkNameList, ipAddrBond)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static network bond ip annotation for pod object",
+		"name", bondNadName, "bondInterfaceName", bondIntName,
+		"sriovNetworks", sriovNetworkNameList, "ipAddresses", ipAddrBond)
 
 	if bondIntName == "" {
-		klog.V(100).Info("The bond interface name of the StaticIPBondAnnotationWithInterface is empty")
+		logger.V(logging.LevelDebug).Info("The bond interface name of the StaticIPBondAnnotationWithInterface is empty")
 
 		return nil
 	}
 
 	if len(sriovNetworkNameList) == 0 {
-		klog.V(100).Info("The sriov network name list of the StaticIPBondAnnotationWithInterface is empty")
+		logger.V(logging.LevelDebug).Info(
+			"The sriov network name list of the StaticIPBondAnnotationWithInterface is empty")
 
 		return nil
 	}
 
 	if len(ipAddrBond) == 0 {
-		klog.V(100).Info("The ip address list of the StaticIPBondAnnotationWithInterface is empty")
+		logger.V(logging.LevelDebug).Info("The ip address list of the StaticIPBondAnnotationWithInterface is empty")
 
 		return nil
 	}
@@ -231,11 +255,14 @@ func StaticIPBondAnnotationWithInterface(
 
 // StaticIPMultiNetDualStackAnnotation defines network annotation for multiple interfaces with dual stack addresses.
 func StaticIPMultiNetDualStackAnnotation(sriovNets, ipAddr []string) ([]*multus.NetworkSelectionElement, error) {
-	klog.V(100).Infof("Build static dual-stack network ip annotation for pod object with "+
-		"sriovNets: %v, ipAddr: %v", sriovNets, ipAddr)
+	logger := logging.FromClient(nil)
+
+	logger.V(logging.LevelDebug).Info(
+		"Build static dual-stack network ip annotation for pod object",
+		"sriovNetworks", sriovNets, "ipAddresses", ipAddr)
 
 	if len(sriovNets) == 0 {
-		klog.V(100).Info("sriovNets cannot be empty")
+		logger.V(logging.LevelDebug).Info("sriovNets cannot be empty")
 
 		return nil, fmt.Errorf("sriovNets []string cannot be empty")
 	}
@@ -244,13 +271,13 @@ func StaticIPMultiNetDualStackAnnotation(sriovNets, ipAddr []string) ([]*multus.
 
 	// Verify ipAddr has an even number of IP addresses and not empty.
 	if len(ipAddr) == 0 || len(ipAddr)%2 != 0 {
-		klog.V(100).Info("ipAddr needs to contain an even number of IP addresses")
+		logger.V(logging.LevelDebug).Info("ipAddr needs to contain an even number of IP addresses")
 
 		return nil, fmt.Errorf("ipAddr []string cannot be empty or an odd number")
 	}
 
 	if !ipValid(ipAddr) {
-		klog.V(100).Info("ipAddr is in invalid format")
+		logger.V(logging.LevelDebug).Info("ipAddr is in invalid format")
 
 		return nil, fmt.Errorf("ipAddr []string contain invalid ip address")
 	}
@@ -270,7 +297,8 @@ func ipValid(ipAddrBond []string) bool {
 		if err != nil {
 			_, err = netip.ParseAddr(ipAddr)
 			if err != nil {
-				klog.V(100).Infof("The ip address %s in ip address list is invalid", ipAddr)
+				logging.FromClient(nil).V(logging.LevelDebug).Info(
+					"The ip address in ip address list is invalid", "ipAddress", ipAddr)
 
 				return false
 			}
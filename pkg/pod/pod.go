@@ -23,15 +23,20 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
-	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/tracing"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 )
 
 const (
+	// resourceCRD is the kind of the resource, used in the errors returned by the builder.
+	resourceCRD = "Pod"
 	// defaultDialTimeout is the maximum time to wait for a TCP connection to be established.
 	defaultDialTimeout = 30 * time.Second
 	// defaultTLSHandshakeTimeout is the maximum time to wait for TLS handshake completion.
@@ -59,13 +64,12 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builder {
-	klog.V(100).Infof(
-		"Initializing new pod structure with the following params: "+
-			"name: %s, namespace: %s, image: %s",
-		name, nsname, image)
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD, "name", name, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info("Initializing new pod structure", "image", image)
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty, pod 'apiClient' cannot be empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty, pod 'apiClient' cannot be empty")
 
 		return nil
 	}
@@ -76,7 +80,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	}
 
 	if name == "" {
-		klog.V(100).Info("The name of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The name of the pod is empty")
 
 		builder.errorMsg = "pod 'name' cannot be empty"
 
@@ -84,7 +88,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the pod is empty")
 
 		builder.errorMsg = "pod 'namespace' cannot be empty"
 
@@ -92,7 +96,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	}
 
 	if image == "" {
-		klog.V(100).Info("The image of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The image of the pod is empty")
 
 		builder.errorMsg = "pod 'image' cannot be empty"
 
@@ -101,7 +105,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 
 	defaultContainer, err := NewContainerBuilder("test", image, []string{"/bin/bash", "-c", "sleep INF"}).GetContainerCfg()
 	if err != nil {
-		klog.V(100).Info("Failed to define the default container settings")
+		logger.V(logging.LevelDebug).Info("Failed to define the default container settings", "error", err)
 
 		builder.errorMsg = err.Error()
 
@@ -113,14 +117,69 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	return builder
 }
 
+// NewBuilderFromManifest creates a new instance of Builder whose definition is decoded from a single YAML or JSON
+// pod manifest. Unknown fields and kinds other than Pod are rejected.
+func NewBuilderFromManifest(apiClient *clients.Settings, manifest []byte) *Builder {
+	logger := logging.FromClient(apiClient).WithValues("kind", resourceCRD)
+
+	logger.V(logging.LevelDebug).Info("Initializing new pod structure from manifest")
+
+	if apiClient == nil {
+		logger.V(logging.LevelDebug).Info("The apiClient of the pod is nil")
+
+		return nil
+	}
+
+	definition, err := common.DecodeManifest[corev1.Pod](apiClient.Scheme(), manifest)
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Failed to decode pod manifest", "error", err)
+
+		return &Builder{
+			apiClient:  apiClient,
+			Definition: &corev1.Pod{},
+			errorMsg:   fmt.Sprintf("failed to decode pod manifest: %v", err),
+		}
+	}
+
+	builder := &Builder{
+		apiClient:  apiClient,
+		Definition: definition,
+	}
+
+	if builder.Definition.Name == "" {
+		logger.V(logging.LevelDebug).Info("The name of the pod is empty")
+
+		builder.errorMsg = "pod 'name' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Namespace == "" {
+		logger.V(logging.LevelDebug).Info("The namespace of the pod is empty")
+
+		builder.errorMsg = "pod 'namespace' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
 // Pull loads an existing pod into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	klog.V(100).Infof("Pulling existing pod name: %s namespace:%s", name, nsname)
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the same as Pull but uses the provided context for the API call.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logger := logging.FromContext(ctx, apiClient).WithValues("kind", resourceCRD, "name", name, "namespace", nsname)
+
+	logger.V(logging.LevelDebug).Info("Pulling existing pod")
 
 	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
+		logger.V(logging.LevelDebug).Info("The apiClient is empty")
 
-		return nil, fmt.Errorf("pod 'apiClient' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "pod 'apiClient' cannot be empty")
 	}
 
 	builder := &Builder{
@@ -134,22 +193,21 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		klog.V(100).Info("The name of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The name of the pod is empty")
 
-		return nil, fmt.Errorf("pod 'name' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "pod 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		klog.V(100).Info("The namespace of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The namespace of the pod is empty")
 
-		return nil, fmt.Errorf("pod 'namespace' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "pod 'namespace' cannot be empty")
 	}
 
-	if !builder.Exists() {
-		klog.V(100).Infof("Failed to pull pod object %s from namespace %s. Object does not exist",
-			name, nsname)
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("Failed to pull pod object. Object does not exist")
 
-		return nil, fmt.Errorf("pod object %s does not exist in namespace %s", name, nsname)
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -163,13 +221,14 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Adding nodeName %s to the definition of pod %s in namespace %s",
-		nodeName, builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Adding nodeName to the definition of pod", "nodeName", nodeName)
 
 	builder.isMutationAllowed("nodeName")
 
 	if nodeName == "" {
-		klog.V(100).Info("The node name is empty")
+		logger.V(logging.LevelDebug).Info("The node name is empty")
 
 		builder.errorMsg = "can not define pod on empty node"
 
@@ -183,17 +242,23 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 
 // Create makes a pod according to the pod definition and stores the created object in the pod builder.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the same as Create but uses the provided context for all API calls.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Creating pod %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info("Creating pod")
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
+		start := time.Now()
 		builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Create(
-			logging.DiscardContext(), builder.Definition, metav1.CreateOptions{})
+			logging.DiscardContextFrom(ctx), builder.Definition, metav1.CreateOptions{})
+		builder.observe("create", start, err)
 	}
 
 	return builder, err
@@ -201,25 +266,32 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Delete removes the pod object and resets the builder object.
 func (builder *Builder) Delete() (*Builder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the same as Delete but uses the provided context for all API calls.
+func (builder *Builder) DeleteWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Deleting pod %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
 
-	if !builder.Exists() {
-		klog.V(100).Infof(
-			"Pod %s in namespace %s cannot be deleted because it does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelOperation).Info("Deleting pod")
+
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("Pod cannot be deleted because it does not exist")
 
 		builder.Object = nil
 
 		return builder, nil
 	}
 
+	start := time.Now()
 	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		logging.DiscardContext(), builder.Object.Name, metav1.DeleteOptions{})
+		logging.DiscardContextFrom(ctx), builder.Object.Name, metav1.DeleteOptions{})
+	builder.observe("delete", start, err)
+
 	if err != nil {
 		return builder, fmt.Errorf("can not delete pod: %w", err)
 	}
@@ -231,19 +303,25 @@ func (builder *Builder) Delete() (*Builder, error) {
 
 // DeleteAndWait deletes the pod object and waits until the pod is deleted.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
+	return builder.DeleteAndWaitWithContext(context.TODO(), timeout)
+}
+
+// DeleteAndWaitWithContext is the same as DeleteAndWait but uses the provided context for all API calls and for the
+// wait, so cancelling the context stops waiting before the timeout elapses.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Deleting pod %s in namespace %s and waiting for the defined period until it is removed",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info(
+		"Deleting pod and waiting for the defined period until it is removed", "timeout", timeout)
 
-	builder, err := builder.Delete()
+	builder, err := builder.DeleteWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	err = builder.WaitUntilDeletedWithContext(ctx, timeout)
 	if err != nil {
 		return builder, err
 	}
@@ -253,25 +331,32 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 
 // DeleteImmediate removes the pod immediately and resets the builder object.
 func (builder *Builder) DeleteImmediate() (*Builder, error) {
+	return builder.DeleteImmediateWithContext(context.TODO())
+}
+
+// DeleteImmediateWithContext is the same as DeleteImmediate but uses the provided context for all API calls.
+func (builder *Builder) DeleteImmediateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Immediately deleting pod %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
 
-	if !builder.Exists() {
-		klog.V(100).Infof(
-			"Pod %s in namespace %s cannot be deleted because it does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelOperation).Info("Immediately deleting pod")
+
+	if !builder.ExistsWithContext(ctx) {
+		logger.V(logging.LevelDebug).Info("Pod cannot be deleted because it does not exist")
 
 		builder.Object = nil
 
 		return builder, nil
 	}
 
+	start := time.Now()
 	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		logging.DiscardContext(), builder.Object.Name, metav1.DeleteOptions{GracePeriodSeconds: ptr.To(int64(0))})
+		logging.DiscardContextFrom(ctx), builder.Object.Name, metav1.DeleteOptions{GracePeriodSeconds: ptr.To(int64(0))})
+	builder.observe("delete", start, err)
+
 	if err != nil {
 		return builder, fmt.Errorf("can not immediately delete pod: %w", err)
 	}
@@ -283,19 +368,26 @@ func (builder *Builder) DeleteImmediate() (*Builder, error) {
 
 // CreateAndWaitUntilRunning creates the pod object and waits until the pod is running.
 func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilRunningWithContext(context.TODO(), timeout)
+}
+
+// CreateAndWaitUntilRunningWithContext is the same as CreateAndWaitUntilRunning but uses the provided context for all
+// API calls and for the wait.
+func (builder *Builder) CreateAndWaitUntilRunningWithContext(
+	ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	klog.V(100).Infof("Creating pod %s in namespace %s and waiting for the defined period until it is ready",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelOperation).Info(
+		"Creating pod and waiting for the defined period until it is ready", "timeout", timeout)
 
-	builder, err := builder.Create()
+	builder, err := builder.CreateWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilRunning(timeout)
+	err = builder.WaitUntilRunningWithContext(ctx, timeout)
 	if err != nil {
 		return builder, err
 	}
@@ -305,14 +397,19 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 
 // WaitUntilRunning waits for the duration of the defined timeout or until the pod is running.
 func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
+	return builder.WaitUntilRunningWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilRunningWithContext is the same as WaitUntilRunning but stops waiting once the provided context is done.
+func (builder *Builder) WaitUntilRunningWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is running",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info(
+		"Waiting for the defined period until pod is running", "timeout", timeout)
 
-	return builder.WaitUntilInStatus(corev1.PodRunning, timeout)
+	return builder.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, timeout)
 }
 
 // IsHealthy returns true if and only if the pod has succeeded or is running and ready. All other cases, such as when
@@ -322,12 +419,12 @@ func (builder *Builder) IsHealthy() bool {
 		return false
 	}
 
-	klog.V(100).Infof("Checking if pod %s in namespace %s is healthy",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Checking if pod is healthy")
 
 	if !builder.Exists() {
-		klog.V(100).Infof("Cannot check if pod %s in namespace %s is healthy because it does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+		logger.V(logging.LevelDebug).Info("Cannot check if pod is healthy because it does not exist")
 
 		return false
 	}
@@ -337,90 +434,116 @@ func (builder *Builder) IsHealthy() bool {
 
 // WaitUntilInStatus waits for the duration of the defined timeout or until the pod gets to a specific status.
 func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(context.TODO(), status, timeout)
+}
+
+// WaitUntilInStatusWithContext is the same as WaitUntilInStatus but stops waiting once the provided context is done.
+func (builder *Builder) WaitUntilInStatusWithContext(
+	ctx context.Context, status corev1.PodPhase, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has status %v",
-		builder.Definition.Name, builder.Definition.Namespace, status)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info(
+		"Waiting for the defined period until pod has status", "status", status, "timeout", timeout)
 
-	return wait.PollUntilContextTimeout(context.TODO(),
-		time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			updatePod, err := builder.apiClient.Pods(builder.Definition.Namespace).
-				Get(logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
-			if err != nil {
-				klog.V(100).Infof("Failed to get pod %s in namespace %s: %v",
-					builder.Definition.Name, builder.Definition.Namespace, err)
-
-				return false, nil
-			}
-
-			return updatePod.Status.Phase == status, nil
+	_, err := common.WaitUntilObject(ctx, builder.apiClient,
+		corev1.SchemeGroupVersion.WithKind(resourceCRD), builder.Definition, timeout, func(pod *corev1.Pod) (bool, error) {
+			return pod != nil && pod.Status.Phase == status, nil
 		})
+
+	return err
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the pod is deleted.
 func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilDeletedWithContext is the same as WaitUntilDeleted but stops waiting once the provided context is done.
+func (builder *Builder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is deleted",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(ctx)
 
+	logger.V(logging.LevelDebug).Info("Waiting for the defined period until pod is deleted", "timeout", timeout)
+
+	start := time.Now()
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, false, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, false, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+				logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
 			if err == nil {
-				klog.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
+				logger.V(logging.LevelDebug).Info("Pod still present")
 
 				return false, nil
 			}
 
 			if k8serrors.IsNotFound(err) {
-				klog.V(100).Infof("pod %s/%s is gone", builder.Definition.Namespace, builder.Definition.Name)
+				logger.V(logging.LevelDebug).Info("Pod is gone")
 
 				return true, nil
 			}
 
-			klog.V(100).Infof("failed to get pod %s/%s: %v", builder.Definition.Namespace, builder.Definition.Name, err)
+			logger.V(logging.LevelDebug).Info("Failed to get pod", "error", err)
 
 			return false, err
 		})
 
+	err = goinfraerrors.WrapWaitError(resourceCRD, builder.Definition.Name, builder.Definition.Namespace, nil, err)
+	builder.observe("wait", start, err)
+
 	return err
 }
 
 // WaitUntilReady waits for the duration of the defined timeout or until the pod reaches the Ready condition.
 func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
+	return builder.WaitUntilReadyWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilReadyWithContext is the same as WaitUntilReady but stops waiting once the provided context is done.
+func (builder *Builder) WaitUntilReadyWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is Ready",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info(
+		"Waiting for the defined period until pod is Ready", "timeout", timeout)
 
-	return builder.WaitUntilCondition(corev1.PodReady, timeout)
+	return builder.WaitUntilConditionWithContext(ctx, corev1.PodReady, timeout)
 }
 
 // WaitUntilCondition waits for the duration of the defined timeout or until the pod gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionWithContext(context.TODO(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext is the same as WaitUntilCondition but stops waiting once the provided context is
+// done.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition corev1.PodConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	klog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has condition %v",
-		builder.Definition.Name, builder.Definition.Namespace, condition)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info(
+		"Waiting for the defined period until pod has condition", "condition", condition, "timeout", timeout)
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+	var lastObserved *corev1.Pod
+
+	start := time.Now()
+	err := wait.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			updatePod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+				logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
 			if err != nil {
 				return false, nil
 			}
 
+			lastObserved = updatePod
+
 			for _, cond := range updatePod.Status.Conditions {
 				if cond.Type == condition && cond.Status == corev1.ConditionTrue {
 					return true, nil
@@ -429,6 +552,12 @@ func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, ti
 
 			return false, nil
 		})
+
+	err = goinfraerrors.WrapWaitError(
+		resourceCRD, builder.Definition.Name, builder.Definition.Namespace, lastObserved, err)
+	builder.observe("wait", start, err)
+
+	return err
 }
 
 // ExecCommand runs command in the pod and returns the buffer output.
@@ -437,9 +566,10 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		return bytes.Buffer{}, err
 	}
 
+	logger := builder.getLogger(context.TODO())
+
 	if !builder.Exists() {
-		klog.V(100).Infof("Cannot execute command on pod %s in namespace %s because it does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+		logger.V(logging.LevelDebug).Info("Cannot execute command on pod because it does not exist")
 
 		return bytes.Buffer{}, fmt.Errorf("pod object %s does not exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
@@ -456,8 +586,7 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		cName = builder.Definition.Spec.Containers[0].Name
 	}
 
-	klog.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s",
-		command, builder.Object.Name, cName, builder.Object.Namespace)
+	logger.V(logging.LevelDebug).Info("Execute command in the pod", "command", command, "container", cName)
 
 	req := builder.apiClient.CoreV1Interface.RESTClient().
 		Post().
@@ -480,8 +609,7 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		defaultResponseHeaderTimeout,
 	)
 	if err != nil {
-		klog.V(100).Infof("Could not create command executor for pod %s in namespace %s: %v",
-			builder.Definition.Name, builder.Definition.Namespace, err)
+		logger.V(logging.LevelDebug).Info("Could not create command executor for pod", "error", err)
 
 		return buffer, err
 	}
@@ -512,21 +640,22 @@ func (builder *Builder) ExecCommandWithTimeout(
 		return bytes.Buffer{}, err
 	}
 
+	logger := builder.getLogger(context.TODO())
+
 	if timeout <= 0 {
-		klog.V(100).Info("Timeout must be greater than 0")
+		logger.V(logging.LevelDebug).Info("Timeout must be greater than 0")
 
 		return bytes.Buffer{}, fmt.Errorf("timeout must be greater than 0")
 	}
 
 	if len(command) == 0 {
-		klog.V(100).Info("Command must be provided")
+		logger.V(logging.LevelDebug).Info("Command must be provided")
 
 		return bytes.Buffer{}, fmt.Errorf("command must be provided")
 	}
 
 	if !builder.Exists() {
-		klog.V(100).Infof("Cannot execute command on pod %s in namespace %s because it does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
+		logger.V(logging.LevelDebug).Info("Cannot execute command on pod because it does not exist")
 
 		return bytes.Buffer{}, fmt.Errorf("pod object %s does not exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
@@ -543,8 +672,8 @@ func (builder *Builder) ExecCommandWithTimeout(
 		cName = builder.Definition.Spec.Containers[0].Name
 	}
 
-	klog.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s with %s timeout",
-		command, builder.Object.Name, cName, builder.Object.Namespace, timeout.String())
+	logger.V(logging.LevelDebug).Info(
+		"Execute command in the pod with timeout", "command", command, "container", cName, "timeout", timeout)
 
 	req := builder.apiClient.CoreV1Interface.RESTClient().
 		Post().
@@ -592,8 +721,9 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 		return bytes.Buffer{}, err
 	}
 
-	klog.V(100).Infof("Copying %s from %s in the pod",
-		path, containerName)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Copying path from the pod", "path", path, "container", containerName)
 
 	var command []string
 	if tar {
@@ -634,8 +764,7 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 		defaultResponseHeaderTimeout,
 	)
 	if err != nil {
-		klog.V(100).Infof("Could not create executor to copy from pod %s in namespace %s: %v",
-			builder.Definition.Name, builder.Definition.Namespace, err)
+		logger.V(logging.LevelDebug).Info("Could not create executor to copy from pod", "error", err)
 
 		return buffer, err
 	}
@@ -655,17 +784,23 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 
 // Exists checks whether the given pod exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the same as Exists but uses the provided context for the API call.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	klog.V(100).Infof("Checking if pod %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(ctx).V(logging.LevelDebug).Info("Checking if pod exists")
 
-	var err error
+	start := time.Now()
+	object, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
+		logging.DiscardContextFrom(ctx), builder.Definition.Name, metav1.GetOptions{})
+	builder.observe("get", start, err)
 
-	builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Get(
-		logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+	builder.Object = object
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -676,7 +811,8 @@ func (builder *Builder) RedefineDefaultCMD(command []string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Redefining default pod's container cmd with the new %v", command)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Redefining default pod's container cmd", "command", command)
 
 	builder.isMutationAllowed("cmd")
 
@@ -695,14 +831,14 @@ func (builder *Builder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *B
 		return builder
 	}
 
-	klog.V(100).Infof("Redefining pod's RestartPolicy to %v", restartPolicy)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Redefining pod's RestartPolicy", "restartPolicy", restartPolicy)
 
 	builder.isMutationAllowed("RestartPolicy")
 
 	if restartPolicy == "" {
-		klog.V(100).Infof(
-			"Failed to set RestartPolicy on pod %s in namespace %s. RestartPolicy can not be empty",
-			builder.Definition.Name, builder.Definition.Namespace)
+		logger.V(logging.LevelDebug).Info("Failed to set RestartPolicy on pod. RestartPolicy can not be empty")
 
 		builder.errorMsg = "can not define pod with empty restart policy"
 
@@ -720,7 +856,7 @@ func (builder *Builder) WithTolerationToMaster() *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Appending pod's %s with toleration to master node", builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Appending pod with toleration to master node")
 
 	builder.isMutationAllowed("toleration to master node")
 
@@ -744,7 +880,7 @@ func (builder *Builder) WithTolerationToControlPlane() *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Appending pod's %s with toleration to control plane node", builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Appending pod with toleration to control plane node")
 
 	builder.isMutationAllowed("toleration to control plane node")
 
@@ -768,7 +904,8 @@ func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Updating pod %s with toleration %v", builder.Definition.Name, toleration)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Updating pod with toleration", "toleration", toleration)
 
 	builder.isMutationAllowed("custom toleration")
 
@@ -787,15 +924,14 @@ func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builde
 		return builder
 	}
 
-	klog.V(100).Infof("Redefining pod %s in namespace %s with nodeSelector %v",
-		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Redefining pod with nodeSelector", "nodeSelector", nodeSelector)
 
 	builder.isMutationAllowed("nodeSelector")
 
 	if len(nodeSelector) == 0 {
-		klog.V(100).Infof(
-			"Failed to set nodeSelector on pod %s in namespace %s. nodeSelector can not be empty",
-			builder.Definition.Name, builder.Definition.Namespace)
+		logger.V(logging.LevelDebug).Info("Failed to set nodeSelector on pod. nodeSelector can not be empty")
 
 		builder.errorMsg = "can not define pod with empty nodeSelector"
 
@@ -813,7 +949,7 @@ func (builder *Builder) WithPrivilegedFlag() *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Applying privileged flag to all pod's: %s containers", builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Applying privileged flag to all pod's containers")
 
 	builder.isMutationAllowed("privileged container flag")
 
@@ -836,16 +972,17 @@ func (builder *Builder) WithVolume(volume corev1.Volume) *Builder {
 		return builder
 	}
 
+	logger := builder.getLogger(context.TODO())
+
 	if volume.Name == "" {
-		klog.V(100).Info("The volume's Name cannot be empty")
+		logger.V(logging.LevelDebug).Info("The volume's Name cannot be empty")
 
 		builder.errorMsg = "the volume's name cannot be empty"
 
 		return builder
 	}
 
-	klog.V(100).Infof("Adding volume %s to pod %s in namespace %s",
-		volume.Name, builder.Definition.Name, builder.Definition.Namespace)
+	logger.V(logging.LevelDebug).Info("Adding volume to pod", "volume", volume.Name)
 
 	builder.Definition.Spec.Volumes = append(builder.Definition.Spec.Volumes, volume)
 
@@ -858,13 +995,15 @@ func (builder *Builder) WithLocalVolume(volumeName, mountPath string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Configuring volume %s for all pod's: %s containers. MountPath %s",
-		volumeName, builder.Definition.Name, mountPath)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info(
+		"Configuring volume for all pod's containers", "volume", volumeName, "mountPath", mountPath)
 
 	builder.isMutationAllowed("LocalVolume")
 
 	if volumeName == "" {
-		klog.V(100).Info("The 'volumeName' of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The 'volumeName' of the pod is empty")
 
 		builder.errorMsg = "'volumeName' parameter is empty"
 
@@ -872,7 +1011,7 @@ func (builder *Builder) WithLocalVolume(volumeName, mountPath string) *Builder {
 	}
 
 	if mountPath == "" {
-		klog.V(100).Info("The 'mountPath' of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The 'mountPath' of the pod is empty")
 
 		builder.errorMsg = "'mountPath' parameter is empty"
 
@@ -917,7 +1056,7 @@ func (builder *Builder) WithAdditionalContainer(container *corev1.Container) *Bu
 		return builder
 	}
 
-	klog.V(100).Infof("Adding new container %v to pod %s", container, builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Adding new container to pod", "container", container)
 	builder.isMutationAllowed("additional container")
 
 	if container == nil {
@@ -937,12 +1076,13 @@ func (builder *Builder) WithAdditionalInitContainer(container *corev1.Container)
 		return builder
 	}
 
-	klog.V(100).Infof("Adding new container %v to pod %s in namespace %s",
-		container, builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Adding new init container to pod", "container", container)
 	builder.isMutationAllowed("additional container")
 
 	if container == nil {
-		klog.V(100).Info("The 'container' parameter of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The 'container' parameter of the pod is empty")
 
 		builder.errorMsg = "'container' parameter cannot be empty"
 
@@ -960,7 +1100,8 @@ func (builder *Builder) WithSecondaryNetwork(network []*multus.NetworkSelectionE
 		return builder
 	}
 
-	klog.V(100).Infof("Applying secondary network %v to pod %s", network, builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying secondary network to pod", "network", network)
 
 	builder.isMutationAllowed("secondary network")
 
@@ -986,7 +1127,7 @@ func (builder *Builder) WithHostNetwork() *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Applying HostNetwork flag to pod's %s configuration", builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Applying HostNetwork flag to pod's configuration")
 
 	builder.isMutationAllowed("HostNetwork")
 
@@ -1005,8 +1146,8 @@ func (builder *Builder) WithHostPid(hostPid bool) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Applying HostPID flag to the configuration of pod: %s in namespace: %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying HostPID flag to the configuration of pod", "hostPID", hostPid)
 
 	builder.isMutationAllowed("HostPID")
 
@@ -1025,8 +1166,8 @@ func (builder *Builder) RedefineDefaultContainer(container corev1.Container) *Bu
 		return builder
 	}
 
-	klog.V(100).Infof("Redefining default pod %s container in namespace %s using new container %v",
-		builder.Definition.Name, builder.Definition.Namespace, container)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Redefining default pod container", "container", container)
 
 	builder.isMutationAllowed("default container")
 
@@ -1041,7 +1182,8 @@ func (builder *Builder) WithHugePages() *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("Applying hugePages configuration to all containers in pod: %s", builder.Definition.Name)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying hugePages configuration to all containers in pod")
 
 	builder.isMutationAllowed("hugepages")
 
@@ -1080,11 +1222,12 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 		return builder
 	}
 
-	klog.V(100).Infof("Applying SecurityContext configuration on pod %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Applying SecurityContext configuration on pod")
 
 	if securityContext == nil {
-		klog.V(100).Info("The 'securityContext' of the pod is empty")
+		logger.V(logging.LevelDebug).Info("The 'securityContext' of the pod is empty")
 
 		builder.errorMsg = "'securityContext' parameter is empty"
 
@@ -1104,36 +1247,30 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		return err
 	}
 
-	klog.V(100).Infof(
-		"Pulling container image %s to node: %s", builder.Definition.Spec.Containers[0].Image,
-		builder.Definition.Spec.NodeName)
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info(
+		"Pulling container image to node",
+		"image", builder.Definition.Spec.Containers[0].Image, "node", builder.Definition.Spec.NodeName)
 
 	builder.WithRestartPolicy(corev1.RestartPolicyNever)
 	builder.RedefineDefaultCMD(testCmd)
 
 	_, err := builder.Create()
 	if err != nil {
-		klog.V(100).Infof(
-			"Failed to create pod %s in namespace %s and pull image %s to node: %s",
-			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
-			builder.Definition.Spec.NodeName)
+		logger.V(logging.LevelDebug).Info("Failed to create pod to pull image", "error", err)
 
 		return err
 	}
 
 	statusErr := builder.WaitUntilInStatus(corev1.PodSucceeded, timeout)
 	if statusErr != nil {
-		klog.V(100).Infof(
-			"Pod status timeout %s. Pod is not in status Succeeded in namespace %s. "+
-				"Fail to confirm that image %s was pulled to node: %s",
-			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
-			builder.Definition.Spec.NodeName)
+		logger.V(logging.LevelDebug).Info(
+			"Pod is not in status Succeeded. Failed to confirm that image was pulled", "error", statusErr)
 
 		_, err = builder.Delete()
 		if err != nil {
-			klog.V(100).Infof(
-				"Failed to remove pod %s in namespace %s from node: %s",
-				builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.NodeName)
+			logger.V(logging.LevelDebug).Info("Failed to remove pod from node", "error", err)
 
 			return err
 		}
@@ -1152,7 +1289,8 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("%v", fmt.Sprintf("Defining pod's label to %s:%s", labelKey, labelValue))
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Defining pod's label", "labelKey", labelKey, "labelValue", labelValue)
 
 	builder.isMutationAllowed("Labels")
 
@@ -1185,7 +1323,7 @@ func (builder *Builder) WithLabels(labels map[string]string) *Builder {
 		return builder
 	}
 
-	klog.V(100).Infof("%v", fmt.Sprintf("Defining pod labels: %q", labels))
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Defining pod labels", "labels", labels)
 
 	builder.Definition.Labels = labels
 
@@ -1198,13 +1336,15 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	klog.V(100).Info("Setting pod additional options")
+	logger := builder.getLogger(context.TODO())
+
+	logger.V(logging.LevelDebug).Info("Setting pod additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 			if err != nil {
-				klog.V(100).Info("Error occurred in mutation function")
+				logger.V(logging.LevelDebug).Info("Error occurred in mutation function", "error", err)
 
 				builder.errorMsg = err.Error()
 
@@ -1222,8 +1362,9 @@ func (builder *Builder) WithTerminationGracePeriodSeconds(terminationGracePeriod
 		return builder
 	}
 
-	klog.V(100).Infof("Applying terminationGracePeriodSeconds flag to the configuration of pod: %s in namespace: %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+		"Applying terminationGracePeriodSeconds to the configuration of pod",
+		"terminationGracePeriodSeconds", terminationGracePeriodSeconds)
 
 	builder.isMutationAllowed("terminationGracePeriodSeconds")
 
@@ -1384,9 +1525,8 @@ func (builder *Builder) isMutationAllowed(configToMutate string) {
 	_, _ = builder.validate()
 
 	if builder.Object != nil {
-		klog.V(100).Infof(
-			"Failed to redefine %s for running pod %s in namespace %s",
-			builder.Definition.Name, configToMutate, builder.Definition.Namespace)
+		builder.getLogger(context.TODO()).V(logging.LevelDebug).Info(
+			"Failed to redefine configuration of running pod", "configuration", configToMutate)
 
 		builder.errorMsg = fmt.Sprintf(
 			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName)
@@ -1442,34 +1582,81 @@ func isMountInUse(containerMounts []corev1.VolumeMount, newMount corev1.VolumeMo
 	return false
 }
 
+// ToJSON returns the pod definition as a JSON manifest with the apiVersion and kind set and the status and
+// server-populated metadata removed.
+func (builder *Builder) ToJSON() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Exporting pod as JSON")
+
+	return common.ExportObjectJSON(builder.Definition, corev1.SchemeGroupVersion.WithKind("Pod"))
+}
+
+// ToYAML is the same as ToJSON but returns a YAML manifest.
+func (builder *Builder) ToYAML() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.getLogger(context.TODO()).V(logging.LevelDebug).Info("Exporting pod as YAML")
+
+	return common.ExportObjectYAML(builder.Definition, corev1.SchemeGroupVersion.WithKind("Pod"))
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "Pod"
+	logger := builder.getLogger(context.TODO())
 
 	if builder == nil {
-		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder is uninitialized")
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		klog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder definition is undefined")
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelDebug).Info("The builder apiClient is nil")
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelDebug).Info("The builder has an error message", "errorMsg", builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
 }
+
+// getLogger returns the logger for the builder's operations, with the kind, name, and namespace of the pod as
+// key/values. See logging.FromContext for how the logger is chosen. It is safe to call on a nil or undefined builder.
+func (builder *Builder) getLogger(ctx context.Context) logr.Logger {
+	if builder == nil {
+		return logging.FromContext(ctx, nil).WithValues("kind", resourceCRD)
+	}
+
+	logger := logging.FromContext(ctx, builder.apiClient).WithValues("kind", resourceCRD)
+
+	if builder.Definition != nil {
+		logger = logger.WithValues("name", builder.Definition.Name, "namespace", builder.Definition.Namespace)
+	}
+
+	return logger
+}
+
+// observe records an operation on the pod in the metrics of the builder apiClient. Waits using common.WaitUntilObject
+// are already recorded there, so this is only needed for the API calls and waits made directly by the builder. Those
+// calls are made once without a clients.RetryPolicy, so no retries are ever recorded for them.
+func (builder *Builder) observe(operation string, start time.Time, err error) {
+	clients.MetricsFrom(builder.apiClient).ObserveOperation(
+		corev1.SchemeGroupVersion.WithKind(resourceCRD), operation, tracing.Outcome(err), time.Since(start))
+}
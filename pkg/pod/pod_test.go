@@ -3,11 +3,16 @@ package pod
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -93,6 +98,65 @@ func TestPodNewBuilder(t *testing.T) {
 	}
 }
 
+func TestPodNewBuilderFromManifest(t *testing.T) {
+	testCases := []struct {
+		manifest      string
+		client        bool
+		expectedError string
+	}{
+		{
+			manifest: fmt.Sprintf("apiVersion: v1\nkind: Pod\nmetadata:\n  name: %s\n  namespace: %s\n"+
+				"spec:\n  containers:\n  - name: test\n    image: %s\n",
+				defaultPodName, defaultPodNsName, defaultPodImage),
+			client:        true,
+			expectedError: "",
+		},
+		{
+			manifest:      fmt.Sprintf("apiVersion: v1\nkind: Pod\nmetadata:\n  namespace: %s\n", defaultPodNsName),
+			client:        true,
+			expectedError: "pod 'name' cannot be empty",
+		},
+		{
+			manifest:      fmt.Sprintf("apiVersion: v1\nkind: Pod\nmetadata:\n  name: %s\n", defaultPodName),
+			client:        true,
+			expectedError: "pod 'namespace' cannot be empty",
+		},
+		{
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n",
+			client:   true,
+			expectedError: "failed to decode pod manifest: manifest has kind /v1, Kind=ConfigMap " +
+				"but expected /v1, Kind=Pod",
+		},
+		{
+			manifest:      "apiVersion: v1\nkind: Pod\n",
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilderFromManifest(testSettings, []byte(testCase.manifest))
+
+		if testCase.client {
+			assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+			if testCase.expectedError == "" {
+				assert.Equal(t, defaultPodName, testBuilder.Definition.Name)
+				assert.Equal(t, defaultPodNsName, testBuilder.Definition.Namespace)
+				assert.Equal(t, defaultPodImage, testBuilder.Definition.Spec.Containers[0].Image)
+			}
+		} else {
+			assert.Nil(t, testBuilder)
+		}
+	}
+}
+
 func TestPodPull(t *testing.T) {
 	testCases := []struct {
 		name                string
@@ -113,28 +177,28 @@ func TestPodPull(t *testing.T) {
 			nsname:              defaultPodNsName,
 			addToRuntimeObjects: true,
 			client:              true,
-			expectedError:       fmt.Errorf("pod 'name' cannot be empty"),
+			expectedError:       goinfraerrors.NewInvalidBuilder("Pod", "pod 'name' cannot be empty"),
 		},
 		{
 			name:                defaultPodName,
 			nsname:              "",
 			addToRuntimeObjects: true,
 			client:              true,
-			expectedError:       fmt.Errorf("pod 'namespace' cannot be empty"),
+			expectedError:       goinfraerrors.NewInvalidBuilder("Pod", "pod 'namespace' cannot be empty"),
 		},
 		{
 			name:                defaultPodName,
 			nsname:              defaultPodNsName,
 			addToRuntimeObjects: false,
 			client:              true,
-			expectedError:       fmt.Errorf("pod object %s does not exist in namespace %s", defaultPodName, defaultPodNsName),
+			expectedError:       goinfraerrors.NewNotFound("Pod", defaultPodName, defaultPodNsName),
 		},
 		{
 			name:                defaultPodName,
 			nsname:              defaultPodNsName,
 			addToRuntimeObjects: true,
 			client:              false,
-			expectedError:       fmt.Errorf("pod 'apiClient' cannot be empty"),
+			expectedError:       goinfraerrors.NewInvalidBuilder("Pod", "pod 'apiClient' cannot be empty"),
 		},
 	}
 
@@ -218,7 +282,7 @@ func TestPodCreate(t *testing.T) {
 		},
 		{
 			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
-			expectedError: fmt.Errorf("pod 'namespace' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder("Pod", "pod 'namespace' cannot be empty"),
 		},
 		{
 			testBuilder:   buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
@@ -238,6 +302,91 @@ func TestPodCreate(t *testing.T) {
 	}
 }
 
+func TestPodCreateWithContextLogging(t *testing.T) {
+	var contextEntries, settingsEntries []string
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testSettings.Logger = funcr.New(func(_, args string) {
+		settingsEntries = append(settingsEntries, args)
+	}, funcr.Options{Verbosity: logging.LevelDebug})
+
+	contextLogger := funcr.New(func(_, args string) {
+		contextEntries = append(contextEntries, args)
+	}, funcr.Options{Verbosity: logging.LevelOperation})
+
+	_, err := buildValidPodTestBuilder(testSettings).CreateWithContext(logr.NewContext(context.TODO(), contextLogger))
+	assert.Nil(t, err)
+
+	// The builder was created with the settings logger, but the context logger takes precedence for the call.
+	assert.NotEmpty(t, settingsEntries)
+	assert.Len(t, contextEntries, 1)
+	assert.Contains(t, contextEntries[0], `"msg"="Creating pod"`)
+	assert.Contains(t, contextEntries[0], fmt.Sprintf(`"kind"="Pod" "name"="%s" "namespace"="%s"`,
+		defaultPodName, defaultPodNsName))
+}
+
+func TestPodWaitUntilInStatusWithContextLogging(t *testing.T) {
+	var contextEntries []string
+
+	runningPod := buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage)
+	runningPod.Status.Phase = corev1.PodRunning
+
+	testSettings, err := clients.NewTestClientBuilder().WithObjects(runningPod).Build()
+	assert.Nil(t, err)
+
+	contextLogger := funcr.New(func(_, args string) {
+		contextEntries = append(contextEntries, args)
+	}, funcr.Options{Verbosity: logging.LevelOperation})
+
+	testBuilder := NewBuilder(testSettings, defaultPodName, defaultPodNsName, defaultPodImage)
+	err = testBuilder.WaitUntilInStatusWithContext(
+		logr.NewContext(context.TODO(), contextLogger), corev1.PodRunning, time.Second)
+	assert.Nil(t, err)
+
+	// The logs of the wait itself must go to the logger of the context provided by the caller.
+	assert.NotEmpty(t, contextEntries)
+	assert.Contains(t, contextEntries[len(contextEntries)-1], `"msg"="Wait succeeded"`)
+}
+
+func TestPodMetrics(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testSettings.Metrics = clients.NewMetrics()
+
+	testBuilder, err := buildValidPodTestBuilder(testSettings).Create()
+	assert.Nil(t, err)
+
+	testBuilder, err = Pull(testSettings, testBuilder.Definition.Name, testBuilder.Definition.Namespace)
+	assert.Nil(t, err)
+
+	_, err = testBuilder.Delete()
+	assert.Nil(t, err)
+
+	err = testBuilder.WaitUntilDeleted(2 * time.Second)
+	assert.Nil(t, err)
+
+	operations := make(map[string]clients.OperationSummary)
+	for _, summary := range testSettings.Metrics.Summary() {
+		operations[summary.Operation] = summary
+	}
+
+	// The get made by Create fails with not found, while those made by Pull and Delete succeed.
+	expectedOperations := map[string]struct{ count, failures int }{
+		"create": {count: 1},
+		"get":    {count: 3, failures: 1},
+		"delete": {count: 1},
+		"wait":   {count: 1},
+	}
+
+	assert.Len(t, operations, len(expectedOperations))
+
+	for operation, expected := range expectedOperations {
+		assert.Equal(t, corev1.SchemeGroupVersion.WithKind("Pod"), operations[operation].GVK, operation)
+		assert.Equal(t, expected.count, operations[operation].Count, operation)
+		assert.Equal(t, expected.failures, operations[operation].Failures, operation)
+		assert.Equal(t, 0, operations[operation].Retries, operation)
+	}
+}
+
 func TestPodDelete(t *testing.T) {
 	testPodDeleteHelper(t, func(builder *Builder) (*Builder, error) {
 		return builder.Delete()
@@ -267,7 +416,7 @@ func TestPodWaitUntilDeleted(t *testing.T) {
 		},
 		{
 			testBuilder:   buildInvalidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("pod 'namespace' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder("Pod", "pod 'namespace' cannot be empty"),
 		},
 		{
 			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
@@ -277,7 +426,76 @@ func TestPodWaitUntilDeleted(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testBuilder.WaitUntilDeleted(2 * time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+		assertPodWaitError(t, testCase.expectedError, err)
+	}
+}
+
+func TestPodWaitUntilInStatusWithContext(t *testing.T) {
+	testCases := []struct {
+		status        corev1.PodPhase
+		cancelled     bool
+		cancelAfter   time.Duration
+		updateAfter   time.Duration
+		expectedError error
+	}{
+		{
+			status:        corev1.PodRunning,
+			cancelled:     false,
+			expectedError: nil,
+		},
+		{
+			status:        corev1.PodSucceeded,
+			updateAfter:   100 * time.Millisecond,
+			expectedError: nil,
+		},
+		{
+			status:        corev1.PodSucceeded,
+			cancelled:     true,
+			expectedError: context.Canceled,
+		},
+		{
+			status:        corev1.PodSucceeded,
+			cancelAfter:   100 * time.Millisecond,
+			expectedError: context.Canceled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		// The wait watches the pod using the runtime client, so the clients must share a tracker.
+		testSettings, err := clients.NewTestClientBuilder().WithObjects(
+			buildDummyPodWithPhaseAndCondition(corev1.PodRunning, corev1.PodReady, false)).Build()
+		assert.NoError(t, err)
+
+		testBuilder := buildValidPodTestBuilder(testSettings)
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		if testCase.cancelled {
+			cancel()
+		}
+
+		// Cancelling while the wait is in progress must stop it well before the timeout.
+		if testCase.cancelAfter > 0 {
+			time.AfterFunc(testCase.cancelAfter, cancel)
+		}
+
+		if testCase.updateAfter > 0 {
+			time.AfterFunc(testCase.updateAfter, func() {
+				pod, err := testSettings.Pods(defaultPodNsName).Get(context.TODO(), defaultPodName, metav1.GetOptions{})
+				if err != nil {
+					return
+				}
+
+				pod.Status.Phase = testCase.status
+				_, _ = testSettings.Pods(defaultPodNsName).UpdateStatus(context.TODO(), pod, metav1.UpdateOptions{})
+			})
+		}
+
+		start := time.Now()
+		err = testBuilder.WaitUntilInStatusWithContext(ctx, testCase.status, time.Minute)
+		assertPodWaitError(t, testCase.expectedError, err)
+		assert.Less(t, time.Since(start), 10*time.Second)
+
+		cancel()
 	}
 }
 
@@ -776,7 +994,7 @@ func testPodDeleteHelper(t *testing.T, deleteFunc func(builder *Builder) (*Build
 		},
 		{
 			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
-			expectedError: fmt.Errorf("pod 'namespace' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder("Pod", "pod 'namespace' cannot be empty"),
 		},
 		{
 			testBuilder:   buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
@@ -812,7 +1030,7 @@ func testPodWaitUntilConditionHelper(t *testing.T, waitFunc func(builder *Builde
 		{
 			valid:         false,
 			ready:         true,
-			expectedError: fmt.Errorf("pod 'namespace' cannot be empty"),
+			expectedError: goinfraerrors.NewInvalidBuilder("Pod", "pod 'namespace' cannot be empty"),
 		},
 		{
 			valid:         true,
@@ -839,10 +1057,25 @@ func testPodWaitUntilConditionHelper(t *testing.T, waitFunc func(builder *Builde
 		}
 
 		err := waitFunc(testBuilder)
-		assert.Equal(t, testCase.expectedError, err)
+		assertPodWaitError(t, testCase.expectedError, err)
 	}
 }
 
+// assertPodWaitError asserts that the error returned by a wait matches the expected error. When the expected error is
+// from the context, the wait must have wrapped it in a WaitTimeoutError.
+func assertPodWaitError(t *testing.T, expectedError, err error) {
+	t.Helper()
+
+	if errors.Is(expectedError, context.DeadlineExceeded) || errors.Is(expectedError, context.Canceled) {
+		assert.True(t, goinfraerrors.IsWaitTimeout(err), "unexpected error, got: %v", err)
+		assert.ErrorIs(t, err, expectedError)
+
+		return
+	}
+
+	assert.Equal(t, expectedError, err)
+}
+
 // testPodWithTolerationHelper handles the test cases where a function applies the specified toleration to a pod.
 func testPodWithTolerationHelper(
 	t *testing.T, toleration corev1.Toleration, testFunc func(builder *Builder, toleration corev1.Toleration) *Builder) {
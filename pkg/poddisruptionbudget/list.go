@@ -80,7 +80,7 @@ func list(apiClient *clients.Settings, nsname string, options metav1.ListOptions
 		return nil, err
 	}
 
-	pdbList, err := apiClient.PodDisruptionBudgets(nsname).List(logging.DiscardContext(), options)
+	pdbList, err := clients.CollectPages(logging.DiscardContext(), options, apiClient.PodDisruptionBudgets(nsname).List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list podDisruptionBudget due to %s", err.Error())
 
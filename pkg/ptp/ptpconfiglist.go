@@ -44,7 +44,7 @@ func ListPtpConfigs(
 
 	ptpConfigList := new(ptpv1.PtpConfigList)
 
-	err = apiClient.List(logging.DiscardContext(), ptpConfigList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, ptpConfigList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list PtpConfigs in all namespaces due to %v", err)
 
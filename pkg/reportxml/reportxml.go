@@ -4,8 +4,10 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/kelseyhightower/envconfig"
@@ -68,11 +70,18 @@ var config *settings
 
 // Create writes report to a given xml file.
 func Create(report ginkgo.Report, destFile, projectTag string) {
+	CreateWithProperties(report, destFile, projectTag, nil)
+}
+
+// CreateWithProperties writes report to a given xml file, adding the properties to the test suite. Properties are sorted
+// by name. This allows recording suite-level data, such as the summary from clients.Metrics.SummaryProperties.
+func CreateWithProperties(report ginkgo.Report, destFile, projectTag string, properties map[string]string) {
 	if destFile == "" {
 		return
 	}
 
 	testSuite := setTestSuite(report)
+	testSuite.Properties.Property = setSuiteProperties(properties)
 
 	for _, testCaseSpecReport := range report.SpecReports {
 		if testCaseSpecReport.FullText() == "" {
@@ -186,6 +195,16 @@ func setProperty(testReport types.SpecReport) []*Property {
 	return nil
 }
 
+func setSuiteProperties(properties map[string]string) []Property {
+	var suiteProperties []Property
+
+	for _, name := range slices.Sorted(maps.Keys(properties)) {
+		suiteProperties = append(suiteProperties, Property{Name: name, Value: properties[name]})
+	}
+
+	return suiteProperties
+}
+
 func setFailureMessage(testReport types.SpecReport) *FailureMessage {
 	if types.SpecStateFailureStates.Is(testReport.State) {
 		return &FailureMessage{
@@ -270,6 +289,7 @@ func appendToExistingReportFile(outputFile string, newReport *TestSuite) {
 	}()
 
 	reportTestSuite.Name = "Aggregated Report"
+	reportTestSuite.Properties.Property = append(reportTestSuite.Properties.Property, newReport.Properties.Property...)
 	reportTestSuite.TestCases = append(reportTestSuite.TestCases, newReport.TestCases...)
 	reportTestSuite.Tests += newReport.Tests
 	reportTestSuite.Skipped += newReport.Skipped
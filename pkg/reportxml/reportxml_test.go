@@ -1,8 +1,10 @@
 package reportxml
 
 import (
+	"encoding/xml"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -109,6 +111,27 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateWithProperties(t *testing.T) {
+	destFile := filepath.Join(t.TempDir(), "report.xml")
+	properties := map[string]string{"second": "value2", "first": "value1"}
+
+	CreateWithProperties(ginkgo.Report{}, destFile, "", properties)
+	CreateWithProperties(ginkgo.Report{}, destFile, "", map[string]string{"third": "value3"})
+
+	content, err := os.ReadFile(destFile)
+	assert.Nil(t, err)
+
+	var testSuite TestSuite
+
+	err = xml.Unmarshal(content, &testSuite)
+	assert.Nil(t, err)
+	assert.Equal(t, []Property{
+		{Name: "first", Value: "value1"},
+		{Name: "second", Value: "value2"},
+		{Name: "third", Value: "value3"},
+	}, testSuite.Properties.Property)
+}
+
 func TestID(t *testing.T) {
 	testCases := []struct {
 		testTag string
@@ -35,7 +35,8 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	klog.V(100).Infof("%v", logMessage)
 
-	resourceQuotaList, err := apiClient.ResourceQuotas(nsname).List(logging.DiscardContext(), passedOptions)
+	resourceQuotaList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.ResourceQuotas(nsname).List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list resource quotas in the namespace %s due to %s", nsname, err.Error())
 
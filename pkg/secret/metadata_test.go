@@ -0,0 +1,78 @@
+package secret
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestSecretWithOwnerReference(t *testing.T) {
+	testCases := []struct {
+		ownerNamespace string
+		expectedError  string
+	}{
+		{
+			ownerNamespace: defaultSecretNamespace,
+			expectedError:  "",
+		},
+		{
+			ownerNamespace: "other-namespace",
+			expectedError:  "owner references cannot cross namespaces",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: append(buildSecretWithDummyObject(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name: "owner", Namespace: testCase.ownerNamespace, UID: types.UID("owner-uid")}}),
+		})
+
+		// The owner may be the object of a builder from any package.
+		owner, err := configmap.Pull(testSettings, "owner", testCase.ownerNamespace)
+		require.NoError(t, err)
+
+		testBuilder := buildValidSecretBuilder(testSettings).
+			WithLabel("app", "test").
+			WithOwnerReference(owner.Object)
+
+		assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, testBuilder.GetError())
+			assert.Equal(t, []metav1.OwnerReference{{
+				APIVersion:         "v1",
+				Kind:               "ConfigMap",
+				Name:               "owner",
+				UID:                types.UID("owner-uid"),
+				BlockOwnerDeletion: ptr.To(true),
+			}}, testBuilder.Definition.OwnerReferences)
+
+			continue
+		}
+
+		assert.ErrorContains(t, testBuilder.GetError(), testCase.expectedError)
+		assert.Empty(t, testBuilder.Definition.OwnerReferences)
+
+		_, err = testBuilder.Create()
+		assert.ErrorContains(t, err, testCase.expectedError)
+	}
+}
+
+func TestSecretWithClusterScopedOwnerReference(t *testing.T) {
+	owner := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: defaultSecretNamespace, UID: types.UID("owner-uid")}}
+	testBuilder := buildValidSecretBuilder(clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{owner},
+	})).WithOwnerReference(owner)
+
+	assert.Nil(t, testBuilder.GetError())
+	assert.Len(t, testBuilder.Definition.OwnerReferences, 1)
+	assert.Equal(t, "Namespace", testBuilder.Definition.OwnerReferences[0].Kind)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
@@ -23,6 +24,14 @@ type Builder struct {
 	errorMsg string
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
+
+	// EmbeddableMetadata provides the label, annotation, owner reference, and finalizer modifiers. The existing
+	// WithAnnotations method of the builder takes precedence over that of the mixin.
+	common.EmbeddableMetadata[corev1.Secret, Builder, *corev1.Secret, *Builder]
+	// EmbeddableApplier provides Apply, which uses server-side apply instead of the Get-then-Update of Update.
+	common.EmbeddableApplier[corev1.Secret, Builder, *corev1.Secret, *Builder]
+	// EmbeddablePatcher provides Patch and PatchChanges, which only send the fields that changed.
+	common.EmbeddablePatcher[corev1.Secret, Builder, *corev1.Secret, *Builder]
 }
 
 // AdditionalOptions additional options for Secret object.
@@ -51,6 +60,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType cor
 		},
 	}
 
+	builder.AttachMixins()
+
 	if name == "" {
 		klog.V(100).Info("The name of the secret is empty")
 
@@ -78,6 +89,54 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType cor
 	return builder
 }
 
+// NewBuilderFromManifest creates a new instance of Builder whose definition is decoded from a single YAML or JSON
+// secret manifest. Unknown fields and kinds other than Secret are rejected.
+func NewBuilderFromManifest(apiClient *clients.Settings, manifest []byte) *Builder {
+	klog.V(100).Info("Initializing new secret structure from manifest")
+
+	if apiClient == nil {
+		klog.V(100).Info("secret 'apiClient' cannot be empty")
+
+		return nil
+	}
+
+	builder := &Builder{
+		apiClient:  apiClient,
+		Definition: &corev1.Secret{},
+	}
+
+	builder.AttachMixins()
+
+	definition, err := common.DecodeManifest[corev1.Secret](apiClient.Scheme(), manifest)
+	if err != nil {
+		klog.V(100).Infof("Failed to decode secret manifest: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("failed to decode secret manifest: %v", err)
+
+		return builder
+	}
+
+	builder.Definition = definition
+
+	if builder.Definition.Name == "" {
+		klog.V(100).Info("The name of the secret is empty")
+
+		builder.errorMsg = "secret 'name' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Namespace == "" {
+		klog.V(100).Info("The namespace of the secret is empty")
+
+		builder.errorMsg = "secret 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
 // Pull loads an existing secret into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	klog.V(100).Infof("Pulling existing secret name: %s under namespace: %s", name, nsname)
@@ -98,6 +157,8 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		},
 	}
 
+	builder.AttachMixins()
+
 	if name == "" {
 		klog.V(100).Info("secret name is empty")
 
@@ -114,7 +175,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("secret object %s does not exist in namespace %s", name, nsname)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
 
 	return builder, nil
 }
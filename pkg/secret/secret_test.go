@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var (
@@ -141,6 +142,63 @@ func TestSecretNewBuilder(t *testing.T) {
 	}
 }
 
+func TestSecretNewBuilderFromManifest(t *testing.T) {
+	testCases := []struct {
+		manifest      string
+		client        bool
+		expectedError string
+	}{
+		{
+			manifest: fmt.Sprintf("apiVersion: v1\nkind: Secret\nmetadata:\n  name: %s\n  namespace: %s\ntype: %s\n",
+				defaultSecretName, defaultSecretNamespace, defaultSecretType),
+			client:        true,
+			expectedError: "",
+		},
+		{
+			manifest:      fmt.Sprintf("apiVersion: v1\nkind: Secret\nmetadata:\n  name: %s\n", defaultSecretName),
+			client:        true,
+			expectedError: "secret 'nsname' cannot be empty",
+		},
+		{
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n",
+			client:   true,
+			expectedError: "failed to decode secret manifest: manifest has kind /v1, Kind=ConfigMap " +
+				"but expected /v1, Kind=Secret",
+		},
+		{
+			manifest:      "apiVersion: v1\nkind: Secret\n",
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewBuilderFromManifest(testSettings, []byte(testCase.manifest))
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, corev1.SecretType(defaultSecretType), testBuilder.Definition.Type)
+
+			// The metadata mixin must be attached so it modifies the decoded definition.
+			testBuilder.WithLabel("app", "test")
+			assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+		}
+	}
+}
+
 func TestSecretCreate(t *testing.T) {
 	testCases := []struct {
 		testSecret    *Builder
@@ -389,6 +447,32 @@ func TestSecretUpdate(t *testing.T) {
 	}
 }
 
+func TestSecretPatchChanges(t *testing.T) {
+	for _, patchType := range []types.PatchType{
+		types.MergePatchType, types.StrategicMergePatchType, types.JSONPatchType} {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      defaultSecretName,
+					Namespace: defaultSecretNamespace,
+				},
+				Data: map[string][]byte{"original": []byte("true")},
+			}},
+			SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+		})
+
+		testBuilder, err := Pull(testSettings, defaultSecretName, defaultSecretNamespace)
+		assert.Nil(t, err)
+		assert.NotSame(t, testBuilder.Object, testBuilder.Definition)
+
+		testBuilder.Definition.Data["patched"] = []byte("true")
+
+		testBuilder, err = testBuilder.PatchChanges(patchType)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string][]byte{"original": []byte("true"), "patched": []byte("true")}, testBuilder.Object.Data)
+	}
+}
+
 func TestSecretValidate(t *testing.T) {
 	testCases := []struct {
 		builderNil    bool
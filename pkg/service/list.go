@@ -33,7 +33,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	klog.V(100).Infof("%v", logMessage)
 
-	serviceList, err := apiClient.Services(nsname).List(logging.DiscardContext(), passedOptions)
+	serviceList, err := clients.CollectPages(logging.DiscardContext(), passedOptions, apiClient.Services(nsname).List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list services in the namespace %s due to %s", nsname, err.Error())
 
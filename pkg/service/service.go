@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +17,9 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// resourceCRD is the kind of the resource, used in the errors returned by the builder.
+const resourceCRD = "Service"
+
 // Builder provides struct for service object containing connection to the cluster and the service definitions.
 type Builder struct {
 	// Service definition. Used to create a service object
@@ -75,6 +80,52 @@ func NewBuilder(
 	return &builder
 }
 
+// NewBuilderFromManifest creates a new instance of Builder whose definition is decoded from a single YAML or JSON
+// service manifest. Unknown fields and kinds other than Service are rejected.
+func NewBuilderFromManifest(apiClient *clients.Settings, manifest []byte) *Builder {
+	klog.V(100).Info("Initializing new service structure from manifest")
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the service is nil")
+
+		return nil
+	}
+
+	builder := Builder{
+		apiClient:  apiClient.CoreV1Interface,
+		Definition: &corev1.Service{},
+	}
+
+	definition, err := common.DecodeManifest[corev1.Service](apiClient.Scheme(), manifest)
+	if err != nil {
+		klog.V(100).Infof("Failed to decode service manifest: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("failed to decode service manifest: %v", err)
+
+		return &builder
+	}
+
+	builder.Definition = definition
+
+	if builder.Definition.Name == "" {
+		klog.V(100).Info("The name of the service is empty")
+
+		builder.errorMsg = "Service 'name' cannot be empty"
+
+		return &builder
+	}
+
+	if builder.Definition.Namespace == "" {
+		klog.V(100).Info("The namespace of the service is empty")
+
+		builder.errorMsg = "Service 'nsname' cannot be empty"
+
+		return &builder
+	}
+
+	return &builder
+}
+
 // WithNodePort redefines the service with NodePort service type.
 func (builder *Builder) WithNodePort() *Builder {
 	if valid, _ := builder.validate(); !valid {
@@ -101,7 +152,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient is empty")
 
-		return nil, fmt.Errorf("service 'apiClient' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "service 'apiClient' cannot be empty")
 	}
 
 	builder := Builder{
@@ -115,15 +166,15 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		return nil, fmt.Errorf("service 'name' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "service 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		return nil, fmt.Errorf("service 'namespace' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "service 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
-		return nil, fmt.Errorf("service object %s does not exist in namespace %s", name, nsname)
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -426,30 +477,28 @@ func isValidPort(port int32) bool {
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "Service"
-
 	if builder == nil {
 		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
 		klog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
 		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
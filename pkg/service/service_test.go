@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -60,29 +61,28 @@ func TestPullService(t *testing.T) {
 			name:                "",
 			namespace:           defaultServiceNamespace,
 			addToRuntimeObjects: true,
-			expectedError:       fmt.Errorf("service 'name' cannot be empty"),
+			expectedError:       goinfraerrors.NewInvalidBuilder("Service", "service 'name' cannot be empty"),
 			client:              true,
 		},
 		{
 			name:                defaultServiceName,
 			namespace:           "",
 			addToRuntimeObjects: true,
-			expectedError:       fmt.Errorf("service 'namespace' cannot be empty"),
+			expectedError:       goinfraerrors.NewInvalidBuilder("Service", "service 'namespace' cannot be empty"),
 			client:              true,
 		},
 		{
 			name:                "servicetesttest",
 			namespace:           defaultServiceNamespace,
 			addToRuntimeObjects: false,
-			expectedError: fmt.Errorf("service object servicetesttest does not exist " +
-				"in namespace test-service-namespace"),
-			client: true,
+			expectedError:       goinfraerrors.NewNotFound("Service", "servicetesttest", defaultServiceNamespace),
+			client:              true,
 		},
 		{
 			name:                "servicetesttest",
 			namespace:           defaultServiceNamespace,
 			addToRuntimeObjects: true,
-			expectedError:       fmt.Errorf("service 'apiClient' cannot be empty"),
+			expectedError:       goinfraerrors.NewInvalidBuilder("Service", "service 'apiClient' cannot be empty"),
 			client:              false,
 		},
 	}
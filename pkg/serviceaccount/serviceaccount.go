@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	authenticationv1 "k8s.io/api/authentication/v1"
@@ -17,6 +19,9 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// resourceCRD is the kind of the resource, used in the errors returned by the builder.
+const resourceCRD = "ServiceAccount"
+
 // Builder provides struct for serviceaccount object containing connection to the cluster and the
 // serviceaccount definitions.
 type Builder struct {
@@ -66,6 +71,52 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	return builder
 }
 
+// NewBuilderFromManifest creates a new instance of Builder whose definition is decoded from a single YAML or JSON
+// serviceaccount manifest. Unknown fields and kinds other than ServiceAccount are rejected.
+func NewBuilderFromManifest(apiClient *clients.Settings, manifest []byte) *Builder {
+	klog.V(100).Info("Initializing new serviceaccount structure from manifest")
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the serviceaccount is nil")
+
+		return nil
+	}
+
+	definition, err := common.DecodeManifest[corev1.ServiceAccount](apiClient.Scheme(), manifest)
+	if err != nil {
+		klog.V(100).Infof("Failed to decode serviceaccount manifest: %v", err)
+
+		return &Builder{
+			apiClient:  apiClient.ServiceAccounts(""),
+			Definition: &corev1.ServiceAccount{},
+			errorMsg:   fmt.Sprintf("failed to decode serviceaccount manifest: %v", err),
+		}
+	}
+
+	builder := &Builder{
+		apiClient:  apiClient.ServiceAccounts(definition.Namespace),
+		Definition: definition,
+	}
+
+	if builder.Definition.Name == "" {
+		klog.V(100).Info("The name of the serviceaccount is empty")
+
+		builder.errorMsg = "serviceaccount 'name' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Namespace == "" {
+		klog.V(100).Info("The namespace of the serviceaccount is empty")
+
+		builder.errorMsg = "serviceaccount 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
 // Pull loads an existing serviceaccount into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	klog.V(100).Infof("Pulling existing serviceaccount name: %s under namespace: %s", name, nsname)
@@ -83,17 +134,17 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		builder.errorMsg = "serviceaccount 'name' cannot be empty"
 
-		return builder, fmt.Errorf("serviceaccount 'name' cannot be empty")
+		return builder, goinfraerrors.NewInvalidBuilder(resourceCRD, "serviceaccount 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		builder.errorMsg = "serviceaccount 'namespace' cannot be empty"
 
-		return builder, fmt.Errorf("serviceaccount 'namespace' cannot be empty")
+		return builder, goinfraerrors.NewInvalidBuilder(resourceCRD, "serviceaccount 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
-		return nil, fmt.Errorf("serviceaccount object %s does not exist in namespace %s", name, nsname)
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -243,30 +294,28 @@ func GetGVR() schema.GroupVersionResource {
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "ServiceAccount"
-
 	if builder == nil {
 		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
 		klog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
 		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
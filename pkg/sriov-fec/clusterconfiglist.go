@@ -54,7 +54,7 @@ func ListClusterConfig(
 
 	sfncList := new(sriovfectypes.SriovFecClusterConfigList)
 
-	err = apiClient.List(logging.DiscardContext(), sfncList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, sfncList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list SriovFecClusterConfigs in the namespace %s due to %s", nsname, err.Error())
 
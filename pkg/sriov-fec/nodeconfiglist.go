@@ -49,7 +49,7 @@ func List(apiClient *clients.Settings, nsname string, options ...client.ListOpti
 
 	sfncList := new(sriovfectypes.SriovFecNodeConfigList)
 
-	err = apiClient.List(logging.DiscardContext(), sfncList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, sfncList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list SriovFecNodeConfigs in the namespace %s due to %s", nsname, err.Error())
 
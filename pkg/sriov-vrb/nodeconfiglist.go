@@ -54,7 +54,7 @@ func ListNodeConfig(
 
 	sfncList := new(sriovvrbtypes.SriovVrbNodeConfigList)
 
-	err = apiClient.List(logging.DiscardContext(), sfncList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, sfncList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list SriovVrbNodeConfigs in the namespace %s due to %s", nsname, err.Error())
 
@@ -49,7 +49,7 @@ func List(apiClient *clients.Settings, nsname string, options ...client.ListOpti
 
 	networkList := new(srIovV1.SriovNetworkList)
 
-	err = apiClient.List(logging.DiscardContext(), networkList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, networkList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list sriov networks in the namespace %s due to %s", nsname, err.Error())
 
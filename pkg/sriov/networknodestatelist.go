@@ -51,7 +51,7 @@ func ListNetworkNodeState(
 
 	networkNodeStateList := new(srIovV1.SriovNetworkNodeStateList)
 
-	err = apiClient.List(logging.DiscardContext(), networkNodeStateList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, networkNodeStateList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list SriovNetworkNodeStates in the namespace %s due to %s", nsname, err.Error())
 
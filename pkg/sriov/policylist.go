@@ -50,7 +50,7 @@ func ListPolicy(apiClient *clients.Settings, nsname string, options ...client.Li
 
 	networkNodePoliciesList := new(srIovV1.SriovNetworkNodePolicyList)
 
-	err = apiClient.List(logging.DiscardContext(), networkNodePoliciesList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, networkNodePoliciesList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list SriovNetworkNodePolicies in the namespace %s due to %s",
 			nsname, err.Error())
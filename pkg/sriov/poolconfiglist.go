@@ -33,7 +33,8 @@ func ListPoolConfigs(apiClient *clients.Settings, namespace string) ([]*PoolConf
 		return nil, fmt.Errorf("failed to list sriovNetworkPoolConfigs, 'namespace' parameter is empty")
 	}
 
-	err = apiClient.List(logging.DiscardContext(), sriovNetworkPoolConfigList, &client.ListOptions{Namespace: namespace})
+	err = clients.ListInto(
+		logging.DiscardContext(), apiClient, sriovNetworkPoolConfigList, &client.ListOptions{Namespace: namespace})
 	if err != nil {
 		klog.V(100).Infof("Failed to list SriovNetworkPoolConfigs in namespace: %s due to %s",
 			namespace, err.Error())
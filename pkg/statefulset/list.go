@@ -33,7 +33,8 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	klog.V(100).Infof("%v", logMessage)
 
-	statefulsetList, err := apiClient.StatefulSets(nsname).List(logging.DiscardContext(), passedOptions)
+	statefulsetList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.StatefulSets(nsname).List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list statefulsets in the namespace %s due to %s", nsname, err.Error())
 
@@ -74,7 +75,8 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 
 	klog.V(100).Infof("%v", logMessage)
 
-	statefulsetList, err := apiClient.StatefulSets("").List(logging.DiscardContext(), passedOptions)
+	statefulsetList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.StatefulSets("").List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list statefulsets in all namespaces due to %s", err.Error())
 
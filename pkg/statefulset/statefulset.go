@@ -2,10 +2,10 @@ package statefulset
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	goinfraerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/errors"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	appsv1 "k8s.io/api/apps/v1"
@@ -17,6 +17,9 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// resourceCRD is the kind of the resource, used in the errors returned by the builder.
+const resourceCRD = "StatefulSet"
+
 // Builder provides struct for statefulset object containing connection to the cluster and the statefulset definitions.
 type Builder struct {
 	// StatefulSet definition. Used to create the statefulset object.
@@ -192,17 +195,17 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		builder.errorMsg = "statefulset 'name' cannot be empty"
 
-		return nil, fmt.Errorf("statefulset 'name' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "statefulset 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		builder.errorMsg = "statefulset 'namespace' cannot be empty"
 
-		return nil, fmt.Errorf("statefulset 'namespace' cannot be empty")
+		return nil, goinfraerrors.NewInvalidBuilder(resourceCRD, "statefulset 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
-		return nil, fmt.Errorf("statefulset object %s does not exist in namespace %s", name, nsname)
+		return nil, goinfraerrors.NewNotFound(resourceCRD, name, nsname)
 	}
 
 	builder.Definition = builder.Object
@@ -312,30 +315,28 @@ func GetGVR() schema.GroupVersionResource {
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "StatefulSet"
-
 	if builder == nil {
 		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
 		klog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
 		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return false, goinfraerrors.NewInvalidBuilder(resourceCRD, "%s", builder.errorMsg)
 	}
 
 	return true, nil
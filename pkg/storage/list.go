@@ -33,7 +33,7 @@ func ListPV(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*PVBu
 
 	klog.V(100).Infof("%v", logMessage)
 
-	pvList, err := apiClient.PersistentVolumes().List(logging.DiscardContext(), passedOptions)
+	pvList, err := clients.CollectPages(logging.DiscardContext(), passedOptions, apiClient.PersistentVolumes().List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list PV objects due to %s", err.Error())
 
@@ -86,7 +86,8 @@ func ListPVC(apiClient *clients.Settings, nsname string, options ...metav1.ListO
 
 	klog.V(100).Infof("%v", logMessage)
 
-	pvcList, err := apiClient.PersistentVolumeClaims(nsname).List(logging.DiscardContext(), passedOptions)
+	pvcList, err := clients.CollectPages(
+		logging.DiscardContext(), passedOptions, apiClient.PersistentVolumeClaims(nsname).List)
 	if err != nil {
 		klog.V(100).Infof("Failed to list PVC objects due to %s", err.Error())
 
@@ -48,7 +48,7 @@ func ListBackupStorageLocationBuilder(
 
 	bslList := &velerov1.BackupStorageLocationList{}
 
-	err = apiClient.List(logging.DiscardContext(), bslList, &passedOptions)
+	err = clients.ListInto(logging.DiscardContext(), apiClient, bslList, &passedOptions)
 	if err != nil {
 		klog.V(100).Infof("Failed to list backupstoragelocations in the nsname %s due to %s", nsname, err.Error())
 